@@ -92,19 +92,12 @@ func sendBuffer(bufOut *buffer.Buffer) {
 
 func handleReceive() {
 	for {
-		bufIn := buffer.New()
-		size, err := conn.Receive(bufIn)
-		if err != nil && err.Error() == "EOF" {
-			break
-		} else if err != nil || size == 0 {
+		packetBytes, err := conn.Receive()
+		if err != nil {
 			_ = conn.Close()
 			break
 		}
 
-		packetLen := bufIn.PullVarInt()
-		// println(fmt.Sprintf("received size: %d, declared packet length: %d", size, packetLen))
-		packetBytes := bufIn.Bytes()[bufIn.IndexI() : bufIn.IndexI()+packetLen]
-
 		handleCPacket(packetBytes)
 	}
 }
@@ -0,0 +1,509 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// lootTablesCmd generates Go loot tables from the Notchian block loot tables, so no JSON needs to be parsed in the game.
+func lootTablesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "loot-tables {blocks.json} {loot_tables_dir} {output_file.go}",
+		Short: "block loot tables code generator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var outFile *os.File
+			var err error
+
+			blocksFileName := args[0]
+			tablesDirName := args[1]
+			outputFileName := args[2]
+
+			if outputFileName == "-" {
+				outFile = os.Stdout
+			} else if outFile, err = os.Create(outputFileName); err != nil {
+				return fmt.Errorf("failed to open output file %s: %w", outputFileName, err)
+			}
+			defer outFile.Close()
+
+			input, err := ioutil.ReadFile(blocksFileName)
+			if err != nil {
+				return fmt.Errorf("failed to read source file %s: %w", blocksFileName, err)
+			}
+
+			gen := lootGenerator{blocks: make(map[string]lootBlock)}
+			if err := json.Unmarshal(input, &gen.blocks); err != nil {
+				return fmt.Errorf("failed to open source file %s: %w", blocksFileName, err)
+			}
+
+			tableFiles, err := filepath.Glob(filepath.Join(tablesDirName, "*.json"))
+			if err != nil {
+				return fmt.Errorf("failed to list loot tables in %s: %w", tablesDirName, err)
+			}
+			sort.Strings(tableFiles) // keep the output stable
+
+			var mapBlob string
+			for _, tableFile := range tableFiles {
+				blockName := "minecraft:" + strings.TrimSuffix(filepath.Base(tableFile), ".json")
+
+				input, err := ioutil.ReadFile(tableFile)
+				if err != nil {
+					return fmt.Errorf("failed to read loot table %s: %w", tableFile, err)
+				}
+
+				var table lootTable
+				if err := json.Unmarshal(input, &table); err != nil {
+					return fmt.Errorf("failed to parse loot table %s: %w", tableFile, err)
+				}
+
+				tableBlob, err := gen.table(table)
+				if err != nil {
+					return fmt.Errorf("failed to generate loot table %s: %w", tableFile, err)
+				}
+				mapBlob = mapBlob + fmt.Sprintf("%q: %s,\n", blockName, tableBlob)
+			}
+
+			goResult := fmt.Sprintf(`// Code generated by "tools gen loot-tables"; DO NOT EDIT.
+
+package loot
+
+import "github.com/alexykot/cncraft/pkg/protocol/objects"
+
+// blockTables - loot tables of the blocks, by block name.
+var blockTables = map[string]*Table{
+%s}
+`, mapBlob)
+
+			result, err := format.Source([]byte(goResult))
+			if err != nil {
+				return fmt.Errorf("failed to format the output: %w", err)
+			}
+
+			if _, err := outFile.Write(result); err != nil {
+				return fmt.Errorf("failed to write output: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+type lootBlock struct {
+	States []struct {
+		ID         int               `json:"id"`
+		Properties map[string]string `json:"properties"`
+	} `json:"states"`
+}
+
+type lootTable struct {
+	Pools     []lootPool        `json:"pools"`
+	Functions []json.RawMessage `json:"functions"`
+}
+
+type lootPool struct {
+	Rolls      json.RawMessage   `json:"rolls"`
+	Conditions []json.RawMessage `json:"conditions"`
+	Entries    []lootEntry       `json:"entries"`
+	Functions  []json.RawMessage `json:"functions"`
+}
+
+type lootEntry struct {
+	Type       string            `json:"type"`
+	Name       string            `json:"name"`
+	Weight     int               `json:"weight"`
+	Conditions []json.RawMessage `json:"conditions"`
+	Functions  []json.RawMessage `json:"functions"`
+	Children   []lootEntry       `json:"children"`
+}
+
+type lootGenerator struct {
+	blocks map[string]lootBlock
+}
+
+func (g lootGenerator) table(table lootTable) (string, error) {
+	var pools []string
+	for _, pool := range table.Pools {
+		poolBlob, err := g.pool(pool)
+		if err != nil {
+			return "", err
+		}
+		pools = append(pools, poolBlob)
+	}
+
+	functions, err := g.functions(table.Functions)
+	if err != nil {
+		return "", err
+	}
+
+	fields := []string{"Pools: []Pool{\n" + joinElements(pools) + "}"}
+	if functions != "" {
+		fields = append(fields, "Functions: "+functions)
+	}
+	return "{\n" + joinElements(fields) + "}", nil
+}
+
+func (g lootGenerator) pool(pool lootPool) (string, error) {
+	rolls, err := lootNumber(pool.Rolls)
+	if err != nil {
+		return "", fmt.Errorf("invalid rolls: %w", err)
+	}
+
+	var entries []string
+	for _, entry := range pool.Entries {
+		entryBlob, err := g.entry(entry)
+		if err != nil {
+			return "", err
+		}
+		entries = append(entries, entryBlob)
+	}
+
+	conditions, err := g.conditions(pool.Conditions)
+	if err != nil {
+		return "", err
+	}
+	functions, err := g.functions(pool.Functions)
+	if err != nil {
+		return "", err
+	}
+
+	fields := []string{"Rolls: " + rolls}
+	if conditions != "" {
+		fields = append(fields, "Conditions: "+conditions)
+	}
+	fields = append(fields, "Entries: []Entry{\n"+joinElements(entries)+"}")
+	if functions != "" {
+		fields = append(fields, "Functions: "+functions)
+	}
+	return "{\n" + joinElements(fields) + "}", nil
+}
+
+func (g lootGenerator) entry(entry lootEntry) (string, error) {
+	var fields []string
+	switch entry.Type {
+	case "minecraft:item":
+		fields = append(fields, "Type: ItemEntry", "Item: "+itemConst(entry.Name))
+	case "minecraft:alternatives":
+		var children []string
+		for _, child := range entry.Children {
+			childBlob, err := g.entry(child)
+			if err != nil {
+				return "", err
+			}
+			children = append(children, childBlob)
+		}
+		fields = append(fields, "Type: AlternativesEntry", "Children: []Entry{\n"+joinElements(children)+"}")
+	default:
+		return "", fmt.Errorf("entry type %s not supported", entry.Type)
+	}
+
+	if entry.Weight > 0 {
+		fields = append(fields, fmt.Sprintf("Weight: %d", entry.Weight))
+	}
+
+	conditions, err := g.conditions(entry.Conditions)
+	if err != nil {
+		return "", err
+	}
+	if conditions != "" {
+		fields = append(fields, "Conditions: "+conditions)
+	}
+
+	functions, err := g.functions(entry.Functions)
+	if err != nil {
+		return "", err
+	}
+	if functions != "" {
+		fields = append(fields, "Functions: "+functions)
+	}
+
+	return "{\n" + joinElements(fields) + "}", nil
+}
+
+// conditions provides the conditions slice literal, or an empty string if there are no conditions.
+func (g lootGenerator) conditions(rawConditions []json.RawMessage) (string, error) {
+	var conditions []string
+	for _, rawCondition := range rawConditions {
+		condition, err := g.condition(rawCondition)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "[]Condition{\n" + joinElements(conditions) + "}", nil
+}
+
+func (g lootGenerator) condition(rawCondition json.RawMessage) (string, error) {
+	var condition struct {
+		Condition  string            `json:"condition"`
+		Block      string            `json:"block"`
+		Properties map[string]string `json:"properties"`
+		Chance     float64           `json:"chance"`
+		Chances    []float64         `json:"chances"`
+
+		Enchantment string            `json:"enchantment"`
+		Terms       []json.RawMessage `json:"terms"`
+		Term        json.RawMessage   `json:"term"`
+
+		OffsetX int64 `json:"offsetX"`
+		OffsetY int64 `json:"offsetY"`
+		OffsetZ int64 `json:"offsetZ"`
+
+		Predicate struct {
+			Item         string   `json:"item"`
+			Items        []string `json:"items"`
+			Enchantments []struct {
+				Enchantment string `json:"enchantment"`
+				Levels      struct {
+					Min int `json:"min"`
+				} `json:"levels"`
+			} `json:"enchantments"`
+			Block struct {
+				Block string            `json:"block"`
+				State map[string]string `json:"state"`
+			} `json:"block"`
+		} `json:"predicate"`
+	}
+	if err := json.Unmarshal(rawCondition, &condition); err != nil {
+		return "", fmt.Errorf("failed to parse condition: %w", err)
+	}
+
+	switch condition.Condition {
+	case "minecraft:survives_explosion":
+		return "SurvivesExplosion{}", nil
+	case "minecraft:entity_properties":
+		return "EntityProperties{}", nil
+	case "minecraft:random_chance":
+		return fmt.Sprintf("RandomChance{Chance: %s}", lootFloat(condition.Chance)), nil
+	case "minecraft:table_bonus":
+		var chances []string
+		for _, chance := range condition.Chances {
+			chances = append(chances, lootFloat(chance))
+		}
+		return fmt.Sprintf("TableBonus{Enchantment: %q, Chances: []float64{%s}}",
+			condition.Enchantment, strings.Join(chances, ", ")), nil
+	case "minecraft:match_tool":
+		var fields []string
+
+		itemNames := condition.Predicate.Items
+		if condition.Predicate.Item != "" {
+			itemNames = append(itemNames, condition.Predicate.Item)
+		}
+		if len(itemNames) > 0 {
+			var itemConsts []string
+			for _, itemName := range itemNames {
+				itemConsts = append(itemConsts, itemConst(itemName))
+			}
+			fields = append(fields, "Items: []objects.ItemID{"+strings.Join(itemConsts, ", ")+"}")
+		}
+
+		if len(condition.Predicate.Enchantments) > 0 {
+			var enchantments []string
+			for _, enchantment := range condition.Predicate.Enchantments {
+				enchantments = append(enchantments,
+					fmt.Sprintf("{Enchantment: %q, Min: %d}", enchantment.Enchantment, enchantment.Levels.Min))
+			}
+			fields = append(fields, "Enchantments: []EnchantmentLevel{"+strings.Join(enchantments, ", ")+"}")
+		}
+		return "MatchTool{" + strings.Join(fields, ", ") + "}", nil
+	case "minecraft:block_state_property":
+		states, err := g.states(condition.Block, condition.Properties)
+		if err != nil {
+			return "", err
+		}
+		return "BlockStateProperty{States: " + states + "}", nil
+	case "minecraft:location_check":
+		states, err := g.states(condition.Predicate.Block.Block, condition.Predicate.Block.State)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("LocationCheck{OffsetX: %d, OffsetY: %d, OffsetZ: %d, States: %s}",
+			condition.OffsetX, condition.OffsetY, condition.OffsetZ, states), nil
+	case "minecraft:alternative":
+		terms, err := g.conditions(condition.Terms)
+		if err != nil {
+			return "", err
+		}
+		return "Alternative{Terms: " + terms + "}", nil
+	case "minecraft:inverted":
+		term, err := g.condition(condition.Term)
+		if err != nil {
+			return "", err
+		}
+		return "Inverted{Term: " + term + "}", nil
+	default:
+		return "", fmt.Errorf("condition %s not supported", condition.Condition)
+	}
+}
+
+// states provides the slice literal of all states of the block having the given properties.
+func (g lootGenerator) states(blockName string, properties map[string]string) (string, error) {
+	block, ok := g.blocks[blockName]
+	if !ok {
+		return "", fmt.Errorf("block %s not found", blockName)
+	}
+
+	var stateIDs []string
+	for _, state := range block.States {
+		matches := true
+		for property, value := range properties {
+			if state.Properties[property] != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			stateIDs = append(stateIDs, strconv.Itoa(state.ID))
+		}
+	}
+
+	if len(stateIDs) == 0 {
+		return "", fmt.Errorf("no states of block %s match properties %v", blockName, properties)
+	}
+	return "[]objects.BlockID{" + strings.Join(stateIDs, ", ") + "}", nil
+}
+
+// functions provides the functions slice literal, or an empty string if there are no supported functions.
+func (g lootGenerator) functions(rawFunctions []json.RawMessage) (string, error) {
+	var functions []string
+	for _, rawFunction := range rawFunctions {
+		function, err := g.function(rawFunction)
+		if err != nil {
+			return "", err
+		}
+		if function != "" {
+			functions = append(functions, function)
+		}
+	}
+
+	if len(functions) == 0 {
+		return "", nil
+	}
+	return "[]Function{\n" + joinElements(functions) + "}", nil
+}
+
+func (g lootGenerator) function(rawFunction json.RawMessage) (string, error) {
+	var function struct {
+		Function    string            `json:"function"`
+		Conditions  []json.RawMessage `json:"conditions"`
+		Count       json.RawMessage   `json:"count"`
+		Enchantment string            `json:"enchantment"`
+		Formula     string            `json:"formula"`
+		Parameters  struct {
+			BonusMultiplier float64 `json:"bonusMultiplier"`
+			Extra           int     `json:"extra"`
+			Probability     float64 `json:"probability"`
+		} `json:"parameters"`
+		Limit struct {
+			Min *int `json:"min"`
+			Max *int `json:"max"`
+		} `json:"limit"`
+	}
+	if err := json.Unmarshal(rawFunction, &function); err != nil {
+		return "", fmt.Errorf("failed to parse function: %w", err)
+	}
+
+	var functionBlob string
+	switch function.Function {
+	case "minecraft:set_count":
+		count, err := lootNumber(function.Count)
+		if err != nil {
+			return "", fmt.Errorf("invalid count: %w", err)
+		}
+		functionBlob = "SetCount{Count: " + count + "}"
+	case "minecraft:explosion_decay":
+		functionBlob = "ExplosionDecay{}"
+	case "minecraft:limit_count":
+		min, max := 0, math.MaxInt16
+		if function.Limit.Min != nil {
+			min = *function.Limit.Min
+		}
+		if function.Limit.Max != nil {
+			max = *function.Limit.Max
+		}
+		functionBlob = fmt.Sprintf("LimitCount{Min: %d, Max: %d}", min, max)
+	case "minecraft:apply_bonus":
+		switch function.Formula {
+		case "minecraft:ore_drops":
+			functionBlob = fmt.Sprintf("ApplyBonus{Enchantment: %q, Formula: OreDrops}", function.Enchantment)
+		case "minecraft:uniform_bonus_count":
+			functionBlob = fmt.Sprintf("ApplyBonus{Enchantment: %q, Formula: UniformBonusCount, BonusMultiplier: %s}",
+				function.Enchantment, lootFloat(function.Parameters.BonusMultiplier))
+		case "minecraft:binomial_with_bonus_count":
+			functionBlob = fmt.Sprintf("ApplyBonus{Enchantment: %q, Formula: BinomialWithBonusCount, Extra: %d, Probability: %s}",
+				function.Enchantment, function.Parameters.Extra, lootFloat(function.Parameters.Probability))
+		default:
+			return "", fmt.Errorf("bonus formula %s not supported", function.Formula)
+		}
+	case "minecraft:copy_name", "minecraft:copy_nbt", "minecraft:copy_state", "minecraft:set_contents":
+		return "", nil // item NBT is not supported
+	default:
+		return "", fmt.Errorf("function %s not supported", function.Function)
+	}
+
+	conditions, err := g.conditions(function.Conditions)
+	if err != nil {
+		return "", err
+	}
+	if conditions != "" {
+		functionBlob = "Conditional{Conditions: " + conditions + ", Function: " + functionBlob + "}"
+	}
+	return functionBlob, nil
+}
+
+// lootNumber provides the number provider literal, numbers are either constants or typed objects.
+func lootNumber(rawNumber json.RawMessage) (string, error) {
+	var constant float64
+	if err := json.Unmarshal(rawNumber, &constant); err == nil {
+		return "Constant(" + lootFloat(constant) + ")", nil
+	}
+
+	var number struct {
+		Type string  `json:"type"`
+		Min  float64 `json:"min"`
+		Max  float64 `json:"max"`
+		N    int     `json:"n"`
+		P    float64 `json:"p"`
+	}
+	if err := json.Unmarshal(rawNumber, &number); err != nil {
+		return "", fmt.Errorf("failed to parse number: %w", err)
+	}
+
+	switch number.Type {
+	case "minecraft:uniform":
+		return fmt.Sprintf("Uniform{Min: %s, Max: %s}", lootFloat(number.Min), lootFloat(number.Max)), nil
+	case "minecraft:binomial":
+		return fmt.Sprintf("Binomial{N: %d, P: %s}", number.N, lootFloat(number.P)), nil
+	default:
+		return "", fmt.Errorf("number type %s not supported", number.Type)
+	}
+}
+
+func lootFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func itemConst(itemName string) string {
+	return "objects.Item" + getConstName(itemName, nil)
+}
+
+// joinElements lays out composite literal elements one per line, so the generated code stays readable.
+func joinElements(elements []string) string {
+	if len(elements) == 0 {
+		return ""
+	}
+	return strings.Join(elements, ",\n") + ",\n"
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// recipesCmd generates Go recipes from the Notchian recipes, resolving the item tags used as ingredients into items.
+func recipesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "recipes {recipes_dir} {item_tags_dir} {output_file.go}",
+		Short: "recipes code generator",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var outFile *os.File
+			var err error
+
+			recipesDirName := args[0]
+			tagsDirName := args[1]
+			outputFileName := args[2]
+
+			if outputFileName == "-" {
+				outFile = os.Stdout
+			} else if outFile, err = os.Create(outputFileName); err != nil {
+				return fmt.Errorf("failed to open output file %s: %w", outputFileName, err)
+			}
+			defer outFile.Close()
+
+			recipeFiles, err := filepath.Glob(filepath.Join(recipesDirName, "*.json"))
+			if err != nil {
+				return fmt.Errorf("failed to list recipes in %s: %w", recipesDirName, err)
+			}
+			sort.Strings(recipeFiles) // keep the output stable
+
+			gen := recipeGenerator{tagsDir: tagsDirName, tags: make(map[string][]string)}
+
+			var listBlob string
+			for _, recipeFile := range recipeFiles {
+				recipeID := "minecraft:" + strings.TrimSuffix(filepath.Base(recipeFile), ".json")
+
+				input, err := ioutil.ReadFile(recipeFile)
+				if err != nil {
+					return fmt.Errorf("failed to read recipe %s: %w", recipeFile, err)
+				}
+
+				var recipe recipeJSON
+				if err := json.Unmarshal(input, &recipe); err != nil {
+					return fmt.Errorf("failed to parse recipe %s: %w", recipeFile, err)
+				}
+
+				recipeBlob, ok, err := gen.recipe(recipeID, recipe)
+				if err != nil {
+					return fmt.Errorf("failed to generate recipe %s: %w", recipeFile, err)
+				} else if !ok {
+					continue // recipe type not supported
+				}
+				listBlob = listBlob + recipeBlob + ",\n"
+			}
+
+			goResult := fmt.Sprintf(`// Code generated by "tools gen recipes"; DO NOT EDIT.
+
+package recipes
+
+import "github.com/alexykot/cncraft/pkg/protocol/objects"
+
+// recipeList - all supported recipes, by recipe ID.
+var recipeList = []*Recipe{
+%s}
+`, listBlob)
+
+			result, err := format.Source([]byte(goResult))
+			if err != nil {
+				return fmt.Errorf("failed to format the output: %w", err)
+			}
+
+			if _, err := outFile.Write(result); err != nil {
+				return fmt.Errorf("failed to write output: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+type recipeJSON struct {
+	Type        string                     `json:"type"`
+	Group       string                     `json:"group"`
+	Pattern     []string                   `json:"pattern"`
+	Key         map[string]json.RawMessage `json:"key"`
+	Ingredients []json.RawMessage          `json:"ingredients"`
+	Ingredient  json.RawMessage            `json:"ingredient"`
+	Result      json.RawMessage            `json:"result"`
+	Experience  float64                    `json:"experience"`
+	CookingTime int                        `json:"cookingtime"`
+}
+
+type recipeGenerator struct {
+	tagsDir string
+	tags    map[string][]string // resolved item names, by tag name
+}
+
+// recipe provides the recipe literal, or false if the recipe type is not supported.
+func (g recipeGenerator) recipe(recipeID string, recipe recipeJSON) (string, bool, error) {
+	fields := []string{
+		fmt.Sprintf("ID: %q", recipeID),
+		"Type: " + recipeTypeConst(recipe.Type),
+	}
+	if recipe.Group != "" {
+		fields = append(fields, fmt.Sprintf("Group: %q", recipe.Group))
+	}
+
+	var ingredients []string
+	switch recipe.Type {
+	case "minecraft:crafting_shaped":
+		width := 0
+		for _, row := range recipe.Pattern {
+			if len(row) > width {
+				width = len(row)
+			}
+		}
+		fields = append(fields, fmt.Sprintf("Width: %d", width), fmt.Sprintf("Height: %d", len(recipe.Pattern)))
+
+		for _, row := range recipe.Pattern {
+			row = row + strings.Repeat(" ", width-len(row))
+			for _, key := range row {
+				if key == ' ' {
+					ingredients = append(ingredients, "nil")
+					continue
+				}
+
+				rawIngredient, ok := recipe.Key[string(key)]
+				if !ok {
+					return "", false, fmt.Errorf("pattern key %q not defined", key)
+				}
+				ingredient, err := g.ingredient(rawIngredient)
+				if err != nil {
+					return "", false, err
+				}
+				ingredients = append(ingredients, ingredient)
+			}
+		}
+	case "minecraft:crafting_shapeless":
+		for _, rawIngredient := range recipe.Ingredients {
+			ingredient, err := g.ingredient(rawIngredient)
+			if err != nil {
+				return "", false, err
+			}
+			ingredients = append(ingredients, ingredient)
+		}
+	case "minecraft:smelting", "minecraft:blasting", "minecraft:smoking", "minecraft:campfire_cooking":
+		ingredient, err := g.ingredient(recipe.Ingredient)
+		if err != nil {
+			return "", false, err
+		}
+		ingredients = append(ingredients, ingredient)
+	default:
+		return "", false, nil
+	}
+	fields = append(fields, "Ingredients: []Ingredient{\n"+joinElements(ingredients)+"}")
+
+	resultItem, resultCount, err := recipeResult(recipe.Result)
+	if err != nil {
+		return "", false, err
+	}
+	fields = append(fields, "Result: "+itemConst(resultItem), fmt.Sprintf("ResultCount: %d", resultCount))
+
+	if recipe.CookingTime > 0 {
+		fields = append(fields,
+			"Experience: "+strconv.FormatFloat(recipe.Experience, 'g', -1, 32),
+			fmt.Sprintf("CookingTime: %d", recipe.CookingTime))
+	}
+
+	return "{\n" + joinElements(fields) + "}", true, nil
+}
+
+// ingredient provides the ingredient literal. Ingredient is either an item, a tag of items, or a list of those.
+func (g recipeGenerator) ingredient(rawIngredient json.RawMessage) (string, error) {
+	type itemOrTag struct {
+		Item string `json:"item"`
+		Tag  string `json:"tag"`
+	}
+
+	var alternatives []itemOrTag
+	if err := json.Unmarshal(rawIngredient, &alternatives); err != nil {
+		var single itemOrTag
+		if err := json.Unmarshal(rawIngredient, &single); err != nil {
+			return "", fmt.Errorf("failed to parse ingredient: %w", err)
+		}
+		alternatives = []itemOrTag{single}
+	}
+
+	var itemConsts []string
+	for _, alternative := range alternatives {
+		if alternative.Item != "" {
+			itemConsts = append(itemConsts, itemConst(alternative.Item))
+			continue
+		}
+
+		itemNames, err := g.tag(alternative.Tag)
+		if err != nil {
+			return "", err
+		}
+		for _, itemName := range itemNames {
+			itemConsts = append(itemConsts, itemConst(itemName))
+		}
+	}
+
+	if len(itemConsts) == 0 {
+		return "", fmt.Errorf("ingredient has no items")
+	}
+	return "{" + strings.Join(itemConsts, ", ") + "}", nil
+}
+
+// tag resolves the item tag into the names of the items, following the nested tags.
+func (g recipeGenerator) tag(tagName string) ([]string, error) {
+	if itemNames, ok := g.tags[tagName]; ok {
+		return itemNames, nil
+	}
+
+	tagFile := filepath.Join(g.tagsDir, strings.Replace(tagName, "minecraft:", "", 1)+".json")
+	input, err := ioutil.ReadFile(tagFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag %s: %w", tagName, err)
+	}
+
+	var tag struct {
+		Values []string `json:"values"`
+	}
+	if err := json.Unmarshal(input, &tag); err != nil {
+		return nil, fmt.Errorf("failed to parse tag %s: %w", tagName, err)
+	}
+
+	var itemNames []string
+	for _, value := range tag.Values {
+		if !strings.HasPrefix(value, "#") {
+			itemNames = append(itemNames, value)
+			continue
+		}
+
+		nestedNames, err := g.tag(strings.TrimPrefix(value, "#"))
+		if err != nil {
+			return nil, err
+		}
+		itemNames = append(itemNames, nestedNames...)
+	}
+
+	g.tags[tagName] = itemNames
+	return itemNames, nil
+}
+
+// recipeResult parses the recipe result, crafting results are objects with the count, cooking results are item names.
+func recipeResult(rawResult json.RawMessage) (string, int, error) {
+	var itemName string
+	if err := json.Unmarshal(rawResult, &itemName); err == nil {
+		return itemName, 1, nil
+	}
+
+	var result struct {
+		Item  string `json:"item"`
+		Count int    `json:"count"`
+	}
+	if err := json.Unmarshal(rawResult, &result); err != nil {
+		return "", 0, fmt.Errorf("failed to parse result: %w", err)
+	}
+
+	if result.Count == 0 {
+		result.Count = 1
+	}
+	return result.Item, result.Count, nil
+}
+
+func recipeTypeConst(recipeType string) string {
+	return getConstName(recipeType, nil)
+}
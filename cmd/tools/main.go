@@ -9,6 +9,8 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 
@@ -18,10 +20,13 @@ import (
 
 	"github.com/alexykot/cncraft/core/db/orm"
 	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/player"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 
 	"github.com/alexykot/cncraft/cmd/tools/packet"
+	"github.com/alexykot/cncraft/cmd/tools/world"
 	coreDB "github.com/alexykot/cncraft/core/db"
+	"github.com/alexykot/cncraft/core/players"
 )
 
 func main() {
@@ -32,6 +37,7 @@ func main() {
 	packet.RegisterPacketTools(ctx, cmd)
 	registerGenerationTools(ctx, cmd)
 	registerMiscTools(ctx, cmd)
+	world.RegisterWorldTools(ctx, cmd)
 
 	if err := cmd.Execute(); err != nil {
 		log.Fatalf("While executing command: %s\n", err)
@@ -199,6 +205,82 @@ func init(){
 		},
 	})
 
+	codegenCmd.AddCommand(&cobra.Command{
+		Use:   "block-defaults {input_file.json} {output_file.go}",
+		Short: "default block states code generator",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			type blockState struct {
+				ID        int  `json:"id"`
+				IsDefault bool `json:"default"`
+			}
+			type blockList map[string]struct {
+				States []blockState `json:"states"`
+			}
+
+			var outFile *os.File
+			var err error
+
+			inputFileName := args[0]
+			outputFileName := args[1]
+
+			if outputFileName == "-" {
+				outFile = os.Stdout
+			} else if outFile, err = os.Create(outputFileName); err != nil {
+				return fmt.Errorf("failed to open output file %s: %w", outputFileName, err)
+			}
+			defer outFile.Close()
+
+			input, err := ioutil.ReadFile(inputFileName)
+			if err != nil {
+				return fmt.Errorf("failed to read source file %s: %w", inputFileName, err)
+			}
+
+			theList := make(blockList)
+			if err := json.Unmarshal(input, &theList); err != nil {
+				return fmt.Errorf("failed to open source file %s: %w", inputFileName, err)
+			}
+
+			blockNames := make([]string, 0, len(theList))
+			for blockName := range theList {
+				blockNames = append(blockNames, blockName)
+			}
+			sort.Strings(blockNames) // keep the output stable
+
+			var mapBlob string
+			for _, blockName := range blockNames {
+				for _, state := range theList[blockName].States {
+					if state.IsDefault {
+						mapBlob = mapBlob + fmt.Sprintf("\"%s\": %d,\n", blockName, state.ID)
+					}
+				}
+			}
+
+			goResult := fmt.Sprintf(`// Code generated by "tools gen block-defaults"; DO NOT EDIT.
+
+package objects
+
+// blockDefaultStates - default states of all blocks, by block name.
+var blockDefaultStates = map[string]BlockID{
+%s}
+`, mapBlob)
+
+			result, err := format.Source([]byte(goResult))
+			if err != nil {
+				return fmt.Errorf("failed to format the output: %w", err)
+			}
+
+			if _, err := outFile.Write(result); err != nil {
+				return fmt.Errorf("failed to write output: %w", err)
+			}
+
+			return nil
+		},
+	})
+
+	codegenCmd.AddCommand(lootTablesCmd())
+	codegenCmd.AddCommand(recipesCmd())
+
 	cmd.AddCommand(codegenCmd)
 }
 
@@ -319,5 +401,33 @@ func registerMiscTools(ctx context.Context, cmd *cobra.Command) {
 		},
 	})
 
+	miscCmd.AddCommand(&cobra.Command{
+		Use:   "op {player_name} [op_level]",
+		Short: "add player to the ops list, op level 0 removes from the list",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opLevel := player.OpLevelOwner
+			if len(args) == 2 {
+				level, err := strconv.Atoi(args[1])
+				if err != nil || !player.OpLevel(level).IsValid() {
+					return fmt.Errorf("op level must be from 0 to 4, got %s", args[1])
+				}
+				opLevel = player.OpLevel(level)
+			}
+
+			dbURL, ok := os.LookupEnv("CNCRAFT_TEST_DB_URL")
+			if !ok {
+				return fmt.Errorf("CNCRAFT_TEST_DB_URL envar must be set to a valid DB URL")
+			}
+
+			db, err := coreDB.New(zap.L(), dbURL, false)
+			if err != nil {
+				return fmt.Errorf("failed to open DB URL %s: %w", dbURL, err)
+			}
+
+			return players.SetOpLevel(db, args[0], opLevel)
+		},
+	})
+
 	cmd.AddCommand(miscCmd)
 }
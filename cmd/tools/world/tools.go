@@ -0,0 +1,390 @@
+package world
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+
+	coreDB "github.com/alexykot/cncraft/core/db"
+	coreWorld "github.com/alexykot/cncraft/core/world"
+	"github.com/alexykot/cncraft/pkg/anvil"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+const defaultBlocksReport = "cmd/tools/generated/reports/blocks.json"
+
+var gamemodes = map[string]game.Gamemode{
+	"survival":  game.Survival,
+	"creative":  game.Creative,
+	"adventure": game.Adventure,
+	"spectator": game.Spectator,
+}
+
+var difficulties = map[string]game.Difficulty{
+	"peaceful": game.Peaceful,
+	"easy":     game.Easy,
+	"normal":   game.Normal,
+	"hard":     game.Hard,
+}
+
+func RegisterWorldTools(ctx context.Context, cmd *cobra.Command) {
+	worldCmd := &cobra.Command{Use: "world {cmd}", Short: "world management tools"}
+
+	var seed uint32
+	var gamemode, difficulty string
+	var radius int64
+	var hardcore, difficultyLocked bool
+	createCmd := &cobra.Command{
+		Use:   "create {name}",
+		Short: "create new flat world with overworld, nether and end dimensions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			settings := coreWorld.DefaultSettings(args[0])
+			if cmd.Flags().Changed("seed") {
+				binary.LittleEndian.PutUint32(settings.Seed, seed)
+			}
+
+			var ok bool
+			if settings.Gamemode, ok = gamemodes[gamemode]; !ok {
+				return fmt.Errorf("unknown gamemode `%s`", gamemode)
+			}
+			if settings.Difficulty, ok = difficulties[difficulty]; !ok {
+				return fmt.Errorf("unknown difficulty `%s`", difficulty)
+			}
+			settings.Coreness = game.Coreness(hardcore)
+			settings.DifficultyIsLocked = difficultyLocked
+
+			if radius < 0 {
+				return fmt.Errorf("radius must not be negative")
+			}
+			settings.Dimensions[0].Edges = level.Edges{
+				NegativeX: -radius * level.SectionX,
+				NegativeZ: -radius * level.SectionZ,
+				PositiveX: radius * level.SectionX,
+				PositiveZ: radius * level.SectionZ,
+			}
+
+			db, err := openDB()
+			if err != nil {
+				return err
+			}
+
+			if err := coreWorld.CreateWorld(db, settings); err != nil {
+				return fmt.Errorf("failed to create world: %w", err)
+			}
+
+			println(fmt.Sprintf("world-id: %s", settings.ID.String()))
+			return nil
+		},
+	}
+	createCmd.Flags().Uint32Var(&seed, "seed", 0, "world seed, random if not set")
+	createCmd.Flags().StringVar(&gamemode, "gamemode", "survival", "one of survival, creative, adventure, spectator")
+	createCmd.Flags().StringVar(&difficulty, "difficulty", "peaceful", "one of peaceful, easy, normal, hard")
+	createCmd.Flags().BoolVar(&hardcore, "hardcore", false, "create hardcore world")
+	createCmd.Flags().BoolVar(&difficultyLocked, "lock-difficulty", true, "lock the world difficulty")
+	createCmd.Flags().Int64Var(&radius, "radius", 3, "overworld size in chunks from the center in each direction")
+	worldCmd.AddCommand(createCmd)
+
+	var blocksReport, worldName string
+	importCmd := &cobra.Command{
+		Use:   "import {region_dir}",
+		Short: "import vanilla 1.16 Anvil region files as a new world",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			regionFiles, err := filepath.Glob(filepath.Join(args[0], "r.*.*.mca"))
+			if err != nil {
+				return fmt.Errorf("failed to list region files: %w", err)
+			} else if len(regionFiles) == 0 {
+				return fmt.Errorf("no region files found in %s", args[0])
+			}
+
+			states, err := anvil.LoadBlockStates(blocksReport)
+			if err != nil {
+				return fmt.Errorf("failed to load block states: %w", err)
+			}
+
+			db, err := openDB()
+			if err != nil {
+				return err
+			}
+
+			settings := coreWorld.DefaultSettings(worldName)
+
+			edges := level.Edges{
+				NegativeX: math.MaxInt64,
+				NegativeZ: math.MaxInt64,
+				PositiveX: math.MinInt64,
+				PositiveZ: math.MinInt64,
+			}
+			var chunkCount int
+			if err := coreWorld.ImportWorld(zap.L(), db, settings, func(repo coreWorld.SectionRepo) (level.Edges, error) {
+				for _, regionFile := range regionFiles {
+					region, err := anvil.ReadRegionFile(regionFile)
+					if err != nil {
+						return edges, fmt.Errorf("failed to read region: %w", err)
+					}
+
+					chunks, err := region.Chunks(states)
+					if err != nil {
+						return edges, fmt.Errorf("failed to decode region: %w", err)
+					}
+
+					for _, chunk := range chunks {
+						x, z := int64(chunk.X)*level.SectionX, int64(chunk.Z)*level.SectionZ
+						dropped, err := saveChunk(repo, x, z, chunk.Sections)
+						if err != nil {
+							return edges, fmt.Errorf("failed to save chunk %d.%d: %w", x, z, err)
+						}
+						if len(dropped) > 0 {
+							println(fmt.Sprintf("chunk %d.%d: dropped sections %v with blocks above y=%d, not supported by the server",
+								x, z, dropped, level.SectionsPerChunk*level.SectionY))
+						}
+
+						if edges.NegativeX > x {
+							edges.NegativeX = x
+						}
+						if edges.NegativeZ > z {
+							edges.NegativeZ = z
+						}
+						if edges.PositiveX < x {
+							edges.PositiveX = x
+						}
+						if edges.PositiveZ < z {
+							edges.PositiveZ = z
+						}
+						chunkCount++
+					}
+				}
+
+				if chunkCount == 0 {
+					return edges, fmt.Errorf("no chunks found in region files in %s", args[0])
+				}
+				return edges, nil
+			}); err != nil {
+				return fmt.Errorf("failed to import world: %w", err)
+			}
+
+			println(fmt.Sprintf("imported %d chunks from %d region files", chunkCount, len(regionFiles)))
+			println(fmt.Sprintf("world-id: %s", settings.ID.String()))
+			println(fmt.Sprintf("edges: X %d to %d, Z %d to %d",
+				edges.NegativeX, edges.PositiveX, edges.NegativeZ, edges.PositiveZ))
+			return nil
+		},
+	}
+	importCmd.Flags().StringVar(&blocksReport, "blocks", defaultBlocksReport, "blocks.json report of the vanilla server")
+	importCmd.Flags().StringVar(&worldName, "name", "Imported World", "name of the new world")
+	worldCmd.AddCommand(importCmd)
+
+	exportCmd := &cobra.Command{
+		Use:   "export {world-id} {out_dir}",
+		Short: "export all dimensions of the world as vanilla 1.16 Anvil region files",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			worldID, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse world ID: %w", err)
+			}
+
+			states, err := anvil.LoadBlockStates(blocksReport)
+			if err != nil {
+				return fmt.Errorf("failed to load block states: %w", err)
+			}
+
+			db, err := openDB()
+			if err != nil {
+				return err
+			}
+
+			settings, err := coreWorld.LoadSettings(db, worldID)
+			if err == sql.ErrNoRows {
+				return fmt.Errorf("world %s not found", worldID.String())
+			} else if err != nil {
+				return fmt.Errorf("failed to load world settings: %w", err)
+			}
+
+			type chunkKey struct{ x, z int32 }
+			dimensions := make(map[uuid.UUID]map[chunkKey]*anvil.Chunk)
+			if err := coreWorld.LoadWorldSections(db, worldID,
+				func(dimensionID uuid.UUID, x, z int64, section level.Section) error {
+					if _, ok := dimensions[dimensionID]; !ok {
+						dimensions[dimensionID] = make(map[chunkKey]*anvil.Chunk)
+					}
+
+					key := chunkKey{x: int32(x / level.SectionX), z: int32(z / level.SectionZ)}
+					chunk, ok := dimensions[dimensionID][key]
+					if !ok {
+						chunk = &anvil.Chunk{X: key.x, Z: key.z}
+						dimensions[dimensionID][key] = chunk
+					}
+					chunk.Sections = append(chunk.Sections, section)
+					return nil
+				}); err != nil {
+				return fmt.Errorf("failed to load world sections: %w", err)
+			}
+
+			// sections never saved are filled from the default template, the same way the server loads them
+			for _, dimension := range settings.Dimensions {
+				if _, ok := dimensions[dimension.ID]; !ok {
+					dimensions[dimension.ID] = make(map[chunkKey]*anvil.Chunk)
+				}
+
+				edges := dimension.Edges
+				for x := edges.NegativeX; x <= edges.PositiveX; x += level.SectionX {
+					for z := edges.NegativeZ; z <= edges.PositiveZ; z += level.SectionZ {
+						key := chunkKey{x: int32(x / level.SectionX), z: int32(z / level.SectionZ)}
+						chunk, ok := dimensions[dimension.ID][key]
+						if !ok {
+							chunk = &anvil.Chunk{X: key.x, Z: key.z}
+							dimensions[dimension.ID][key] = chunk
+						}
+						fillDefaultSections(chunk)
+					}
+				}
+			}
+
+			for dimensionID, chunks := range dimensions {
+				regions := make(map[chunkKey]*anvil.Region)
+				for _, chunk := range chunks {
+					regionX, regionZ := anvil.RegionCoords(chunk.X, chunk.Z)
+					region, ok := regions[chunkKey{x: regionX, z: regionZ}]
+					if !ok {
+						region = anvil.NewRegion(regionX, regionZ)
+						regions[chunkKey{x: regionX, z: regionZ}] = region
+					}
+
+					if err := region.SetChunk(*chunk, states); err != nil {
+						return fmt.Errorf("failed to add chunk to region: %w", err)
+					}
+				}
+
+				regionDir := filepath.Join(args[1], dimensionDir(dimensionID), "region")
+				if err := os.MkdirAll(regionDir, 0755); err != nil {
+					return fmt.Errorf("failed to create region dir %s: %w", regionDir, err)
+				}
+				for _, region := range regions {
+					if err := region.WriteFile(regionDir); err != nil {
+						return fmt.Errorf("failed to write region: %w", err)
+					}
+				}
+
+				println(fmt.Sprintf("exported %d chunks in %d region files into %s", len(chunks), len(regions), regionDir))
+			}
+			return nil
+		},
+	}
+	exportCmd.Flags().StringVar(&blocksReport, "blocks", defaultBlocksReport, "blocks.json report of the vanilla server")
+	worldCmd.AddCommand(exportCmd)
+
+	cmd.AddCommand(worldCmd)
+}
+
+// openDB opens the database at the CNCRAFT_TEST_DB_URL and brings its schema up to date.
+func openDB() (*sql.DB, error) {
+	dbURL, ok := os.LookupEnv("CNCRAFT_TEST_DB_URL")
+	if !ok {
+		return nil, fmt.Errorf("CNCRAFT_TEST_DB_URL envar must be set to a valid DB URL")
+	}
+
+	db, err := coreDB.New(zap.L(), dbURL, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open DB URL %s: %w", dbURL, err)
+	}
+
+	if err := coreDB.Migrate(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate DB: %w", err)
+	}
+	return db, nil
+}
+
+// dimensionDir provides the directory of the dimension inside the vanilla world save. Dimensions that are not
+// vanilla are placed in directories named by their IDs.
+func dimensionDir(dimensionID uuid.UUID) string {
+	switch dimensionID {
+	case coreWorld.DimensionID(game.Overworld):
+		return ""
+	case coreWorld.DimensionID(game.Nether):
+		return "DIM-1"
+	case coreWorld.DimensionID(game.TheEnd):
+		return "DIM1"
+	default:
+		return dimensionID.String()
+	}
+}
+
+// fillDefaultSections adds the sections missing in the chunk from the default template.
+func fillDefaultSections(chunk *anvil.Chunk) {
+	var saved [level.SectionsPerChunk]bool
+	for _, section := range chunk.Sections {
+		saved[section.Index()] = true
+	}
+
+	for i := range saved {
+		if !saved[i] {
+			chunk.Sections = append(chunk.Sections, coreWorld.DefaultSection(uint8(i)))
+		}
+	}
+}
+
+// saveChunk saves all sections of the chunk, sections missing in the imported chunk are saved filled with air,
+// so that they are not replaced with the default section contents when loaded. Sections above the ones supported
+// by the server are dropped, indices of the dropped sections that had any blocks in them are provided.
+func saveChunk(repo level.SectionRepo, x, z int64, sections []level.Section) ([]int, error) {
+	var dropped []int
+	var chunkSections [level.SectionsPerChunk]level.Section
+	for _, section := range sections {
+		if section.Index() >= level.SectionsPerChunk {
+			if !isEmptySection(section) {
+				dropped = append(dropped, section.Index())
+			}
+			continue
+		}
+		chunkSections[section.Index()] = section
+	}
+	sort.Ints(dropped)
+
+	for i, section := range chunkSections {
+		if section == nil {
+			var blocks level.BlockArr
+			for y := 0; y < level.SectionY; y++ {
+				for z := 0; z < level.SectionZ; z++ {
+					for x := 0; x < level.SectionX; x++ {
+						blocks[y][z][x] = level.NewBlock(objects.BlockAir)
+					}
+				}
+			}
+			section = level.NewSection(blocks, uint8(i))
+		}
+
+		if err := repo.SaveSection(x, z, section); err != nil {
+			return nil, err
+		}
+	}
+	return dropped, nil
+}
+
+// isEmptySection tells if there is nothing but air in the section.
+func isEmptySection(section level.Section) bool {
+	for y := int64(0); y < level.SectionY; y++ {
+		for z := int64(0); z < level.SectionZ; z++ {
+			for x := int64(0); x < level.SectionX; x++ {
+				switch section.GetBlock(x, y, z).ID() {
+				case objects.BlockAir, objects.BlockCaveAir, objects.BlockVoidAir:
+				default:
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/anvil"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+type memRepo struct {
+	saved map[int]level.Section
+}
+
+func (r *memRepo) LoadSection(_, _ int64, index uint8) (level.Section, error) {
+	return r.saved[int(index)], nil
+}
+func (r *memRepo) SaveSection(_, _ int64, section level.Section) error {
+	r.saved[section.Index()] = section
+	return nil
+}
+func (r *memRepo) LoadBlockEntities(_, _ int64) ([]level.BlockEntity, error) { return nil, nil }
+func (r *memRepo) SaveBlockEntity(_, _ int64, _ level.BlockEntity) error     { return nil }
+func (r *memRepo) DeleteBlockEntity(_, _ int64, _ data.PositionI) error      { return nil }
+
+func mkSection(index uint8, blockID objects.BlockID) level.Section {
+	var blocks level.BlockArr
+	for y := 0; y < level.SectionY; y++ {
+		for z := 0; z < level.SectionZ; z++ {
+			for x := 0; x < level.SectionX; x++ {
+				blocks[y][z][x] = level.NewBlock(objects.BlockAir)
+			}
+		}
+	}
+	blocks[5][3][7] = level.NewBlock(blockID)
+	return level.NewSection(blocks, index)
+}
+
+func TestSaveChunk(t *testing.T) {
+	states, err := anvil.LoadBlockStates("../generated/reports/blocks.json")
+	require.NoError(t, err)
+
+	region := anvil.NewRegion(0, 0)
+	require.NoError(t, region.SetChunk(anvil.Chunk{X: 1, Z: 2, Sections: []level.Section{
+		mkSection(2, objects.BlockStone),
+		mkSection(9, objects.BlockOakLog_AxisY), // y 144 to 159
+		mkSection(12, objects.BlockAir),         // nothing is lost with the empty section
+		mkSection(15, objects.BlockGlowstone),   // y 240 to 255
+	}}, states))
+
+	dir := t.TempDir()
+	require.NoError(t, region.WriteFile(dir))
+	readRegion, err := anvil.ReadRegionFile(dir + "/r.0.0.mca")
+	require.NoError(t, err)
+	chunks, err := readRegion.Chunks(states)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+
+	repo := &memRepo{saved: make(map[int]level.Section)}
+	dropped, err := saveChunk(repo, 16, 32, chunks[0].Sections)
+	require.NoError(t, err)
+	assert.Equal(t, []int{9, 15}, dropped)
+
+	require.Len(t, repo.saved, level.SectionsPerChunk)
+	for i := 0; i < level.SectionsPerChunk; i++ {
+		expected := objects.BlockAir
+		if i == 2 {
+			expected = objects.BlockStone
+		}
+		assert.Equal(t, expected, repo.saved[i].GetBlock(7, 5, 3).ID(), "section %d", i)
+	}
+}
@@ -0,0 +1,257 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// maxGiveCount - no more items than fit into the empty inventory can be given at once.
+const maxGiveCount = 36 * 64
+
+// defaultKickReason - reason shown to the kicked player, if the operator did not give any.
+const defaultKickReason = "Kicked by an operator"
+
+// tpCommand teleports the sender, or the targeted players, to the location or to the destination player.
+// Relative coordinates of the location are resolved against the position of the sender.
+func tpCommand(ps nats.PubSub, roster players.Roster) *brigadier.Node {
+	teleport := func(ctx *brigadier.Context) error {
+		sender, isPlayer := ctx.Sender.(*PlayerSender)
+
+		var targeted []*players.Player
+		if targets, ok := ctx.Arg("targets").(brigadier.Entities); ok {
+			var err error
+			if targeted, err = targetPlayers(ctx, roster, targets); err != nil {
+				return err
+			}
+		} else if isPlayer {
+			targeted = []*players.Player{sender.Player()}
+		} else {
+			return brigadier.Fail("A player is required to run this command here")
+		}
+
+		var destinationName string
+		var destination *players.Player
+		if entities, ok := ctx.Arg("destination").(brigadier.Entities); ok {
+			found, err := targetPlayers(ctx, roster, entities)
+			if err != nil {
+				return err
+			}
+			destination, destinationName = found[0], found[0].Username
+		}
+
+		for _, p := range targeted {
+			origin := p
+			if isPlayer {
+				origin = sender.Player()
+			}
+
+			dimensionID, pos := origin.GetState().Dimension, origin.GetLocation().PositionF
+			if destination != nil {
+				dimensionID, pos = destination.GetState().Dimension, destination.GetLocation().PositionF
+			} else {
+				pos = ctx.Arg("location").(brigadier.Coordinates).Resolve(pos)
+				destinationName = fmt.Sprintf("%.2f, %.2f, %.2f", pos.X, pos.Y, pos.Z)
+			}
+
+			if err := ps.Publish(subj.MkPlayerTeleport(), envelope.PlayerTeleport(&pb.PlayerTeleport{
+				PlayerId:    p.ID.String(),
+				DimensionId: dimensionID.String(),
+				Pos:         &pb.Position{X: pos.X, Y: pos.Y, Z: pos.Z},
+			})); err != nil {
+				return fmt.Errorf("failed to publish player teleport: %w", err)
+			}
+		}
+
+		ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Teleported %s to %s", describePlayers(targeted), destinationName)))
+		return nil
+	}
+
+	return brigadier.Literal("tp").Requires(PermissionTeleport).
+		Then(brigadier.Argument("location", brigadier.Vec3Parser{}).Executes(teleport)).
+		Then(brigadier.Argument("destination", brigadier.EntityParser{Single: true}).
+			Suggests(suggestPlayers(roster)).
+			Executes(teleport)).
+		Then(brigadier.Argument("targets", brigadier.EntityParser{}).
+			Suggests(suggestPlayers(roster)).
+			Then(brigadier.Argument("location", brigadier.Vec3Parser{}).Executes(teleport)).
+			Then(brigadier.Argument("destination", brigadier.EntityParser{Single: true}).
+				Suggests(suggestPlayers(roster)).
+				Executes(teleport)))
+}
+
+// gamemodeNames - gamemodes by the names used in the /gamemode command, along with the names shown to the players.
+var gamemodeNames = []struct {
+	mode    game.Gamemode
+	literal string
+	name    string
+}{
+	{game.Survival, "survival", "Survival Mode"},
+	{game.Creative, "creative", "Creative Mode"},
+	{game.Adventure, "adventure", "Adventure Mode"},
+	{game.Spectator, "spectator", "Spectator Mode"},
+}
+
+// gamemodeCommand switches the sender, or the targeted players, to the gamemode.
+func gamemodeCommand(log *zap.Logger, ps nats.PubSub, roster players.Roster) *brigadier.Node {
+	command := brigadier.Literal("gamemode").Requires(PermissionGamemode)
+
+	for _, gamemode := range gamemodeNames {
+		mode, name := gamemode.mode, gamemode.name
+		switchMode := func(ctx *brigadier.Context) error {
+			sender, isPlayer := ctx.Sender.(*PlayerSender)
+
+			var targeted []*players.Player
+			if targets, ok := ctx.Arg("target").(brigadier.Entities); ok {
+				var err error
+				if targeted, err = targetPlayers(ctx, roster, targets); err != nil {
+					return err
+				}
+			} else if isPlayer {
+				targeted = []*players.Player{sender.Player()}
+			} else {
+				return brigadier.Fail("A player is required to run this command here")
+			}
+
+			for _, p := range targeted {
+				p.SetGamemode(mode)
+
+				cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CChangeGameState)
+				gameState := cpacket.(*protocol.CPacketChangeGameState)
+				gameState.Reason = protocol.GameStateChangeGamemode
+				gameState.Value = float32(mode)
+
+				cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerAbilities)
+				abilities := cpacket.(*protocol.CPacketPlayerAbilities)
+				abilities.Abilities = p.GetAbilities()
+				abilities.FlyingSpeed = p.GetSettings().FlyingSpeed
+				abilities.FieldOfView = p.GetSettings().FoVModifier
+
+				if err := ps.Publish(subj.MkConnTransmit(p.ConnID),
+					envelope.MkCpacketEnvelope(gameState), envelope.MkCpacketEnvelope(abilities)); err != nil {
+					return fmt.Errorf("failed to publish gamemode change: %w", err)
+				}
+
+				if isPlayer && p == sender.Player() {
+					ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Set own game mode to %s", name)))
+					continue
+				}
+				NewPlayerSender(log, ps, p).SendMessage(chat.New(fmt.Sprintf("Your game mode has been updated to %s", name)))
+				ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Set %s's game mode to %s", p.Username, name)))
+			}
+			return nil
+		}
+
+		command.Then(brigadier.Literal(gamemode.literal).
+			Executes(switchMode).
+			Then(brigadier.Argument("target", brigadier.EntityParser{PlayersOnly: true}).
+				Suggests(suggestPlayers(roster)).
+				Executes(switchMode)))
+	}
+	return command
+}
+
+// giveCommand puts the items into the inventories of the targeted players. Items that do not fit are lost.
+func giveCommand(ps nats.PubSub, roster players.Roster) *brigadier.Node {
+	give := func(ctx *brigadier.Context) error {
+		targeted, err := targetPlayers(ctx, roster, ctx.Arg("targets").(brigadier.Entities))
+		if err != nil {
+			return err
+		}
+
+		itemID := ctx.Arg("item").(objects.ItemID)
+		count := int16(1)
+		if value, ok := ctx.Arg("count").(int32); ok {
+			count = int16(value)
+		}
+
+		itemName := strings.TrimPrefix(itemID.String(), "minecraft:")
+		for _, p := range targeted {
+			inventory := p.GetState().Inventory
+			left, updated := inventory.PickUp(items.Slot{IsPresent: true, ItemID: itemID, ItemCount: count})
+			if len(updated) == 0 {
+				ctx.Sender.SendMessage(chat.New(fmt.Sprintf("No room for [%s] in %s's inventory", itemName, p.Username)))
+				continue
+			}
+			roster.PlayerInventoryChanged(p.ConnID)
+
+			var outLopes []*envelope.E
+			for _, slotID := range updated {
+				cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
+				setSlot := cpacket.(*protocol.CPacketSetSlot)
+				setSlot.WindowID = items.InventoryWindow
+				setSlot.SlotID = slotID
+				setSlot.Slot = inventory.GetSlot(slotID)
+				outLopes = append(outLopes, envelope.MkCpacketEnvelope(setSlot))
+			}
+			if err := ps.Publish(subj.MkConnTransmit(p.ConnID), outLopes...); err != nil {
+				return fmt.Errorf("failed to publish inventory slots: %w", err)
+			}
+
+			// only as many items are given as fit into the inventory
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Gave %d [%s] to %s", count-left.ItemCount, itemName, p.Username)))
+		}
+		return nil
+	}
+
+	return brigadier.Literal("give").Requires(PermissionGive).
+		Then(brigadier.Argument("targets", brigadier.EntityParser{PlayersOnly: true}).
+			Suggests(suggestPlayers(roster)).
+			Then(brigadier.Argument("item", brigadier.ItemStackParser{}).
+				Executes(give).
+				Then(brigadier.Argument("count", brigadier.IntegerParser{Min: 1, Max: maxGiveCount}).
+					Executes(give))))
+}
+
+// kickCommand disconnects the targeted players, with the reason shown to them.
+func kickCommand(ps nats.PubSub, roster players.Roster) *brigadier.Node {
+	kick := func(ctx *brigadier.Context) error {
+		targeted, err := targetPlayers(ctx, roster, ctx.Arg("targets").(brigadier.Entities))
+		if err != nil {
+			return err
+		}
+
+		reason := defaultKickReason
+		if value, ok := ctx.Arg("reason").(string); ok {
+			reason = value
+		}
+
+		for _, p := range targeted {
+			if err := ps.Publish(subj.MkPlayerKick(), envelope.PlayerKick(&pb.PlayerKick{
+				ConnId: p.ConnID.String(),
+				Reason: reason,
+			})); err != nil {
+				return fmt.Errorf("failed to publish player kick: %w", err)
+			}
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Kicked %s: %s", p.Username, reason)))
+		}
+		return nil
+	}
+
+	return brigadier.Literal("kick").Requires(PermissionKick).
+		Then(brigadier.Argument("targets", brigadier.EntityParser{PlayersOnly: true}).
+			Suggests(suggestPlayers(roster)).
+			Executes(kick).
+			Then(brigadier.Argument("reason", brigadier.MessageParser{}).Executes(kick)))
+}
+
+// describePlayers names the single player, or counts the players.
+func describePlayers(targeted []*players.Player) string {
+	if len(targeted) == 1 {
+		return targeted[0].Username
+	}
+	return fmt.Sprintf("%d players", len(targeted))
+}
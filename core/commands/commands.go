@@ -0,0 +1,105 @@
+// Package commands contains the commands available on the server and the senders of the commands.
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/core/world"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+)
+
+// Permission nodes required to use the commands, commands not listed here are permitted to everyone.
+const (
+	PermissionOp       = "cncraft.command.op"
+	PermissionTeleport = "cncraft.command.teleport"
+	PermissionGamemode = "cncraft.command.gamemode"
+	PermissionGive     = "cncraft.command.give"
+	PermissionKick     = "cncraft.command.kick"
+	PermissionTime     = "cncraft.command.time"
+	PermissionWeather  = "cncraft.command.weather"
+)
+
+// New provides the dispatcher with all the server commands registered.
+func New(log *zap.Logger, ps nats.PubSub, roster players.Roster, clock *world.Clock) *brigadier.Dispatcher {
+	dispatcher := brigadier.NewDispatcher()
+
+	dispatcher.Register(helpCommand(dispatcher))
+	dispatcher.Register(meCommand(ps))
+	dispatcher.Register(opCommand(log, ps, roster, dispatcher))
+	dispatcher.Register(deopCommand(log, ps, roster, dispatcher))
+	dispatcher.Register(opsCommand(roster))
+	dispatcher.Register(tpCommand(ps, roster))
+	dispatcher.Register(gamemodeCommand(log, ps, roster))
+	dispatcher.Register(giveCommand(ps, roster))
+	dispatcher.Register(kickCommand(ps, roster))
+	dispatcher.Register(timeCommand(clock))
+	dispatcher.Register(weatherCommand(clock))
+
+	return dispatcher
+}
+
+// helpCommand lists the usage of the commands the sender is permitted to use, or of the given command only.
+func helpCommand(dispatcher *brigadier.Dispatcher) *brigadier.Node {
+	listUsage := func(ctx *brigadier.Context) error {
+		lines := dispatcher.Usage(ctx.Sender)
+		if command, ok := ctx.Arg("command").(string); ok {
+			var commandLines []string
+			for _, line := range lines {
+				if line == "/"+command || strings.HasPrefix(line, "/"+command+" ") {
+					commandLines = append(commandLines, line)
+				}
+			}
+			if len(commandLines) == 0 {
+				return brigadier.ErrUnknownCommand
+			}
+			lines = commandLines
+		}
+
+		for _, line := range lines {
+			ctx.Sender.SendMessage(chat.New(line))
+		}
+		return nil
+	}
+
+	suggestCommands := func(ctx *brigadier.Context, _ string) []string {
+		var names []string
+		for _, command := range dispatcher.Tree(ctx.Sender).Children {
+			names = append(names, command.Name)
+		}
+		return names
+	}
+
+	return brigadier.Literal("help").
+		Executes(listUsage).
+		Then(brigadier.Argument("command", brigadier.StringParser{Mode: brigadier.GreedyPhrase}).
+			Suggests(suggestCommands).
+			Executes(listUsage))
+}
+
+// meCommand sends the action of the sender to the chat of every player in the cluster.
+func meCommand(ps nats.PubSub) *brigadier.Node {
+	return brigadier.Literal("me").
+		Then(brigadier.Argument("action", brigadier.MessageParser{}).
+			Executes(func(ctx *brigadier.Context) error {
+				message := chat.New(fmt.Sprintf("* %s %s", ctx.Sender.Name(), ctx.Arg("action").(string)))
+
+				chatMessage := &pb.ChatMessage{Message: message.AsJson(), Position: int32(chat.NormalChat)}
+				if sender, ok := ctx.Sender.(*PlayerSender); ok {
+					chatMessage.SenderId = sender.Player().ID.String()
+				}
+
+				if err := ps.Publish(subj.MkChatMessage(), envelope.ChatMessage(chatMessage)); err != nil {
+					return fmt.Errorf("failed to publish ChatMessage: %w", err)
+				}
+				return nil
+			}))
+}
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+	"github.com/alexykot/cncraft/pkg/game/player"
+)
+
+// opCommand adds the players to the ops list, with the highest op level unless given. Players cannot be granted
+// a higher op level than the sender has.
+func opCommand(log *zap.Logger, ps nats.PubSub, roster players.Roster, dispatcher *brigadier.Dispatcher) *brigadier.Node {
+	op := func(ctx *brigadier.Context) error {
+		opLevel := player.OpLevelOwner
+		if level, ok := ctx.Arg("level").(int32); ok {
+			opLevel = player.OpLevel(level)
+		}
+		if sender, ok := ctx.Sender.(*PlayerSender); ok && opLevel > sender.Player().GetPermissions().OpLevel {
+			return brigadier.Fail("You cannot grant a higher op level than your own")
+		}
+
+		return setOpLevel(ctx, log, ps, roster, dispatcher, opLevel, "Made %s a server operator")
+	}
+
+	return brigadier.Literal("op").Requires(PermissionOp).
+		Then(brigadier.Argument("targets", brigadier.EntityParser{PlayersOnly: true}).
+			Suggests(suggestPlayers(roster)).
+			Executes(op).
+			Then(brigadier.Argument("level", brigadier.IntegerParser{
+				Min: int32(player.OpLevelModerator),
+				Max: int32(player.OpLevelOwner),
+			}).Executes(op)))
+}
+
+// deopCommand removes the players from the ops list.
+func deopCommand(log *zap.Logger, ps nats.PubSub, roster players.Roster, dispatcher *brigadier.Dispatcher) *brigadier.Node {
+	return brigadier.Literal("deop").Requires(PermissionOp).
+		Then(brigadier.Argument("targets", brigadier.EntityParser{PlayersOnly: true}).
+			Suggests(suggestPlayers(roster)).
+			Executes(func(ctx *brigadier.Context) error {
+				return setOpLevel(ctx, log, ps, roster, dispatcher, player.OpLevelNone, "Made %s no longer a server operator")
+			}))
+}
+
+// opsCommand lists the players in the ops list along with their op levels.
+func opsCommand(roster players.Roster) *brigadier.Node {
+	return brigadier.Literal("ops").Requires(PermissionOp).
+		Executes(func(ctx *brigadier.Context) error {
+			operators, err := roster.GetOperators()
+			if err != nil {
+				return fmt.Errorf("failed to get operators: %w", err)
+			}
+			if len(operators) == 0 {
+				ctx.Sender.SendMessage(chat.New("There are no server operators"))
+				return nil
+			}
+
+			names := make([]string, 0, len(operators))
+			for name := range operators {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			listed := make([]string, len(names))
+			for i, name := range names {
+				listed[i] = fmt.Sprintf("%s (level %d)", name, operators[name])
+			}
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("There are %d server operators: %s",
+				len(listed), strings.Join(listed, ", "))))
+			return nil
+		})
+}
+
+// setOpLevel sets the op level of the targeted players. The players connected to this node are told their
+// new op level and the commands they are permitted to use now.
+func setOpLevel(ctx *brigadier.Context, log *zap.Logger, ps nats.PubSub, roster players.Roster,
+	dispatcher *brigadier.Dispatcher, opLevel player.OpLevel, feedback string) error {
+	names, err := targetNames(ctx, roster, ctx.Arg("targets").(brigadier.Entities))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := roster.SetPlayerOpLevel(name, opLevel); errors.Is(err, players.ErrUnknownPlayer) {
+			return brigadier.Fail("Player %s has never joined the server", name)
+		} else if err != nil {
+			return fmt.Errorf("failed to set op level of player %s: %w", name, err)
+		}
+		ctx.Sender.SendMessage(chat.New(fmt.Sprintf(feedback, name)))
+
+		p, ok := roster.GetPlayerByUsername(name)
+		if !ok {
+			continue
+		}
+		lopes := PermissionLopes(dispatcher, NewPlayerSender(log, ps, p))
+		if err := ps.Publish(subj.MkConnTransmit(p.ConnID), lopes...); err != nil {
+			return fmt.Errorf("failed to publish player permissions: %w", err)
+		}
+	}
+	return nil
+}
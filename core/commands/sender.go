@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+// PlayerSender - player sending the commands, the command feedback goes into the chat of the player.
+type PlayerSender struct {
+	log    *zap.Logger
+	ps     nats.PubSub
+	player *players.Player
+}
+
+func NewPlayerSender(log *zap.Logger, ps nats.PubSub, player *players.Player) *PlayerSender {
+	return &PlayerSender{log: log, ps: ps, player: player}
+}
+
+func (s *PlayerSender) Name() string { return s.player.Username }
+
+func (s *PlayerSender) Player() *players.Player { return s.player }
+
+// HasPermission tells if the player has the permission, as cached in the roster.
+func (s *PlayerSender) HasPermission(permission string) bool {
+	return s.player.GetPermissions().Has(permission)
+}
+
+func (s *PlayerSender) SendMessage(message *chat.Message) {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CChatMessage)
+	feedback := cpacket.(*protocol.CPacketChatMessage)
+	feedback.Message = *message
+	feedback.MessagePosition = chat.SystemChat
+
+	if err := s.ps.Publish(subj.MkConnTransmit(s.player.ConnID), envelope.MkCpacketEnvelope(feedback)); err != nil {
+		s.log.Error("failed to publish command feedback", zap.String("player", s.player.Username), zap.Error(err))
+	}
+}
+
+// PermissionLopes provides the packets telling the player its op level and declaring the commands the player is
+// permitted to use. Sent on join and whenever the permissions of the player change.
+func PermissionLopes(dispatcher *brigadier.Dispatcher, sender *PlayerSender) []*envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CEntityStatus)
+	entityStatus := cpacket.(*protocol.CPacketEntityStatus)
+	entityStatus.EntityID = sender.player.PC.ID()
+	entityStatus.Status = sender.player.GetPermissions().OpLevel.EntityStatus()
+
+	cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CDeclareCommands)
+	declareCommands := cpacket.(*protocol.CPacketDeclareCommands)
+	declareCommands.Root = dispatcher.Tree(sender)
+
+	return []*envelope.E{envelope.MkCpacketEnvelope(entityStatus), envelope.MkCpacketEnvelope(declareCommands)}
+}
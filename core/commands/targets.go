@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"math/rand"
+
+	"github.com/alexykot/cncraft/core/players"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+)
+
+// targetPlayers resolves the entities argument into the players connected to this node.
+// DEBT players connected to other nodes in the cluster cannot be targeted.
+func targetPlayers(ctx *brigadier.Context, roster players.Roster, targets brigadier.Entities) ([]*players.Player, error) {
+	if targets.Selector == "" {
+		p, ok := roster.GetPlayerByUsername(targets.Name)
+		if !ok {
+			return nil, brigadier.Fail("No player was found")
+		}
+		return []*players.Player{p}, nil
+	}
+
+	all := roster.GetPlayers()
+	if len(all) == 0 {
+		return nil, brigadier.Fail("No player was found")
+	}
+
+	switch targets.Selector {
+	case brigadier.SelectAllPlayers, brigadier.SelectAllEntities:
+		return all, nil
+	case brigadier.SelectRandomPlayer:
+		return []*players.Player{all[rand.Intn(len(all))]}, nil
+	}
+
+	sender, ok := ctx.Sender.(*PlayerSender)
+	if !ok {
+		return nil, brigadier.Fail("A player is required to run this command here")
+	}
+	if targets.Selector == brigadier.SelectSelf {
+		return []*players.Player{sender.Player()}, nil
+	}
+
+	// the nearest player in the same dimension, the sender itself included
+	origin := sender.Player().GetLocation().PositionF
+	dimension := sender.Player().GetState().Dimension
+	nearest, nearestDistance := sender.Player(), -1.0
+	for _, p := range all {
+		if p.GetState().Dimension != dimension {
+			continue
+		}
+		pos := p.GetLocation().PositionF
+		distance := (pos.X-origin.X)*(pos.X-origin.X) + (pos.Y-origin.Y)*(pos.Y-origin.Y) + (pos.Z-origin.Z)*(pos.Z-origin.Z)
+		if nearestDistance < 0 || distance < nearestDistance {
+			nearest, nearestDistance = p, distance
+		}
+	}
+	return []*players.Player{nearest}, nil
+}
+
+// targetNames resolves the entities argument into the player names. Players targeted by name may be offline.
+func targetNames(ctx *brigadier.Context, roster players.Roster, targets brigadier.Entities) ([]string, error) {
+	if targets.Selector == "" {
+		return []string{targets.Name}, nil
+	}
+
+	targeted, err := targetPlayers(ctx, roster, targets)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(targeted))
+	for i, p := range targeted {
+		names[i] = p.Username
+	}
+	return names, nil
+}
+
+// suggestPlayers suggests the names of the players connected to this node.
+func suggestPlayers(roster players.Roster) brigadier.Suggester {
+	return func(_ *brigadier.Context, _ string) []string {
+		var names []string
+		for _, p := range roster.GetPlayers() {
+			names = append(names, p.Username)
+		}
+		return names
+	}
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/alexykot/cncraft/core/world"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
+	"github.com/alexykot/cncraft/pkg/game"
+)
+
+// maxWeatherDuration - longest weather duration allowed, in seconds, same as in the Notchian server.
+const maxWeatherDuration = 1000000
+
+// timeCommand sets, moves forward or tells the time of day.
+func timeCommand(clock *world.Clock) *brigadier.Node {
+	setTime := func(timeOfDay int64) brigadier.Executor {
+		return func(ctx *brigadier.Context) error {
+			timeOfDay := timeOfDay
+			if ticks, ok := ctx.Arg("time").(int32); ok {
+				timeOfDay = int64(ticks)
+			}
+			clock.SetTime(timeOfDay)
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Set the time to %d", timeOfDay)))
+			return nil
+		}
+	}
+
+	queryTime := func(query func(age, timeOfDay int64) int64) brigadier.Executor {
+		return func(ctx *brigadier.Context) error {
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("The time is %d", query(clock.Time()))))
+			return nil
+		}
+	}
+
+	return brigadier.Literal("time").Requires(PermissionTime).
+		Then(brigadier.Literal("set").
+			Then(brigadier.Literal("day").Executes(setTime(world.TimeDay))).
+			Then(brigadier.Literal("noon").Executes(setTime(world.TimeNoon))).
+			Then(brigadier.Literal("night").Executes(setTime(world.TimeNight))).
+			Then(brigadier.Literal("midnight").Executes(setTime(world.TimeMidnight))).
+			Then(brigadier.Argument("time", brigadier.TimeParser{}).Executes(setTime(0)))).
+		Then(brigadier.Literal("add").
+			Then(brigadier.Argument("time", brigadier.TimeParser{}).
+				Executes(func(ctx *brigadier.Context) error {
+					timeOfDay := clock.AddTime(int64(ctx.Arg("time").(int32)))
+					ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Set the time to %d", timeOfDay)))
+					return nil
+				}))).
+		Then(brigadier.Literal("query").
+			Then(brigadier.Literal("daytime").Executes(queryTime(func(_, timeOfDay int64) int64 {
+				return timeOfDay % world.TicksPerDay
+			}))).
+			Then(brigadier.Literal("gametime").Executes(queryTime(func(age, _ int64) int64 {
+				return age
+			}))).
+			Then(brigadier.Literal("day").Executes(queryTime(func(_, timeOfDay int64) int64 {
+				return timeOfDay / world.TicksPerDay
+			}))))
+}
+
+// weatherCommand sets the weather, for the given duration in seconds or for a random duration, same as
+// the Notchian server does.
+func weatherCommand(clock *world.Clock) *brigadier.Node {
+	command := brigadier.Literal("weather").Requires(PermissionWeather)
+
+	for _, weather := range []struct {
+		weather game.Weather
+		literal string
+		name    string
+	}{
+		{game.WeatherClear, "clear", "clear"},
+		{game.WeatherRain, "rain", "rain"},
+		{game.WeatherThunder, "thunder", "rain & thunder"},
+	} {
+		weather := weather
+		setWeather := func(ctx *brigadier.Context) error {
+			duration := int32(300+rand.Intn(600)) * game.TicksPerSecond
+			if seconds, ok := ctx.Arg("duration").(int32); ok {
+				duration = seconds * game.TicksPerSecond
+			}
+
+			clock.SetWeather(weather.weather, duration)
+			ctx.Sender.SendMessage(chat.New(fmt.Sprintf("Set the weather to %s", weather.name)))
+			return nil
+		}
+
+		command.Then(brigadier.Literal(weather.literal).
+			Executes(setWeather).
+			Then(brigadier.Argument("duration", brigadier.IntegerParser{Min: 0, Max: maxWeatherDuration}).
+				Executes(setWeather)))
+	}
+	return command
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/protocol/auth/mojang"
 )
 
 type ServerConf struct {
@@ -12,6 +14,14 @@ type ServerConf struct {
 	Brand     string // Server brand. Is always set to `CNCraft` and cannot be changed.
 	IsCracked bool   `yaml:"is-cracked"` // if True - skip player authentication, connection encryption and compression. Set to False by default.
 
+	// URL of the session server the players are authenticated against, unless the server is cracked. Any server
+	// implementing the Mojang session server API will do. Set to the Mojang session server by default.
+	SessionServerURL string `yaml:"session-server-url"`
+
+	// Forwarding of the client details by the proxy the server runs behind. Only applies to the cracked servers,
+	// the proxy authenticates the players instead.
+	Forwarding ForwardingConf `yaml:"forwarding"`
+
 	World WorldConf `yaml:"world"` // Configuration of the world to load. Only one world per server at a time supported.
 
 	DBURL string      `yaml:"db-url"` // URL of the postgres server
@@ -24,16 +34,53 @@ type NetworkConf struct {
 	Host        string `yaml:"host"` // resolvable hostname/IP to bind to. Set to `localhost` by default.
 	Port        int    `yaml:"port"` // TCP port to serve on. Set to 25566 by default.
 	ZipTreshold int32  // size of packet in bytes from which to start compressing the packets. Cannot be set externally.
+
+	// If True - every connection is expected to start with the HAProxy PROXY protocol v1 or v2 header, providing
+	// the real address of the client connected to the load balancer. Set to False by default.
+	ProxyProtocol bool `yaml:"proxy-protocol"`
+}
+
+// ForwardingMode - the way the proxy forwards the client details.
+type ForwardingMode string
+
+const (
+	ForwardingNone   ForwardingMode = "none"   // nothing is forwarded, the clients connect directly
+	ForwardingLegacy ForwardingMode = "legacy" // BungeeCord forwarding, the server must only be reachable through the proxy
+	ForwardingModern ForwardingMode = "modern" // Velocity forwarding, signed with the secret shared with the proxy
+)
+
+type ForwardingConf struct {
+	Mode   ForwardingMode `yaml:"mode"`   // one of `none`, `legacy` or `modern`. Set to `none` by default.
+	Secret string         `yaml:"secret"` // secret shared with Velocity, required for the `modern` mode.
 }
 
 type WorldConf struct {
 	// ID of the world to load. Must be a 36-char UUID string. Identifies a world saved in persistence, server will
-	// fail to start if the world with this ID is not found. World must be pre-created separately using migration tools.
+	// fail to start if the world with this ID is not found, unless CreateMissing is set. World should be pre-created
+	// separately using `tools world create` or `tools world import`.
 	WorldID uuid.UUID `yaml:"world-id"`
 
+	// If True - a default flat world is created with the WorldID on the first start, if the world is not found.
+	// Set to False by default.
+	CreateMissing bool `yaml:"create-missing"`
+
 	// World shard size in *chunks*. Will be used as XxZ size of the shard, i.e. will be 10x10 chunks per shard
 	// if set to 10 (default). Min 1, max 64.
-	ShardSize           int  `yaml:"shard-size"`
+	ShardSize int `yaml:"shard-size"`
+
+	// Interval in *seconds* between saving the changed world chunks into persistence. Changed chunks are also
+	// saved on server shutdown. Min 1, 60 by default.
+	SaveInterval int `yaml:"save-interval"`
+
+	// Time in *seconds* a loaded chunk stays in memory after it was last used. Chunks are loaded on demand, when
+	// a player sees them or the world handlers access them, and idle ones are saved and unloaded at every
+	// save interval. Min 1, 300 by default.
+	ChunkIdleTimeout int `yaml:"chunk-idle-timeout"`
+
+	// Maximum view distance in *chunks*, the view distance requested by clients is clamped to it. Min 2, max 32,
+	// 10 by default.
+	MaxViewDistance int32 `yaml:"max-view-distance"`
+
 	EnableRespawnScreen bool // Enable respawn screen or tell client to respawn immediately.
 }
 
@@ -70,6 +117,9 @@ func GetCurrentConfig() ServerConf {
 	return currentConf
 }
 
+// DefaultWorldID is the ID of the world used by the default development config.
+var DefaultWorldID = uuid.MustParse("6e3ad5d4-7a3c-4e8b-9c1a-0d1f2c3b4a59")
+
 // GetDefaultConfig is a temporary function to provide a workable hardcoded development config. Eventually it will be
 // replaced with a proper external configuration loader.
 func GetDefaultConfig() ServerConf {
@@ -79,8 +129,12 @@ func GetDefaultConfig() ServerConf {
 		IsCracked: true,
 
 		World: WorldConf{
-			WorldID:             uuid.New(),
+			WorldID:             DefaultWorldID,
+			CreateMissing:       true,
 			ShardSize:           3,
+			SaveInterval:        60,
+			ChunkIdleTimeout:    300,
+			MaxViewDistance:     10,
 			EnableRespawnScreen: true,
 		},
 
@@ -107,10 +161,30 @@ func GetDefaultConfig() ServerConf {
 }
 
 func addDefaults(conf ServerConf) ServerConf {
+	if conf.SessionServerURL == "" {
+		conf.SessionServerURL = mojang.DefaultSessionServerURL
+	}
+
+	if conf.Forwarding.Mode == "" {
+		conf.Forwarding.Mode = ForwardingNone
+	}
+
 	if conf.World.ShardSize < 1 || conf.World.ShardSize > 64 {
 		conf.World.ShardSize = 10
 	}
 
+	if conf.World.SaveInterval < 1 {
+		conf.World.SaveInterval = 60
+	}
+
+	if conf.World.ChunkIdleTimeout < 1 {
+		conf.World.ChunkIdleTimeout = 300
+	}
+
+	if conf.World.MaxViewDistance < 2 || conf.World.MaxViewDistance > 32 {
+		conf.World.MaxViewDistance = 10
+	}
+
 	if conf.Log.Baseline == "" {
 		conf.Log.Baseline = "ERROR"
 	}
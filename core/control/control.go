@@ -18,6 +18,8 @@ const (
 	WORLD      Component = "world"
 	EVENTS     Component = "events"
 	SHARDER    Component = "sharder"
+	STREAMER   Component = "streamer"
+	CLOCK      Component = "clock"
 	ROSTER     Component = "roster"
 	DB         Component = "db"
 )
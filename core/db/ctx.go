@@ -11,6 +11,11 @@ const queryTimeout = time.Second * 30
 
 // CtxWithCancel provides a context with timeout suitable for database queries, with the corresponding cancelFunc
 func CtxWithCancel() (context.Context, context.CancelFunc) {
+	// Once the server context is cancelled the queries flushing the world and player state during the shutdown
+	// sequence still need to run, so they get a context detached from the server one.
+	if dbCtx == nil || dbCtx.Err() != nil {
+		return context.WithTimeout(context.Background(), queryTimeout)
+	}
 	return context.WithTimeout(dbCtx, queryTimeout)
 }
 
@@ -48,6 +48,12 @@ func Init(ctx context.Context, ctrlChan chan control.Command, ps nats.PubSub, db
 	}
 }
 
+// Migrate brings the database schema up to date. Meant for tooling working with the database without starting the
+// server, the server migrates the database itself in Init.
+func Migrate(db *sql.DB) error {
+	return migrateDB(db)
+}
+
 func migrateDB(db *sql.DB) error {
 	s := bindata.Resource(AssetNames(), func(name string) (bytes []byte, e error) {
 		return Asset(name)
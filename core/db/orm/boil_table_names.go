@@ -4,9 +4,25 @@
 package orm
 
 var TableNames = struct {
-	Inventory string
-	Players   string
+	BlockEntities   string
+	Dimensions      string
+	Inventory       string
+	Operators       string
+	PlayerRoles     string
+	Players         string
+	RolePermissions string
+	Roles           string
+	Sections        string
+	Worlds          string
 }{
-	Inventory: "inventory",
-	Players:   "players",
+	BlockEntities:   "block_entities",
+	Dimensions:      "dimensions",
+	Inventory:       "inventory",
+	Operators:       "operators",
+	PlayerRoles:     "player_roles",
+	Players:         "players",
+	RolePermissions: "role_permissions",
+	Roles:           "roles",
+	Sections:        "sections",
+	Worlds:          "worlds",
 }
@@ -59,27 +59,6 @@ var InventoryTableColumns = struct {
 
 // Generated where
 
-type whereHelperuuid_UUID struct{ field string }
-
-func (w whereHelperuuid_UUID) EQ(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelperuuid_UUID) NEQ(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperuuid_UUID) LT(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelperuuid_UUID) LTE(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelperuuid_UUID) GT(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelperuuid_UUID) GTE(x uuid.UUID) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelperint16 struct{ field string }
 
 func (w whereHelperint16) EQ(x int16) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
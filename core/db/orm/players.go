@@ -123,29 +123,6 @@ func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelperfloat64 struct{ field string }
 
 func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
@@ -184,27 +161,6 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var PlayerWhere = struct {
 	ID            whereHelperuuid_UUID
 	ConnID        whereHelpernull_String
@@ -235,14 +191,20 @@ var PlayerWhere = struct {
 
 // PlayerRels is where relationship names are stored.
 var PlayerRels = struct {
-	Inventories string
+	Operator      string
+	Inventories   string
+	RoleNameRoles string
 }{
-	Inventories: "Inventories",
+	Operator:      "Operator",
+	Inventories:   "Inventories",
+	RoleNameRoles: "RoleNameRoles",
 }
 
 // playerR is where relationships are stored.
 type playerR struct {
-	Inventories InventorySlice `boil:"Inventories" json:"Inventories" toml:"Inventories" yaml:"Inventories"`
+	Operator      *Operator      `boil:"Operator" json:"Operator" toml:"Operator" yaml:"Operator"`
+	Inventories   InventorySlice `boil:"Inventories" json:"Inventories" toml:"Inventories" yaml:"Inventories"`
+	RoleNameRoles RoleSlice      `boil:"RoleNameRoles" json:"RoleNameRoles" toml:"RoleNameRoles" yaml:"RoleNameRoles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -351,6 +313,20 @@ func (q playerQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (boo
 	return count > 0, nil
 }
 
+// Operator pointed to by the foreign key.
+func (o *Player) Operator(mods ...qm.QueryMod) operatorQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"player_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Operators(queryMods...)
+	queries.SetFrom(query.Query, "\"cncraft\".\"operators\"")
+
+	return query
+}
+
 // Inventories retrieves all the inventory's Inventories with an executor.
 func (o *Player) Inventories(mods ...qm.QueryMod) inventoryQuery {
 	var queryMods []qm.QueryMod
@@ -372,6 +348,121 @@ func (o *Player) Inventories(mods ...qm.QueryMod) inventoryQuery {
 	return query
 }
 
+// RoleNameRoles retrieves all the role's Roles with an executor via name column.
+func (o *Player) RoleNameRoles(mods ...qm.QueryMod) roleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"cncraft\".\"player_roles\" on \"cncraft\".\"roles\".\"name\" = \"cncraft\".\"player_roles\".\"role_name\""),
+		qm.Where("\"cncraft\".\"player_roles\".\"player_id\"=?", o.ID),
+	)
+
+	query := Roles(queryMods...)
+	queries.SetFrom(query.Query, "\"cncraft\".\"roles\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"cncraft\".\"roles\".*"})
+	}
+
+	return query
+}
+
+// LoadOperator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (playerL) LoadOperator(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlayer interface{}, mods queries.Applicator) error {
+	var slice []*Player
+	var object *Player
+
+	if singular {
+		object = maybePlayer.(*Player)
+	} else {
+		slice = *maybePlayer.(*[]*Player)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playerR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`cncraft.operators`),
+		qm.WhereIn(`cncraft.operators.player_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Operator")
+	}
+
+	var resultSlice []*Operator
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Operator")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for operators")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for operators")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Operator = foreign
+		if foreign.R == nil {
+			foreign.R = &operatorR{}
+		}
+		foreign.R.Player = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ID, foreign.PlayerID) {
+				local.R.Operator = foreign
+				if foreign.R == nil {
+					foreign.R = &operatorR{}
+				}
+				foreign.R.Player = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadInventories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (playerL) LoadInventories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlayer interface{}, mods queries.Applicator) error {
@@ -463,6 +554,164 @@ func (playerL) LoadInventories(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
+// LoadRoleNameRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (playerL) LoadRoleNameRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlayer interface{}, mods queries.Applicator) error {
+	var slice []*Player
+	var object *Player
+
+	if singular {
+		object = maybePlayer.(*Player)
+	} else {
+		slice = *maybePlayer.(*[]*Player)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &playerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &playerR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"cncraft\".\"roles\".name, \"cncraft\".\"roles\".op_level, \"cncraft\".\"roles\".created_at, \"a\".\"player_id\""),
+		qm.From("\"cncraft\".\"roles\""),
+		qm.InnerJoin("\"cncraft\".\"player_roles\" as \"a\" on \"cncraft\".\"roles\".\"name\" = \"a\".\"role_name\""),
+		qm.WhereIn("\"a\".\"player_id\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load roles")
+	}
+
+	var resultSlice []*Role
+
+	var localJoinCols []uuid.UUID
+	for results.Next() {
+		one := new(Role)
+		var localJoinCol uuid.UUID
+
+		err = results.Scan(&one.Name, &one.OpLevel, &one.CreatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for roles")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice roles")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if singular {
+		object.R.RoleNameRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &roleR{}
+			}
+			foreign.R.Players = append(foreign.R.Players, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if queries.Equal(local.ID, localJoinCol) {
+				local.R.RoleNameRoles = append(local.R.RoleNameRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Players = append(foreign.R.Players, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOperator of the player to the related item.
+// Sets o.R.Operator to related.
+// Adds o to related.R.Player.
+func (o *Player) SetOperator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Operator) error {
+	var err error
+
+	if insert {
+		queries.Assign(&related.PlayerID, o.ID)
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"cncraft\".\"operators\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"player_id"}),
+			strmangle.WhereClause("\"", "\"", 2, operatorPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.PlayerID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		queries.Assign(&related.PlayerID, o.ID)
+	}
+
+	if o.R == nil {
+		o.R = &playerR{
+			Operator: related,
+		}
+	} else {
+		o.R.Operator = related
+	}
+
+	if related.R == nil {
+		related.R = &operatorR{
+			Player: o,
+		}
+	} else {
+		related.R.Player = o
+	}
+	return nil
+}
+
 // AddInventories adds the given related objects to the existing relationships
 // of the player, optionally inserting them as new records.
 // Appends related to o.R.Inventories.
@@ -516,6 +765,150 @@ func (o *Player) AddInventories(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddRoleNameRoles adds the given related objects to the existing relationships
+// of the player, optionally inserting them as new records.
+// Appends related to o.R.RoleNameRoles.
+// Sets related.R.Players appropriately.
+func (o *Player) AddRoleNameRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"cncraft\".\"player_roles\" (\"player_id\", \"role_name\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.Name}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &playerR{
+			RoleNameRoles: related,
+		}
+	} else {
+		o.R.RoleNameRoles = append(o.R.RoleNameRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &roleR{
+				Players: PlayerSlice{o},
+			}
+		} else {
+			rel.R.Players = append(rel.R.Players, o)
+		}
+	}
+	return nil
+}
+
+// SetRoleNameRoles removes all previously related items of the
+// player replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Players's RoleNameRoles accordingly.
+// Replaces o.R.RoleNameRoles with related.
+// Sets related.R.Players's RoleNameRoles accordingly.
+func (o *Player) SetRoleNameRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Role) error {
+	query := "delete from \"cncraft\".\"player_roles\" where \"player_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeRoleNameRolesFromPlayersSlice(o, related)
+	if o.R != nil {
+		o.R.RoleNameRoles = nil
+	}
+	return o.AddRoleNameRoles(ctx, exec, insert, related...)
+}
+
+// RemoveRoleNameRoles relationships from objects passed in.
+// Removes related items from R.RoleNameRoles (uses pointer comparison, removal does not keep order)
+// Sets related.R.Players.
+func (o *Player) RemoveRoleNameRoles(ctx context.Context, exec boil.ContextExecutor, related ...*Role) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"cncraft\".\"player_roles\" where \"player_id\" = $1 and \"role_name\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.Name)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeRoleNameRolesFromPlayersSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RoleNameRoles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RoleNameRoles)
+			if ln > 1 && i < ln-1 {
+				o.R.RoleNameRoles[i] = o.R.RoleNameRoles[ln-1]
+			}
+			o.R.RoleNameRoles = o.R.RoleNameRoles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeRoleNameRolesFromPlayersSlice(o *Player, related []*Role) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Players {
+			if !queries.Equal(o.ID, ri.ID) {
+				continue
+			}
+
+			ln := len(rel.R.Players)
+			if ln > 1 && i < ln-1 {
+				rel.R.Players[i] = rel.R.Players[ln-1]
+			}
+			rel.R.Players = rel.R.Players[:ln-1]
+			break
+		}
+	}
+}
+
 // Players retrieves all the records using an executor.
 func Players(mods ...qm.QueryMod) playerQuery {
 	mods = append(mods, qm.From("\"cncraft\".\"players\""))
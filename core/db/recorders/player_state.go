@@ -155,8 +155,14 @@ func handlePlayerSpatial(log *zap.Logger, db *sql.DB) func(lope *envelope.E) {
 
 		player.OnGround = spatial.OnGround
 
+		if player.DimensionID, err = uuid.Parse(spatial.DimensionId); err != nil {
+			log.Error("failed to parse dimension ID", zap.String("id", spatial.DimensionId), zap.Error(err))
+			return
+		}
+
 		if _, err = player.Update(getCtx(), db,
 			boil.Whitelist(
+				orm.PlayerColumns.DimensionID,
 				orm.PlayerColumns.PositionX, orm.PlayerColumns.PositionY, orm.PlayerColumns.PositionZ,
 				orm.PlayerColumns.Yaw, orm.PlayerColumns.Pitch, orm.PlayerColumns.OnGround,
 			)); err != nil {
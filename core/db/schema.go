@@ -2,6 +2,16 @@
 // sources:
 // schema/001_cncraft.down.sql
 // schema/001_players.up.sql
+// schema/002_sections.down.sql
+// schema/002_sections.up.sql
+// schema/003_worlds.down.sql
+// schema/003_worlds.up.sql
+// schema/004_block_entities.down.sql
+// schema/004_block_entities.up.sql
+// schema/005_permissions.down.sql
+// schema/005_permissions.up.sql
+// schema/006_admin_permissions.down.sql
+// schema/006_admin_permissions.up.sql
 package db
 
 import (
@@ -118,6 +128,206 @@ func _001_playersUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __002_sectionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x27\x00\xd8\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x63\x6e\x63\x72\x61\x66\x74\x2e\x73\x65\x63\x74\x69\x6f\x6e\x73\x3b\x0a\x03\x00\x33\x18\x31\x12\x27\x00\x00\x00")
+
+func _002_sectionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__002_sectionsDownSql,
+		"002_sections.down.sql",
+	)
+}
+
+func _002_sectionsDownSql() (*asset, error) {
+	bytes, err := _002_sectionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "002_sections.down.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __002_sectionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xd1\x4a\xc3\x30\x14\x86\xef\xfb\x14\xe7\x72\x83\xe2\x0b\x78\x95\x69\x98\xc1\x34\x1d\xdd\x29\x32\xc7\x08\x59\x12\x31\xac\xa6\xa5\xcd\x70\xec\xe9\x65\xd9\x82\x4e\x99\xce\xe4\xe6\xfc\xe7\xf0\xf1\xf3\xe7\xe4\xae\xa2\x04\x29\x20\x99\x70\x0a\xda\xeb\x5e\xbd\x84\x9b\xc1\xea\xe0\x5a\x3f\x64\xa3\x0c\x00\xe0\xbd\xed\x1b\x23\x9d\x81\xe3\xa9\x6b\x76\x7f\x2a\x7f\x5c\x51\x22\x88\x9a\xf3\x3c\x82\xc6\xbd\x59\x3f\xb8\xd6\x1f\xe1\xeb\x41\xfd\xba\xf5\x1b\xb9\x4b\xc3\x09\x9b\x32\x81\x49\xfd\x0d\xee\xff\x0f\x9e\x22\x4b\xe7\x8d\xdd\x01\xcc\x0b\xc2\xf9\x05\xf4\x13\x8c\x21\xd7\x2e\x0c\xb2\xb3\xbd\x5c\x37\xad\xde\x5c\x49\x1e\x64\xa7\x1a\x1b\x82\x4d\x43\x26\x90\x4e\x69\xb5\x5c\xa5\xc6\x45\x30\x1a\x49\xa3\x82\xfa\x12\x72\xb9\xfa\xdd\x31\x92\xdb\xce\xa8\x60\x8d\x54\xe1\xa0\x00\x59\x41\xe7\x48\x8a\x19\x3c\x31\x7c\x28\x6b\x8c\x1d\x78\x2e\x05\xfd\x66\x39\xab\x58\x41\xaa\x05\x3c\xd2\x05\x8c\xd2\x7f\xc8\xcf\x16\x9c\xa7\xad\xa5\x62\x9f\x9f\xbf\xea\x38\x1b\xdf\x66\x1f\x03\x00\xac\x90\xe0\xb2\x70\x02\x00\x00")
+
+func _002_sectionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__002_sectionsUpSql,
+		"002_sections.up.sql",
+	)
+}
+
+func _002_sectionsUpSql() (*asset, error) {
+	bytes, err := _002_sectionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "002_sections.up.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __003_worldsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x4e\x00\xb1\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x63\x6e\x63\x72\x61\x66\x74\x2e\x64\x69\x6d\x65\x6e\x73\x69\x6f\x6e\x73\x3b\x0a\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x63\x6e\x63\x72\x61\x66\x74\x2e\x77\x6f\x72\x6c\x64\x73\x3b\x0a\x03\x00\xc1\xc4\xe7\x5b\x4e\x00\x00\x00")
+
+func _003_worldsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__003_worldsDownSql,
+		"003_worlds.down.sql",
+	)
+}
+
+func _003_worldsDownSql() (*asset, error) {
+	bytes, err := _003_worldsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "003_worlds.down.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __003_worldsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\x9b\x30\x10\xbd\xf3\x2b\xe6\x18\xa4\x55\xd5\x56\x55\x55\x69\x4f\x06\x26\x5d\x54\x03\x2b\x30\xad\xd2\x0b\xb2\x6c\x27\xb5\x1a\x20\x02\xf7\x23\xfd\xf5\x95\x20\x0e\x81\x42\x13\x45\x3b\x27\x6c\xde\x7b\x33\xe3\x99\xe7\xa7\x48\x18\x02\x23\x1e\x45\x10\x95\x68\xf8\xd6\xbc\xfa\x55\x37\x7b\xd9\x3a\x2b\x07\x00\x40\x4b\x98\x46\x9e\x87\x81\xfd\x9e\x46\x9c\x30\x88\x73\x4a\x1f\x3a\x72\xc5\x4b\x65\xff\x9c\xe2\x33\x49\xfd\x27\x92\xae\xde\xbc\xfd\xe0\xda\xbb\x79\x72\xab\xd4\x34\xb7\xb7\x61\x48\xec\xe1\xbf\xe4\xae\x87\xc2\x1c\x0f\x43\xfe\x2c\x22\x94\x86\x31\xb3\xe7\x39\x32\x04\xb8\x26\x39\x65\xf0\xba\xaf\xe1\x1b\x6f\xa4\xa8\x9b\xcb\x26\xbc\x24\xa1\xf6\xfb\xaa\xcc\x9a\xd0\x0c\x7b\xa9\x1d\x2f\x55\x59\xcb\x4b\xa9\x7b\x2a\x92\x7a\xbb\xd5\xe2\xc7\xde\x1c\x5f\x48\xa6\xd8\xd7\xe2\xbb\x92\xf7\x37\x26\x75\xa9\xaa\x56\xd7\x55\x21\x6a\xa9\xc4\xe5\x90\xdf\xbf\x73\x17\xa5\x4e\x43\x36\xbc\x31\xc5\x20\xa1\xe5\x8d\xeb\xd5\xb1\x45\xa3\xb8\x51\xb2\xe0\xc6\x02\x80\x85\x11\x66\x8c\x44\xcf\xf0\x25\x64\x4f\x49\xce\xba\x1b\xf8\x9a\xc4\x38\x49\xfd\x9c\x86\x11\x49\x37\xf0\x09\x37\xb0\xd2\xd2\x75\xdc\x47\xc7\x99\x35\xc4\xb9\xbc\x25\x53\x8c\x4a\x1e\x67\xe9\x17\x71\x20\x74\xd0\x14\xd7\x98\x62\xec\x63\x36\x31\x5d\x57\x08\x24\x31\x04\x48\x91\x21\xf8\x24\xf3\x49\x80\xf3\x6e\x1a\x59\x69\x9c\x75\x78\xd0\xde\x02\x0b\xd0\x4e\x56\xc9\x9d\x2a\x2a\xb5\xe3\x46\xff\x54\xc5\x6f\xf0\xc2\x8f\xc3\x32\x8d\x65\xc7\xd0\x3f\x57\xa1\x87\xba\xd5\x37\xaa\x9e\xa1\x8b\xaa\xff\x0e\xcd\xbe\xed\x03\x68\xe9\x3a\xee\xa3\xf3\x77\x00\x52\xab\x18\x90\xcf\x04\x00\x00")
+
+func _003_worldsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__003_worldsUpSql,
+		"003_worlds.up.sql",
+	)
+}
+
+func _003_worldsUpSql() (*asset, error) {
+	bytes, err := _003_worldsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "003_worlds.up.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __004_block_entitiesDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x2d\x00\xd2\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x63\x6e\x63\x72\x61\x66\x74\x2e\x62\x6c\x6f\x63\x6b\x5f\x65\x6e\x74\x69\x74\x69\x65\x73\x3b\x0a\x03\x00\x68\xe9\x51\x40\x2d\x00\x00\x00")
+
+func _004_block_entitiesDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__004_block_entitiesDownSql,
+		"004_block_entities.down.sql",
+	)
+}
+
+func _004_block_entitiesDownSql() (*asset, error) {
+	bytes, err := _004_block_entitiesDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "004_block_entities.down.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __004_block_entitiesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd2\xcf\x4b\xc3\x30\x14\x07\xf0\x7b\xff\x8a\xef\x71\x85\xe0\x49\xbc\x78\x4a\x67\x70\xc1\xfe\x18\x35\x55\xea\x25\xc4\x26\x62\xd8\x4c\xc7\x96\xe1\xda\xbf\x5e\x1a\xcc\x41\x71\x68\xcd\xe9\xbd\xe4\x7d\x78\x90\xf7\x96\x35\xa3\x82\x41\xd0\x2c\x67\xe8\x5c\xb7\x57\x2f\xfe\xe2\x79\xdb\x77\x1b\x69\x9c\xb7\xde\x9a\x43\xb2\x48\x00\xe0\xbd\xdf\x6f\xb5\xb4\x7a\x8a\xd1\x34\xfc\x06\x67\x4e\x59\x09\x94\x4d\x9e\x93\xc0\xb4\x7d\x33\xee\x60\x7b\x37\xd1\xbf\xb3\xee\xf5\xe8\x36\xf2\x34\x85\x40\xc6\x6f\x79\x29\x62\xe1\xef\x6c\x9c\xcb\x3e\xfb\xcc\x65\xc3\xff\xd8\x38\x9f\x85\xaf\x0c\x03\x19\xa4\x1f\x76\x06\x78\xa0\xf5\x72\x45\xeb\xc5\xd5\x65\x1a\xcb\x7f\x70\x53\xaa\x95\x57\xf1\x05\x59\x2b\x18\x8d\xc9\x59\x16\xda\x1d\x77\x5a\x79\xa3\xa5\xf2\x00\x04\x2f\xd8\xbd\xa0\xc5\x1a\x8f\x5c\xac\xaa\x46\x84\x1b\x3c\x55\x25\xfb\xd6\x6e\x5d\xf3\x82\xd6\x2d\xee\x58\x8b\x45\x5c\x1a\xf2\x65\x0f\x48\x1c\x6f\x0c\x46\x82\x13\xc1\x40\x30\xa6\x49\x7a\x9d\x7c\x0c\x00\xca\xc9\xcc\x9e\x95\x02\x00\x00")
+
+func _004_block_entitiesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__004_block_entitiesUpSql,
+		"004_block_entities.up.sql",
+	)
+}
+
+func _004_block_entitiesUpSql() (*asset, error) {
+	bytes, err := _004_block_entitiesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "004_block_entities.up.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __005_permissionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\x09\xf2\x0f\x50\x08\x71\x74\xf2\x71\x55\xf0\x74\x53\x70\x8d\xf0\x0c\x0e\x09\x56\x48\xce\x4b\x2e\x4a\x4c\x2b\xd1\xcb\x2f\x48\x2d\x4a\x2c\xc9\x2f\x2a\xb6\xe6\xc2\xab\xae\x20\x27\xb1\x32\xb5\x28\xbe\x28\x3f\x27\x95\x90\x52\x90\x9a\xf8\x82\xd4\xa2\xdc\xcc\xe2\xe2\xcc\xfc\x3c\x62\x94\x17\x5b\x73\x01\x06\x00\xfc\x83\xe2\x03\xa6\x00\x00\x00")
+
+func _005_permissionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__005_permissionsDownSql,
+		"005_permissions.down.sql",
+	)
+}
+
+func _005_permissionsDownSql() (*asset, error) {
+	bytes, err := _005_permissionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "005_permissions.down.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __005_permissionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x4f\x6f\x9b\x30\x18\xc6\xef\xfe\x14\xcf\x0d\x98\xdc\x28\xeb\xb2\x5e\x72\xf2\x12\x57\x45\x23\x50\x81\x69\xd5\x5d\x90\x1b\xdc\x16\x09\x30\x02\x94\x29\xdf\x7e\x0a\x04\x87\x2e\xa4\x87\x75\x70\x7b\xfd\xfe\x79\x7e\x8f\xfd\xae\x42\xce\x04\x87\x60\x3f\x3c\x8e\x6d\xb9\xad\xe5\x4b\x3b\xab\x75\xae\x1a\x62\x13\x00\x28\x65\xa1\xd0\x7f\x0f\x2c\x5c\xdd\xb1\xd0\xbe\x59\x38\xc7\xc8\xe9\xf7\x03\x01\x3f\xf6\x3c\xda\x15\xe9\x2a\xc9\xd5\x4e\xe5\x00\xa2\x0d\xf3\x3c\xd7\x17\x43\xe2\x54\x11\xd6\xfc\x96\xc5\x9e\xc0\x9c\x92\xae\x7e\x5b\x2b\xd9\xaa\x34\x91\x2d\x84\xbb\xe1\x91\x60\x9b\x7b\x3c\xba\xe2\x2e\x88\x45\x17\xc1\xaf\xc0\xe7\x7f\x0d\xbd\x0f\xdd\x0d\x0b\x9f\xf0\x93\x3f\xc1\x3e\xc8\x76\x88\xb3\x24\xe4\x22\x61\x52\xa9\xba\xc8\x9a\x26\xd3\xe5\x00\x7b\x00\x4f\x0e\xa5\xef\x61\x43\x7e\xcb\x43\xee\xaf\x78\xf4\xde\xa2\xe3\x18\x04\x3e\xd6\xdc\xe3\x82\x63\xc5\xa2\x15\x5b\xf3\xde\x85\x53\x7f\xd3\xed\xfa\xfb\x8d\x33\x92\x7d\xae\xdb\x28\xa0\xa3\xf2\x0f\x40\xaa\x5c\xee\x55\x9d\x8c\x6f\xec\x18\xca\x52\xc4\xb1\xbb\x9e\x12\xdf\x67\x34\xb0\xb3\xf4\xa2\xf8\x93\x15\x9f\x74\xe2\x9c\xd1\x08\xa4\xa7\x29\x1f\x20\xea\x4a\xd5\xb2\xd5\xf5\x39\xdf\xa7\x00\xff\xf5\x8d\x2e\xfe\xdb\x1b\x35\x20\x3d\xfc\xd5\x15\xc2\xce\xcb\x37\x95\xa7\x78\xde\xa3\x7d\x53\x30\xf0\xd0\x2f\xc7\x00\xfa\xd5\x7a\xcd\x76\xaa\x84\x2c\x53\xc8\x67\xbd\x53\xbd\x97\x5d\x9a\x49\x99\x43\xd6\xca\xb4\x53\x3b\x55\xef\x75\xa9\x66\xc4\xf5\x23\x1e\x0a\xb8\xbe\x08\xa6\xae\x91\x1a\x6f\xe8\x88\xd2\x21\x0f\xcc\x8b\x79\x04\xdb\xea\x85\x5b\x14\x73\x0a\x3f\x78\xb4\x1d\xa7\xa7\x03\x60\x5b\x85\x4e\x7b\xc9\x16\xc5\xd7\x89\xf3\x57\x59\xa8\x42\x36\x6d\xd7\xe0\x7a\x22\x41\xa6\x45\x56\x5a\x14\xdf\x26\xce\xf4\xef\xb2\xab\x5b\x0c\x67\x4b\x72\x91\x66\xbc\xdf\x17\x17\xcb\x30\x0d\x53\xad\xa1\xc5\x56\x17\x85\x2c\xd3\x99\xae\xac\x29\x05\xd6\x17\xcb\x59\x92\x3f\x03\x00\xa4\x84\x49\x22\x3f\x05\x00\x00")
+
+func _005_permissionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__005_permissionsUpSql,
+		"005_permissions.up.sql",
+	)
+}
+
+func _005_permissionsUpSql() (*asset, error) {
+	bytes, err := _005_permissionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "005_permissions.up.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __006_admin_permissionsDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\xc1\x8a\xc2\x30\x10\x86\xef\x79\x8a\xb9\x4d\x0b\xa5\x2f\xb0\xf4\xb0\xd0\x2c\xbb\xb0\xb6\x50\x04\x8f\x32\xa4\xa3\x86\x76\x92\x92\x04\x7d\x7d\xb1\x20\x82\xf1\x22\x38\xc7\x99\xe1\xfb\x7f\xbe\x56\xff\xeb\xad\x56\x3f\x43\xbf\x01\xe3\x4c\xa0\x43\xaa\x83\x9f\x79\xbf\x70\x10\x1b\xa3\xf5\x2e\xaa\xdd\xaf\x1e\x34\x14\xeb\xde\x91\x30\x34\x80\x47\x12\x16\x8a\x89\x03\xc2\x77\xd7\xc2\xe3\x1f\xfe\x3a\x28\xf0\x0e\x33\x5e\x84\xdc\x58\x27\x9e\x79\xf1\x21\x61\x05\xd9\x6d\x65\xf9\x91\xb1\x52\xf0\xfe\xe4\x38\x7b\xe6\x57\x31\xc9\xca\xa7\x22\x2e\x4c\xe9\xc4\x01\xcb\xf2\xd6\xb8\x1f\x9e\xe4\xd0\x28\xd6\x65\x5e\x9a\x9c\x33\x59\x33\x61\xf9\xa5\xae\x03\x00\x26\xa4\x53\x11\x87\x01\x00\x00")
+
+func _006_admin_permissionsDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__006_admin_permissionsDownSql,
+		"006_admin_permissions.down.sql",
+	)
+}
+
+func _006_admin_permissionsDownSql() (*asset, error) {
+	bytes, err := _006_admin_permissionsDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "006_admin_permissions.down.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var __006_admin_permissionsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8c\xc1\x0a\x82\x40\x10\x86\xef\x3e\xc5\xdc\x56\x41\x7c\x81\x4e\x1d\x3c\x08\x61\x90\xd6\x35\x86\x75\xaa\x41\x67\x57\x66\x87\x7a\xfd\xc0\x88\x08\x82\x70\x8e\xdf\x7c\xdf\xdf\xb4\x5d\x7d\xe8\xa1\x69\xfb\x3d\xf8\xe0\x15\x2f\x56\x69\x9c\xe8\x3c\x93\x0a\xa7\xc4\x31\x24\xc8\x17\x12\x50\xa8\x84\x0f\x2f\xb2\xd3\x76\x77\xac\x3b\xc8\xdd\x15\x85\x04\x93\x91\xba\x12\xdc\x7b\xc7\x47\x11\x0c\x43\x65\x34\xd1\x1c\xd5\x5c\x51\x66\xf0\xba\x7f\xc9\xf2\x8d\x03\xad\x49\xf8\xbe\x46\x37\x96\x35\xfa\x83\xd0\x6e\xa4\x5f\x05\x0e\xc2\xe1\x97\x3c\xb2\x1f\x5d\xb1\xc9\x9e\x03\x00\xcc\x34\x8d\x51\x5d\x01\x00\x00")
+
+func _006_admin_permissionsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__006_admin_permissionsUpSql,
+		"006_admin_permissions.up.sql",
+	)
+}
+
+func _006_admin_permissionsUpSql() (*asset, error) {
+	bytes, err := _006_admin_permissionsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "006_admin_permissions.up.sql", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -170,8 +380,18 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
-	"001_cncraft.down.sql": _001_cncraftDownSql,
-	"001_players.up.sql":   _001_playersUpSql,
+	"001_cncraft.down.sql":           _001_cncraftDownSql,
+	"001_players.up.sql":             _001_playersUpSql,
+	"002_sections.down.sql":          _002_sectionsDownSql,
+	"002_sections.up.sql":            _002_sectionsUpSql,
+	"003_worlds.down.sql":            _003_worldsDownSql,
+	"003_worlds.up.sql":              _003_worldsUpSql,
+	"004_block_entities.down.sql":    _004_block_entitiesDownSql,
+	"004_block_entities.up.sql":      _004_block_entitiesUpSql,
+	"005_permissions.down.sql":       _005_permissionsDownSql,
+	"005_permissions.up.sql":         _005_permissionsUpSql,
+	"006_admin_permissions.down.sql": _006_admin_permissionsDownSql,
+	"006_admin_permissions.up.sql":   _006_admin_permissionsUpSql,
 }
 
 // AssetDir returns the file names below a certain
@@ -215,8 +435,18 @@ type bintree struct {
 }
 
 var _bintree = &bintree{nil, map[string]*bintree{
-	"001_cncraft.down.sql": &bintree{_001_cncraftDownSql, map[string]*bintree{}},
-	"001_players.up.sql":   &bintree{_001_playersUpSql, map[string]*bintree{}},
+	"001_cncraft.down.sql":           &bintree{_001_cncraftDownSql, map[string]*bintree{}},
+	"001_players.up.sql":             &bintree{_001_playersUpSql, map[string]*bintree{}},
+	"002_sections.down.sql":          &bintree{_002_sectionsDownSql, map[string]*bintree{}},
+	"002_sections.up.sql":            &bintree{_002_sectionsUpSql, map[string]*bintree{}},
+	"003_worlds.down.sql":            &bintree{_003_worldsDownSql, map[string]*bintree{}},
+	"003_worlds.up.sql":              &bintree{_003_worldsUpSql, map[string]*bintree{}},
+	"004_block_entities.down.sql":    &bintree{_004_block_entitiesDownSql, map[string]*bintree{}},
+	"004_block_entities.up.sql":      &bintree{_004_block_entitiesUpSql, map[string]*bintree{}},
+	"005_permissions.down.sql":       &bintree{_005_permissionsDownSql, map[string]*bintree{}},
+	"005_permissions.up.sql":         &bintree{_005_permissionsUpSql, map[string]*bintree{}},
+	"006_admin_permissions.down.sql": &bintree{_006_admin_permissionsDownSql, map[string]*bintree{}},
+	"006_admin_permissions.up.sql":   &bintree{_006_admin_permissionsUpSql, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory
@@ -0,0 +1,15 @@
+CREATE TABLE cncraft.sections
+(
+    world_id       UUID                        NOT NULL,
+    dimension_id   UUID                        NOT NULL,
+    chunk_x        BIGINT                      NOT NULL,
+    chunk_z        BIGINT                      NOT NULL,
+    section_index  SMALLINT                    NOT NULL,
+
+    bits_per_block SMALLINT                    NOT NULL,
+    palette        INTEGER[]                   NOT NULL,
+    block_data     BIGINT[]                    NOT NULL,
+
+    updated_at     TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (world_id, dimension_id, chunk_x, chunk_z, section_index)
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cncraft.dimensions;
+DROP TABLE IF EXISTS cncraft.worlds;
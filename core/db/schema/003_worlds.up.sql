@@ -0,0 +1,31 @@
+CREATE TABLE cncraft.worlds
+(
+    id                 UUID                        NOT NULL,
+    name               VARCHAR(128)                NOT NULL,
+    seed               BYTEA                       NOT NULL,
+    world_type         SMALLINT                    NOT NULL DEFAULT 0,
+    hardcore           BOOL                        NOT NULL DEFAULT FALSE,
+    gamemode           SMALLINT                    NOT NULL DEFAULT 0,
+    difficulty         SMALLINT                    NOT NULL DEFAULT 0,
+    difficulty_locked  BOOL                        NOT NULL DEFAULT FALSE,
+    dimension_codec    VARCHAR(64)                 NOT NULL,
+    start_dimension_id UUID                        NOT NULL,
+
+    created_at         TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE cncraft.dimensions
+(
+    id              UUID         NOT NULL,
+    world_id        UUID REFERENCES cncraft.worlds (id) ON DELETE CASCADE,
+    name            VARCHAR(128) NOT NULL,
+    dimension_type  VARCHAR(128) NOT NULL,
+
+    edge_negative_x BIGINT       NOT NULL,
+    edge_negative_z BIGINT       NOT NULL,
+    edge_positive_x BIGINT       NOT NULL,
+    edge_positive_z BIGINT       NOT NULL,
+
+    PRIMARY KEY (world_id, id)
+);
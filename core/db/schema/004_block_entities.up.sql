@@ -0,0 +1,16 @@
+CREATE TABLE cncraft.block_entities
+(
+    world_id     UUID                        NOT NULL,
+    dimension_id UUID                        NOT NULL,
+    chunk_x      BIGINT                      NOT NULL,
+    chunk_z      BIGINT                      NOT NULL,
+    x            BIGINT                      NOT NULL,
+    y            BIGINT                      NOT NULL,
+    z            BIGINT                      NOT NULL,
+
+    entity_type  VARCHAR(64)                 NOT NULL,
+    data         BYTEA                       NOT NULL,
+
+    updated_at   TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (world_id, dimension_id, chunk_x, chunk_z, x, y, z)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS cncraft.operators;
+DROP TABLE IF EXISTS cncraft.player_roles;
+DROP TABLE IF EXISTS cncraft.role_permissions;
+DROP TABLE IF EXISTS cncraft.roles;
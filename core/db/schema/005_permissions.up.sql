@@ -0,0 +1,45 @@
+CREATE TABLE cncraft.roles
+(
+    name       VARCHAR(64)                 NOT NULL,
+    op_level   SMALLINT                    NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (name)
+);
+
+CREATE TABLE cncraft.role_permissions
+(
+    role_name  VARCHAR(64) REFERENCES cncraft.roles (name) ON DELETE CASCADE,
+    permission VARCHAR(256) NOT NULL,
+
+    PRIMARY KEY (role_name, permission)
+);
+
+CREATE TABLE cncraft.player_roles
+(
+    player_id UUID REFERENCES cncraft.players (id) ON DELETE CASCADE,
+    role_name VARCHAR(64) REFERENCES cncraft.roles (name) ON DELETE CASCADE,
+
+    PRIMARY KEY (player_id, role_name)
+);
+
+CREATE TABLE cncraft.operators
+(
+    player_id  UUID REFERENCES cncraft.players (id) ON DELETE CASCADE,
+    op_level   SMALLINT                    NOT NULL DEFAULT 4,
+
+    created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+    PRIMARY KEY (player_id)
+);
+
+-- Roles held by the operators of the op level given and above, roles of op level 0 are held by everyone.
+INSERT INTO cncraft.roles (name, op_level, created_at)
+VALUES ('player', 0, NOW()),
+       ('moderator', 1, NOW()),
+       ('gamemaster', 2, NOW()),
+       ('admin', 3, NOW()),
+       ('owner', 4, NOW());
+
+INSERT INTO cncraft.role_permissions (role_name, permission)
+VALUES ('admin', 'cncraft.command.op'),
+       ('owner', '*');
@@ -0,0 +1,6 @@
+DELETE
+FROM cncraft.role_permissions
+WHERE (role_name = 'gamemaster' AND permission IN ('cncraft.command.teleport', 'cncraft.command.gamemode',
+                                                   'cncraft.command.give', 'cncraft.command.time',
+                                                   'cncraft.command.weather'))
+   OR (role_name = 'admin' AND permission = 'cncraft.command.kick');
@@ -0,0 +1,7 @@
+INSERT INTO cncraft.role_permissions (role_name, permission)
+VALUES ('gamemaster', 'cncraft.command.teleport'),
+       ('gamemaster', 'cncraft.command.gamemode'),
+       ('gamemaster', 'cncraft.command.give'),
+       ('gamemaster', 'cncraft.command.time'),
+       ('gamemaster', 'cncraft.command.weather'),
+       ('admin', 'cncraft.command.kick');
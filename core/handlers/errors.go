@@ -25,6 +25,9 @@ func (e errType) Error() string { return string(e) }
 
 // List of sentinel errors
 const InvalidLoginErr errType = "user login data invalid"
+const ForwardingErr errType = "client details not forwarded by proxy"
+const ChatSpamErr errType = "player is spamming chat"
+const IllegalChatErr errType = "chat message has illegal characters"
 
 func newPacketError(topErr errType, wrappedErr error) PacketError {
 	wrappedMessage := fmt.Sprintf("%s: %s", topErr, wrappedErr.Error())
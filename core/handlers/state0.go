@@ -3,16 +3,26 @@ package handlers
 import (
 	"fmt"
 
+	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/forwarding"
 )
 
-// HandleSHandshake handles the Handshake packet.
-func HandleSHandshake(stateSetter func(state protocol.State), spacket protocol.SPacket) error {
+// HandleSHandshake handles the Handshake packet. Client details forwarded by BungeeCord in the handshake host are
+// picked up if the legacy forwarding is enabled, the login fails later if there are none.
+func HandleSHandshake(stateSetter func(state protocol.State), forwardSetter func(*forwarding.Info), spacket protocol.SPacket) error {
 	packet, ok := spacket.(*protocol.SPacketHandshake)
 	if !ok {
 		return fmt.Errorf("received packet is not a handshake: %v", spacket)
 	}
 
+	conf := control.GetCurrentConfig()
+	if packet.NextState == protocol.Login && conf.IsCracked && conf.Forwarding.Mode == control.ForwardingLegacy {
+		if forwarded, err := forwarding.ParseLegacy(packet.Host); err == nil {
+			forwardSetter(forwarded)
+		}
+	}
+
 	switch packet.NextState {
 	case protocol.Handshake, protocol.Status, protocol.Login:
 		stateSetter(packet.NextState)
@@ -7,45 +7,52 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/alexykot/cncraft/core/control"
-	"github.com/alexykot/cncraft/core/nats"
-	"github.com/alexykot/cncraft/core/nats/subj"
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game/player"
 	"github.com/alexykot/cncraft/pkg/protocol"
 	"github.com/alexykot/cncraft/pkg/protocol/auth"
 	"github.com/alexykot/cncraft/pkg/protocol/auth/mojang"
+	"github.com/alexykot/cncraft/pkg/protocol/forwarding"
 )
 
-// HandleSLoginStart handles the LoginStart packet.
-func HandleSLoginStart(auther auth.A, ps nats.PubSub, stateSetter func(protocol.State), aliver func(uuid.UUID),
-	connID uuid.UUID, sPacket protocol.SPacket) ([]protocol.CPacket, error) {
+// velocityMessageID - ID of the login plugin request asking Velocity for the client details. It is the only login
+// plugin request sent, so the ID is the same for all connections.
+const velocityMessageID = 1
+
+// HandleSLoginStart handles the LoginStart packet. Once the login completes, provides the PlayerLoading envelope
+// to be published after the packets are transmitted.
+func HandleSLoginStart(auther auth.A, stateSetter func(protocol.State), aliver func(uuid.UUID),
+	forwarded *forwarding.Info, connID uuid.UUID, sPacket protocol.SPacket) ([]protocol.CPacket, *envelope.E, error) {
 	loginStart, ok := sPacket.(*protocol.SPacketLoginStart)
 	if !ok {
-		return nil, fmt.Errorf("received packet is not a loginStart: %v", sPacket)
+		return nil, nil, fmt.Errorf("received packet is not a loginStart: %v", sPacket)
 	}
 
 	if err := auther.BootstrapUser(connID, loginStart.Username); err != nil {
-		return nil, fmt.Errorf("failed to bootstrap user: %w", err)
+		return nil, nil, fmt.Errorf("failed to bootstrap user: %w", err)
 	}
 
-	if control.GetCurrentConfig().IsCracked { // "cracked" or "offline-mode" server does not do authentication or encryption
-		loginSuccess, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CLoginSuccess) // Predefined packet is expected to always exist.
-		loginSuccess.(*protocol.CPacketLoginSuccess).PlayerUUID = connID
-		loginSuccess.(*protocol.CPacketLoginSuccess).PlayerName = loginStart.Username
-
-		stateSetter(protocol.Play)
-		aliver(connID)
-		lope := envelope.PlayerLoading(&pb.PlayerLoading{
-			ConnId:    connID.String(),
-			ProfileId: connID.String(),
-			Username:  loginStart.Username,
-			// TODO also publish skin data
-		})
-		if err := ps.Publish(subj.MkPlayerLoading(), lope); err != nil {
-			return nil, fmt.Errorf("failed to publish player loading envelope: %w", err)
+	if conf := control.GetCurrentConfig(); conf.IsCracked { // "cracked" or "offline-mode" server does not do authentication or encryption
+		profile := player.Profile{ID: connID, Name: loginStart.Username}
+
+		switch conf.Forwarding.Mode {
+		case control.ForwardingModern: // the login completes once Velocity responds with the client details
+			cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CLoginPluginRequest)
+			pluginRequest := cpacket.(*protocol.CPacketLoginPluginRequest)
+			pluginRequest.MessageID = velocityMessageID
+			pluginRequest.Channel = forwarding.VelocityChannel
+			pluginRequest.OptData = []byte{forwarding.VelocityVersion}
+			return []protocol.CPacket{pluginRequest}, nil, nil
+		case control.ForwardingLegacy:
+			if forwarded == nil {
+				return nil, nil, newPacketError(ForwardingErr, fmt.Errorf("no client details forwarded in handshake"))
+			}
+			profile.ID, profile.Properties = forwarded.Profile.ID, forwarded.Profile.Properties
 		}
 
-		return []protocol.CPacket{loginSuccess}, nil
+		loginSuccess, loadingLope := completeCrackedLogin(auther, stateSetter, aliver, connID, profile)
+		return []protocol.CPacket{loginSuccess}, loadingLope, nil
 	}
 
 	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CEncryptionRequest) // Predefined packet is expected to always exist.
@@ -55,44 +62,94 @@ func HandleSLoginStart(auther auth.A, ps nats.PubSub, stateSetter func(protocol.
 	encRequest.PublicKey = auther.GetUserPubkey(connID)
 	encRequest.VerifyToken = auther.GetUserVerifyToken(connID)
 
-	return []protocol.CPacket{encRequest}, nil
+	return []protocol.CPacket{encRequest}, nil, nil
+}
+
+// HandleSLoginPluginResponse handles the LoginPluginResponse packet. The only login plugin request sent is the one
+// asking Velocity for the client details, the login completes with the details verified. Provides the PlayerLoading
+// envelope to be published after the packets are transmitted.
+func HandleSLoginPluginResponse(auther auth.A, stateSetter func(protocol.State), aliver func(uuid.UUID),
+	forwardSetter func(*forwarding.Info), connID uuid.UUID, sPacket protocol.SPacket) ([]protocol.CPacket, *envelope.E, error) {
+	pluginResponse, ok := sPacket.(*protocol.SPacketLoginPluginResponse)
+	if !ok {
+		return nil, nil, fmt.Errorf("received packet is not a loginPluginResponse: %v", sPacket)
+	}
+
+	conf := control.GetCurrentConfig()
+	if !conf.IsCracked || conf.Forwarding.Mode != control.ForwardingModern {
+		return nil, nil, newPacketError(InvalidLoginErr, fmt.Errorf("login plugin response was not requested"))
+	}
+	if pluginResponse.Message != velocityMessageID {
+		return nil, nil, newPacketError(InvalidLoginErr, fmt.Errorf("unexpected login plugin message ID %d", pluginResponse.Message))
+	}
+	if !pluginResponse.Success { // the client understands no Velocity channel, it connected directly
+		return nil, nil, newPacketError(ForwardingErr, fmt.Errorf("client did not connect through Velocity"))
+	}
+
+	forwarded, err := forwarding.ParseVelocity([]byte(conf.Forwarding.Secret), pluginResponse.OptData)
+	if err != nil {
+		return nil, nil, newPacketError(ForwardingErr, fmt.Errorf("failed to parse forwarded client details: %w", err))
+	}
+	forwardSetter(forwarded)
+
+	loginSuccess, loadingLope := completeCrackedLogin(auther, stateSetter, aliver, connID, forwarded.Profile)
+	return []protocol.CPacket{loginSuccess}, loadingLope, nil
 }
 
-func HandleSEncryptionResponse(auther auth.A, ps nats.PubSub,
-	stateSetter func(state protocol.State), encSetter func([]byte) error, compSetter func(), aliver func(uuid.UUID),
-	connID uuid.UUID, sPacket protocol.SPacket) ([]protocol.CPacket, error) {
+// completeCrackedLogin lets the player with the given profile in, without the authentication. Provides
+// the LoginSuccess packet, and the PlayerLoading envelope to be published once the packet is transmitted.
+func completeCrackedLogin(auther auth.A, stateSetter func(protocol.State), aliver func(uuid.UUID),
+	connID uuid.UUID, profile player.Profile) (protocol.CPacket, *envelope.E) {
+	loginSuccess, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CLoginSuccess) // Predefined packet is expected to always exist.
+	loginSuccess.(*protocol.CPacketLoginSuccess).PlayerUUID = profile.ID
+	loginSuccess.(*protocol.CPacketLoginSuccess).PlayerName = profile.Name
+
+	stateSetter(protocol.Play)
+	aliver(connID)
+	lope := envelope.PlayerLoading(&pb.PlayerLoading{
+		ConnId:     connID.String(),
+		ProfileId:  profile.ID.String(),
+		Username:   profile.Name,
+		Properties: mkPlayerProfileProperties(profile.Properties),
+	})
+
+	auther.LoginSuccess(connID)
+
+	return loginSuccess, lope
+}
+
+// HandleSEncryptionResponse handles the EncryptionResponse packet, authenticating the player with the session
+// server. Provides the PlayerLoading envelope to be published after the packets are transmitted.
+func HandleSEncryptionResponse(auther auth.A,
+	stateSetter func(state protocol.State), encSetter func([]byte) error, aliver func(uuid.UUID),
+	connID uuid.UUID, sPacket protocol.SPacket) ([]protocol.CPacket, *envelope.E, error) {
 
 	encResponse, ok := sPacket.(*protocol.SPacketEncryptionResponse)
 	if !ok {
-		return nil, fmt.Errorf("received packet is not an SEncryptionResponse: %v", sPacket)
+		return nil, nil, fmt.Errorf("received packet is not an SEncryptionResponse: %v", sPacket)
 	}
 
 	savedToken := auther.GetUserVerifyToken(connID)
 	returnedToken, err := auther.DecryptUserVerifyToken(connID, encResponse.VerifyToken)
 	if bytes.Compare(returnedToken, savedToken) != 0 {
-		return nil, newPacketError(InvalidLoginErr, fmt.Errorf("supplied verify token does not match the saved one: %X != %X",
+		return nil, nil, newPacketError(InvalidLoginErr, fmt.Errorf("supplied verify token does not match the saved one: %X != %X",
 			returnedToken, savedToken))
 	}
 
 	sharedSecret, err := auther.DecryptUserSharedSecret(connID, encResponse.SharedSecret)
 	if err != nil {
-		return nil, newPacketError(InvalidLoginErr, fmt.Errorf("failed to decrypt user shared secret: %w", err))
+		return nil, nil, newPacketError(InvalidLoginErr, fmt.Errorf("failed to decrypt user shared secret: %w", err))
 	}
 
-	// mojangData, err := auther.RunMojangSessionAuth(userID, sharedSecret)
-	// if err != nil {
-	//	return nil, newPacketError(InvalidLoginErr, fmt.Errorf("failed to run Mojang session server auth: %w", err))
-	// }
-	// DEBT mojang session auth returns HTTP 204, unclear why, to debug later
-	mojangData := &mojang.AuthResponse{
-		ProfileID:  uuid.New(),
-		Username:   auther.GetUserName(connID),
-		Properties: nil,
+	// The client encrypts everything after the SEncryptionResponse, so the disconnect on the failed
+	// authentication has to be encrypted as well.
+	if err := encSetter(sharedSecret); err != nil {
+		return nil, nil, fmt.Errorf("failed to enable conn encryption: %w", err)
 	}
 
-	compSetter()
-	if err := encSetter(sharedSecret); err != nil {
-		return nil, fmt.Errorf("failed to enable conn encryption: %w", err)
+	mojangData, err := auther.RunSessionAuth(connID, sharedSecret)
+	if err != nil {
+		return nil, nil, newPacketError(InvalidLoginErr, fmt.Errorf("failed to authenticate with session server: %w", err))
 	}
 
 	setCompression, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetCompression)                  // Predefined packet is expected to always exist.
@@ -105,16 +162,37 @@ func HandleSEncryptionResponse(auther auth.A, ps nats.PubSub,
 	stateSetter(protocol.Play)
 	aliver(connID)
 	lope := envelope.PlayerLoading(&pb.PlayerLoading{
-		ConnId:    connID.String(),
-		ProfileId: mojangData.ProfileID.String(),
-		Username:  mojangData.Username,
-		// TODO also publish skin data
+		ConnId:     connID.String(),
+		ProfileId:  mojangData.ProfileID.String(),
+		Username:   mojangData.Username,
+		Properties: mkProfileProperties(mojangData.Properties),
 	})
-	if err := ps.Publish(subj.MkPlayerLoading(), lope); err != nil {
-		return nil, fmt.Errorf("failed to publish player loading envelope: %w", err)
-	}
 
 	auther.LoginSuccess(connID)
 
-	return []protocol.CPacket{setCompression, loginSuccess}, nil
+	return []protocol.CPacket{setCompression, loginSuccess}, lope, nil
+}
+
+func mkProfileProperties(properties []mojang.Property) []*pb.ProfileProperty {
+	pbProperties := make([]*pb.ProfileProperty, len(properties))
+	for i, property := range properties {
+		pbProperties[i] = &pb.ProfileProperty{
+			Name:      property.Name,
+			Value:     property.Value,
+			Signature: property.Signature,
+		}
+	}
+	return pbProperties
+}
+
+func mkPlayerProfileProperties(properties []player.ProfileProperty) []*pb.ProfileProperty {
+	pbProperties := make([]*pb.ProfileProperty, len(properties))
+	for i, property := range properties {
+		pbProperties[i] = &pb.ProfileProperty{
+			Name:      property.Name,
+			Value:     property.Value,
+			Signature: property.Signature,
+		}
+	}
+	return pbProperties
 }
@@ -2,25 +2,38 @@ package handlers
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/alexykot/cncraft/core/commands"
 	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/core/nats"
 	"github.com/alexykot/cncraft/core/nats/subj"
 	"github.com/alexykot/cncraft/core/players"
 	"github.com/alexykot/cncraft/core/world"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
 	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/game/player"
+	"github.com/alexykot/cncraft/pkg/game/recipes"
 	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
 	"github.com/alexykot/cncraft/pkg/protocol/plugin"
 )
 
 // RegisterEventHandlersState3 registers handlers for envelopes broadcast in the Play connection state.
 //  Play state handlers are entirely asynchronous, so NATS subscriptions need to be created at boot time.
-func RegisterEventHandlersState3(log *zap.Logger, ctrlChan chan control.Command, ps nats.PubSub, roster players.Roster, world *world.World) {
-	if err := ps.Subscribe(subj.MkPlayerLoading(), handlePlayerLoading(ps, log, roster, world)); err != nil {
+func RegisterEventHandlersState3(log *zap.Logger, ctrlChan chan control.Command, ps nats.PubSub, roster players.Roster, world *world.World, streamer *world.Streamer, clock *world.Clock, cmds *brigadier.Dispatcher) {
+	if err := ps.Subscribe(subj.MkPlayerLoading(), handlePlayerLoading(ps, log, roster, world, streamer, clock, cmds)); err != nil {
 		// Handlers don't have any async loops, so do not need to signal readiness, it's ready as soon
 		// they are registered, and have no internal components that would need to be stopped.
 		// But it can fail while loading and that needs to be signalled.
@@ -33,10 +46,84 @@ func RegisterEventHandlersState3(log *zap.Logger, ctrlChan chan control.Command,
 		return
 	}
 
+	if err := ps.Subscribe(subj.MkPlayerTeleport(), handlePlayerTeleport(ps, log, roster, world, streamer)); err != nil {
+		ctrlChan <- control.Command{
+			Signal:    control.COMPONENT,
+			Component: control.EVENTS,
+			State:     control.FAILED,
+			Err:       fmt.Errorf("failed to register PlayerTeleport handler: %w", err),
+		}
+		return
+	}
+
+	if err := ps.Subscribe(subj.MkPlayerSpatialUpdate(), handlePortals(ps, log, roster, world)); err != nil {
+		ctrlChan <- control.Command{
+			Signal:    control.COMPONENT,
+			Component: control.EVENTS,
+			State:     control.FAILED,
+			Err:       fmt.Errorf("failed to register portals handler: %w", err),
+		}
+		return
+	}
+
+	if err := ps.Subscribe(subj.MkChatMessage(), handleChatMessage(ps, log, roster)); err != nil {
+		ctrlChan <- control.Command{
+			Signal:    control.COMPONENT,
+			Component: control.EVENTS,
+			State:     control.FAILED,
+			Err:       fmt.Errorf("failed to register ChatMessage handler: %w", err),
+		}
+		return
+	}
+
 	log.Info("Play state event handlers registered")
 }
 
-func handlePlayerLoading(ps nats.PubSub, log *zap.Logger, roster players.Roster, world *world.World) func(lope *envelope.E) {
+// handleChatMessage delivers the chat message to every player connected to this node, unless the chat mode
+// of the player hides it.
+func handleChatMessage(ps nats.PubSub, log *zap.Logger, roster players.Roster) func(lope *envelope.E) {
+	return func(inLope *envelope.E) {
+		chatMessage := inLope.GetChatMessage()
+		if chatMessage == nil {
+			log.Error("failed to parse envelope - no ChatMessage inside", zap.Any("envelope", inLope))
+			return
+		}
+
+		var message chat.Message
+		if err := json.Unmarshal([]byte(chatMessage.Message), &message); err != nil {
+			log.Error("failed to parse chat message JSON", zap.String("message", chatMessage.Message), zap.Error(err))
+			return
+		}
+
+		var senderID uuid.UUID // messages sent by the server have no sender
+		if chatMessage.SenderId != "" {
+			var err error
+			if senderID, err = uuid.Parse(chatMessage.SenderId); err != nil {
+				log.Error("failed to parse sender ID as UUID", zap.String("id", chatMessage.SenderId), zap.Error(err))
+				return
+			}
+		}
+		position := chat.MessagePosition(chatMessage.Position)
+
+		for _, p := range roster.GetPlayers() {
+			if settings := p.GetSettings(); settings != nil && !settings.ChatMode.Shows(position) {
+				continue
+			}
+
+			cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CChatMessage)
+			chatPacket := cpacket.(*protocol.CPacketChatMessage)
+			chatPacket.Message = message
+			chatPacket.MessagePosition = position
+			chatPacket.Sender = senderID
+
+			if err := ps.Publish(subj.MkConnTransmit(p.ConnID), envelope.MkCpacketEnvelope(chatPacket)); err != nil {
+				log.Error("failed to publish CChatMessage", zap.String("conn", p.ConnID.String()), zap.Error(err))
+			}
+		}
+	}
+}
+
+func handlePlayerLoading(ps nats.PubSub, log *zap.Logger, roster players.Roster, world *world.World, streamer *world.Streamer, clock *world.Clock, cmds *brigadier.Dispatcher) func(lope *envelope.E) {
 	return func(inLope *envelope.E) {
 		ps := ps
 		log := log
@@ -54,23 +141,44 @@ func handlePlayerLoading(ps nats.PubSub, log *zap.Logger, roster players.Roster,
 		}
 		log.Debug("handling player loading", zap.String("user", userId.String()))
 
-		p, err := roster.AddPlayer(loading.Username, userId, world.StartDimension)
+		profileID, err := uuid.Parse(loading.ProfileId)
+		if err != nil {
+			log.Error("failed to parse profile ID as UUID", zap.String("id", loading.ProfileId), zap.Error(err))
+			return
+		}
+		profile := player.Profile{ID: profileID, Name: loading.Username}
+		for _, property := range loading.Properties {
+			profile.Properties = append(profile.Properties, player.ProfileProperty{
+				Name:      property.Name,
+				Value:     property.Value,
+				Signature: property.Signature,
+			})
+		}
+
+		p, err := roster.AddPlayer(profile, userId, world.StartDimension, world.Dimensions)
 		if err != nil {
 			log.Error("failed add player", zap.Error(err))
 			return
 		}
+		// DEBT gamemode of the player is not persisted, players join in the gamemode of the world.
+		p.SetGamemode(world.Gamemode)
+
 		var outLopes []*envelope.E
 
 		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CJoinGame) // Predefined packet is expected to always exist.
 		joinGame := cpacket.(*protocol.CPacketJoinGame)                           // And always be of the correct type.
 
 		joinGame.EntityID = p.PC.ID()
-		joinGame.GameMode = world.Gamemode
+		joinGame.GameMode = p.PC.GetGameMode()
 		joinGame.DimensionCodec = world.NBTDimensionCodec
-		joinGame.Dimension = world.NBTDimension
+		joinGame.Dimension = world.DimensionTypes[p.State.Dimension].NBT
+		joinGame.WorldName = world.DimensionTypes[p.State.Dimension].Name
+		for _, dimType := range world.DimensionTypes {
+			joinGame.WorldNames = append(joinGame.WorldNames, dimType.Name)
+		}
 		joinGame.IsHardcore = world.Coreness
 		joinGame.HashedSeed = int64(binary.LittleEndian.Uint64(world.SeedHash[:]))
-		joinGame.ViewDistance = p.Settings.ViewDistance
+		joinGame.ViewDistance = streamer.ViewDistance(p.Settings.ViewDistance)
 		joinGame.EnableRespawnScreen = control.GetCurrentConfig().World.EnableRespawnScreen
 		outLopes = append(outLopes, envelope.MkCpacketEnvelope(joinGame))
 
@@ -87,48 +195,58 @@ func handlePlayerLoading(ps nats.PubSub, log *zap.Logger, roster players.Roster,
 
 		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerAbilities)
 		abilities := cpacket.(*protocol.CPacketPlayerAbilities)
-		abilities.Abilities = *p.Abilities
+		abilities.Abilities = p.GetAbilities()
 		abilities.FlyingSpeed = p.Settings.FlyingSpeed
 		abilities.FieldOfView = p.Settings.FoVModifier
 		outLopes = append(outLopes, envelope.MkCpacketEnvelope(abilities))
 
 		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CDeclareRecipes)
 		declareRecipes := cpacket.(*protocol.CPacketDeclareRecipes)
-		declareRecipes.RecipeCount = 0 // TODO probably will be a static list of recipes defined for current server version
+		declareRecipes.Recipes = recipes.All()
 		outLopes = append(outLopes, envelope.MkCpacketEnvelope(declareRecipes))
 
 		// TODO CTags packet is not defined
-		// TODO CEntityStatus packet is not defined
-		// TODO CDeclareCommands packet is not defined
-		// TODO CUnlockRecipes packet is not defined
 
-		// DEBT move this to a separate world loader
-		chunksToLoad := world.Dimensions[world.StartDimension].Chunks()
-		for _, chunk := range chunksToLoad {
-			cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CChunkData)
-			chunkData := cpacket.(*protocol.CPacketChunkData)
-			chunkData.Chunk = chunk
-			outLopes = append(outLopes, envelope.MkCpacketEnvelope(chunkData))
+		// DEBT only the player itself is added to the list, for the client to show its skin. Other players are
+		//  neither listed nor spawned yet.
+		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerInfo)
+		playerInfo := cpacket.(*protocol.CPacketPlayerInfo)
+		playerInfo.Action = player.AddPlayer
+		playerInfo.Values = []player.PlayerInfo{&player.PlayerInfoAddPlayer{
+			Profile:  p.Profile,
+			GameMode: p.PC.GetGameMode(),
+		}}
+		outLopes = append(outLopes, envelope.MkCpacketEnvelope(playerInfo))
+
+		outLopes = append(outLopes, commands.PermissionLopes(cmds, commands.NewPlayerSender(log, ps, p))...)
+
+		// DEBT all recipes are unlocked for everybody, Notchian server unlocks them as the player progresses.
+		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CUnlockRecipes)
+		unlockRecipes := cpacket.(*protocol.CPacketUnlockRecipes)
+		unlockRecipes.Action = protocol.UnlockRecipesInit
+		for _, recipe := range declareRecipes.Recipes {
+			unlockRecipes.RecipeIDs = append(unlockRecipes.RecipeIDs, recipe.ID)
 		}
+		outLopes = append(outLopes, envelope.MkCpacketEnvelope(unlockRecipes))
+
+		chunkLopes, err := streamer.MoveView(p, p.State.Dimension, p.State.Location.PositionF, true)
+		if err != nil {
+			log.Error("failed to load chunks around player", zap.Error(err), zap.String("player", p.Username))
+			return
+		}
+		outLopes = append(outLopes, chunkLopes...)
 
 		// Player Position And Look
 		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerPositionAndLook)
 		posAndLook := cpacket.(*protocol.CPacketPlayerPositionAndLook)
 		posAndLook.Location = p.State.Location // Relative is always False here.
+		posAndLook.TeleportID = p.StartTeleport()
 		outLopes = append(outLopes, envelope.MkCpacketEnvelope(posAndLook))
 
+		outLopes = append(outLopes, clock.Lopes()...)
+
 		// Player inventory init
-		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CWindowItems)
-		winItems := cpacket.(*protocol.CPacketWindowItems)
-		inventorySlots := p.State.Inventory.ToArray()
-		winItems.SlotCount = int16(len(inventorySlots))
-		winItems.Slots = inventorySlots
-		outLopes = append(outLopes, envelope.MkCpacketEnvelope(winItems))
-
-		cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CHeldItemChange)
-		heldItemChange := cpacket.(*protocol.CPacketHeldItemChange)
-		heldItemChange.Slot = p.State.Inventory.CurrentHotbarSlot
-		outLopes = append(outLopes, envelope.MkCpacketEnvelope(heldItemChange))
+		outLopes = append(outLopes, mkInventoryLopes(p)...)
 
 		if err := ps.Publish(subj.MkConnTransmit(userId), outLopes...); err != nil {
 			log.Error("failed to publish conn.transmit message", zap.Error(err), zap.Any("conn", userId))
@@ -136,3 +254,204 @@ func handlePlayerLoading(ps nats.PubSub, log *zap.Logger, roster players.Roster,
 		}
 	}
 }
+
+// handlePlayerTeleport moves the player to the requested position. If the dimension changes, the client is respawned
+// into the new dimension and receives its chunks before the new position.
+func handlePlayerTeleport(ps nats.PubSub, log *zap.Logger, roster players.Roster, world *world.World, streamer *world.Streamer) func(lope *envelope.E) {
+	return func(inLope *envelope.E) {
+		teleport := inLope.GetPlayerTeleport()
+		if teleport == nil {
+			log.Error("failed to parse envelope - no PlayerTeleport inside", zap.Any("envelope", inLope))
+			return
+		}
+
+		playerID, err := uuid.Parse(teleport.PlayerId)
+		if err != nil {
+			log.Error("failed to parse player ID as UUID", zap.String("id", teleport.PlayerId), zap.Error(err))
+			return
+		}
+		dimensionID, err := uuid.Parse(teleport.DimensionId)
+		if err != nil {
+			log.Error("failed to parse dimension ID as UUID", zap.String("id", teleport.DimensionId), zap.Error(err))
+			return
+		}
+
+		p, ok := roster.GetPlayerByID(playerID)
+		if !ok {
+			log.Warn("cannot teleport player that is not in roster", zap.String("id", teleport.PlayerId))
+			return
+		}
+		dimType, ok := world.DimensionTypes[dimensionID]
+		if !ok {
+			log.Error("cannot teleport player to unknown dimension", zap.String("dimension", teleport.DimensionId))
+			return
+		}
+
+		location := p.GetLocation()
+		location.PositionF = data.PositionFFromPb(teleport.Pos)
+
+		var outLopes []*envelope.E
+		changesDimension := dimensionID != p.GetState().Dimension
+		if changesDimension {
+			log.Debug("moving player to dimension", zap.String("player", p.Username), zap.String("dimension", dimType.Name))
+
+			cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CRespawn)
+			respawn := cpacket.(*protocol.CPacketRespawn)
+			respawn.Dimension = dimType.NBT
+			respawn.WorldName = dimType.Name
+			respawn.HashedSeed = int64(binary.LittleEndian.Uint64(world.SeedHash[:]))
+			respawn.GameMode = p.PC.GetGameMode()
+			respawn.IsFlat = world.Type == game.WorldFlat
+			respawn.CopyMetadata = true
+			outLopes = append(outLopes, envelope.MkCpacketEnvelope(respawn))
+
+			cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerAbilities)
+			abilities := cpacket.(*protocol.CPacketPlayerAbilities)
+			abilities.Abilities = p.GetAbilities()
+			abilities.FlyingSpeed = p.Settings.FlyingSpeed
+			abilities.FieldOfView = p.Settings.FoVModifier
+			outLopes = append(outLopes, envelope.MkCpacketEnvelope(abilities))
+
+		}
+
+		// Chunks are sent before the position, so that the client does not find itself in the void.
+		chunkLopes, err := streamer.MoveView(p, dimensionID, location.PositionF, changesDimension)
+		if err != nil {
+			log.Error("failed to load chunks around player", zap.Error(err), zap.String("player", p.Username))
+			return
+		}
+		outLopes = append(outLopes, chunkLopes...)
+
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CPlayerPositionAndLook)
+		posAndLook := cpacket.(*protocol.CPacketPlayerPositionAndLook)
+		posAndLook.Location = location
+		posAndLook.TeleportID = p.StartTeleport()
+		outLopes = append(outLopes, envelope.MkCpacketEnvelope(posAndLook))
+
+		if changesDimension {
+			// client drops the inventory contents on respawn
+			outLopes = append(outLopes, mkInventoryLopes(p)...)
+		}
+
+		roster.SetPlayerDimension(p.ConnID, dimensionID, location.PositionF)
+
+		if err := ps.Publish(subj.MkConnTransmit(p.ConnID), outLopes...); err != nil {
+			log.Error("failed to publish conn.transmit message", zap.Error(err), zap.Any("conn", p.ConnID))
+			return
+		}
+	}
+}
+
+// portalCooldown - time after using a portal during which portals do not trigger for the player again, so that
+// a player arriving into a portal is not sent straight back.
+const portalCooldown = 5 * time.Second
+
+// DEBT there is no world spawn point yet, players leaving the End arrive at the same place new players spawn at.
+var worldSpawn = data.PositionF{X: 0, Y: 10, Z: 0}
+
+// endSpawn - position of the obsidian platform in the vanilla End.
+var endSpawn = data.PositionF{X: 100.5, Y: 49, Z: 0.5}
+
+// handlePortals watches player movements and teleports players that step into nether or end portal blocks.
+// Spatial updates are seen by every node, only the node holding the player connection handles them.
+func handlePortals(ps nats.PubSub, log *zap.Logger, roster players.Roster, world *world.World) func(lope *envelope.E) {
+	return func(inLope *envelope.E) {
+		spatial := inLope.GetPlayerSpatial()
+		if spatial == nil {
+			log.Error("failed to parse envelope - no PlayerSpatialUpdate inside", zap.Any("envelope", inLope))
+			return
+		}
+
+		playerID, err := uuid.Parse(spatial.PlayerId)
+		if err != nil {
+			log.Error("failed to parse player ID as UUID", zap.String("id", spatial.PlayerId), zap.Error(err))
+			return
+		}
+		p, ok := roster.GetPlayerByID(playerID)
+		if !ok {
+			return // player is connected to another node
+		}
+		dimensionID, err := uuid.Parse(spatial.DimensionId)
+		if err != nil {
+			log.Error("failed to parse dimension ID as UUID", zap.String("id", spatial.DimensionId), zap.Error(err))
+			return
+		}
+
+		position := data.PositionFFromPb(spatial.Pos)
+		block, err := world.GetBlock(dimensionID, data.PositionI{
+			X: int64(math.Floor(position.X)),
+			Y: int64(math.Floor(position.Y)),
+			Z: int64(math.Floor(position.Z)),
+		})
+		if err != nil {
+			return // outside of the loaded world, cannot be in a portal
+		}
+
+		targetID, target, ok := portalDestination(dimensionID, block.ID(), position)
+		if !ok {
+			return
+		}
+		targetDim, ok := world.Dimensions[targetID]
+		if !ok {
+			return // portal leads to a dimension this world does not have
+		}
+
+		if !p.UsePortal(time.Now(), portalCooldown) {
+			return
+		}
+
+		target = clampToEdges(target, targetDim.Edges())
+		if err := ps.Publish(subj.MkPlayerTeleport(), envelope.PlayerTeleport(&pb.PlayerTeleport{
+			PlayerId:    spatial.PlayerId,
+			DimensionId: targetID.String(),
+			Pos:         &pb.Position{X: target.X, Y: target.Y, Z: target.Z},
+		})); err != nil {
+			log.Error("failed to publish player teleport", zap.Error(err), zap.String("id", spatial.PlayerId))
+		}
+	}
+}
+
+// portalDestination provides the dimension and position the portal block leads to from the given dimension.
+// Nether coordinates are scaled 1:8 to the overworld, as in vanilla.
+func portalDestination(dimensionID uuid.UUID, blockID objects.BlockID, pos data.PositionF) (uuid.UUID, data.PositionF, bool) {
+	overworldID := world.DimensionID(game.Overworld)
+	netherID := world.DimensionID(game.Nether)
+	endID := world.DimensionID(game.TheEnd)
+
+	switch blockID {
+	case objects.BlockNetherPortal_AxisX, objects.BlockNetherPortal_AxisZ:
+		switch dimensionID {
+		case overworldID:
+			return netherID, data.PositionF{X: pos.X / 8, Y: pos.Y, Z: pos.Z / 8}, true
+		case netherID:
+			return overworldID, data.PositionF{X: pos.X * 8, Y: pos.Y, Z: pos.Z * 8}, true
+		}
+	case objects.BlockEndPortal:
+		switch dimensionID {
+		case overworldID:
+			return endID, endSpawn, true
+		case endID:
+			return overworldID, worldSpawn, true
+		}
+	}
+	return uuid.UUID{}, data.PositionF{}, false
+}
+
+// clampToEdges moves the position inside the dimension edges, if it is outside.
+func clampToEdges(pos data.PositionF, edges level.Edges) data.PositionF {
+	pos.X = math.Max(float64(edges.NegativeX), math.Min(pos.X, float64(edges.PositiveX+level.SectionX)-0.5))
+	pos.Z = math.Max(float64(edges.NegativeZ), math.Min(pos.Z, float64(edges.PositiveZ+level.SectionZ)-0.5))
+	return pos
+}
+
+// mkInventoryLopes provides packets setting the full player inventory and the held item.
+func mkInventoryLopes(p *players.Player) []*envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CHeldItemChange)
+	heldItemChange := cpacket.(*protocol.CPacketHeldItemChange)
+	heldItemChange.Slot = p.State.Inventory.GetCurrentHotbarSlot()
+
+	return []*envelope.E{
+		envelope.MkCpacketEnvelope(mkInventoryItems(p.State.Inventory)),
+		envelope.MkCpacketEnvelope(heldItemChange),
+	}
+}
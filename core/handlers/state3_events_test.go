@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexykot/cncraft/core/world"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestPortalDestination(t *testing.T) {
+	overworldID := world.DimensionID(game.Overworld)
+	netherID := world.DimensionID(game.Nether)
+	endID := world.DimensionID(game.TheEnd)
+
+	tests := []struct {
+		name        string
+		dimensionID uuid.UUID
+		blockID     objects.BlockID
+		pos         data.PositionF
+		expectOK    bool
+		expectDimID uuid.UUID
+		expectPos   data.PositionF
+	}{
+		{
+			name:        "overworld_to_nether",
+			dimensionID: overworldID,
+			blockID:     objects.BlockNetherPortal_AxisX,
+			pos:         data.PositionF{X: 80, Y: 64, Z: -160},
+			expectOK:    true,
+			expectDimID: netherID,
+			expectPos:   data.PositionF{X: 10, Y: 64, Z: -20},
+		},
+		{
+			name:        "nether_to_overworld",
+			dimensionID: netherID,
+			blockID:     objects.BlockNetherPortal_AxisZ,
+			pos:         data.PositionF{X: -10.5, Y: 70, Z: 3},
+			expectOK:    true,
+			expectDimID: overworldID,
+			expectPos:   data.PositionF{X: -84, Y: 70, Z: 24},
+		},
+		{
+			name:        "overworld_to_end",
+			dimensionID: overworldID,
+			blockID:     objects.BlockEndPortal,
+			pos:         data.PositionF{X: 500, Y: 30, Z: 500},
+			expectOK:    true,
+			expectDimID: endID,
+			expectPos:   endSpawn,
+		},
+		{
+			name:        "end_to_overworld",
+			dimensionID: endID,
+			blockID:     objects.BlockEndPortal,
+			pos:         data.PositionF{X: 0, Y: 60, Z: 0},
+			expectOK:    true,
+			expectDimID: overworldID,
+			expectPos:   worldSpawn,
+		},
+		{
+			name:        "nether_portal_in_the_end",
+			dimensionID: endID,
+			blockID:     objects.BlockNetherPortal_AxisX,
+		},
+		{
+			name:        "end_portal_in_the_nether",
+			dimensionID: netherID,
+			blockID:     objects.BlockEndPortal,
+		},
+		{
+			name:        "not_a_portal",
+			dimensionID: overworldID,
+			blockID:     objects.BlockStone,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dimensionID, pos, ok := portalDestination(test.dimensionID, test.blockID, test.pos)
+			assert.Equal(t, test.expectOK, ok)
+			if test.expectOK {
+				assert.Equal(t, test.expectDimID, dimensionID)
+				assert.Equal(t, test.expectPos, pos)
+			}
+		})
+	}
+}
+
+func TestClampToEdges(t *testing.T) {
+	edges := level.Edges{NegativeX: -32, NegativeZ: -16, PositiveX: 16, PositiveZ: 48} // chunks -32 to 16 and -16 to 48
+
+	tests := []struct {
+		name     string
+		pos      data.PositionF
+		expected data.PositionF
+	}{
+		{
+			name:     "inside",
+			pos:      data.PositionF{X: 3.5, Y: 70, Z: -2},
+			expected: data.PositionF{X: 3.5, Y: 70, Z: -2},
+		},
+		{
+			name:     "past_negative_edges",
+			pos:      data.PositionF{X: -100, Y: 70, Z: -17},
+			expected: data.PositionF{X: -32, Y: 70, Z: -16},
+		},
+		{
+			name:     "past_positive_edges",
+			pos:      data.PositionF{X: 40, Y: 70, Z: 64},
+			expected: data.PositionF{X: 31.5, Y: 70, Z: 63.5},
+		},
+		{
+			name:     "inside_last_chunk",
+			pos:      data.PositionF{X: 31, Y: 70, Z: 50},
+			expected: data.PositionF{X: 31, Y: 70, Z: 50},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, clampToEdges(test.pos, edges))
+		})
+	}
+}
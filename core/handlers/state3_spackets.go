@@ -1,20 +1,29 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
 
+	"github.com/alexykot/cncraft/core/commands"
 	"github.com/alexykot/cncraft/core/nats"
 	"github.com/alexykot/cncraft/core/nats/subj"
 	"github.com/alexykot/cncraft/core/players"
 	"github.com/alexykot/cncraft/core/world"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/items"
+	gamePlayer "github.com/alexykot/cncraft/pkg/game/player"
+	"github.com/alexykot/cncraft/pkg/game/recipes"
 	"github.com/alexykot/cncraft/pkg/protocol"
 	"github.com/alexykot/cncraft/pkg/protocol/plugin"
 )
@@ -69,6 +78,21 @@ func HandleSKeepAlive(aliveRecorder func(uuid.UUID, int64), connID uuid.UUID, sP
 	return nil
 }
 
+// HandleSTeleportConfirm confirms the teleport the client arrived at, the positions the client sends are taken
+// into account again after. Confirmations of the teleports other than the last one sent are ignored.
+func HandleSTeleportConfirm(log *zap.Logger, player *players.Player, sPacket protocol.SPacket) error {
+	teleportConfirm, ok := sPacket.(*protocol.SPacketTeleportConfirm)
+	if !ok {
+		return fmt.Errorf("received packet is not a teleportConfirm: %v", sPacket)
+	}
+
+	if !player.ConfirmTeleport(teleportConfirm.TeleportID) {
+		log.Debug("unexpected teleport confirmation ignored", zap.String("player", player.Username),
+			zap.Int32("teleport", teleportConfirm.TeleportID))
+	}
+	return nil
+}
+
 func HandleSPlayerSpatial(locSetter func(uuid.UUID, *data.PositionF, *data.RotationF, *bool), connID uuid.UUID, sPacket protocol.SPacket) error {
 	if playerPos, ok := sPacket.(*protocol.SPacketPlayerPosition); ok {
 		locSetter(connID, &playerPos.Position, nil, nil)
@@ -88,12 +112,17 @@ func HandleSHeldItemChange(heldItemSetter func(connID uuid.UUID, heldItem uint8)
 	if !ok {
 		return fmt.Errorf("received packet is not a heldItemChange: %v", sPacket)
 	}
+	if heldItem.Slot >= items.HotbarSize { // same as in the Notchian server, the change is ignored
+		return fmt.Errorf("held item slot %d out of the hotbar", heldItem.Slot)
+	}
 
 	heldItemSetter(connID, heldItem.Slot)
 	return nil
 }
 
-func HandleSClickWindow(inventory *items.Inventory, log *zap.Logger, sPacket protocol.SPacket) (bool, []protocol.CPacket, error) {
+// HandleSClickWindow handles clicks in the player inventory and in the container window the player has open. Container
+// slots changed by the click are updated for everybody else viewing the same container.
+func HandleSClickWindow(ps nats.PubSub, player *players.Player, dropItem ItemDropper, log *zap.Logger, sPacket protocol.SPacket) (bool, []protocol.CPacket, error) {
 	windowClick, ok := sPacket.(*protocol.SPacketClickWindow)
 	if !ok {
 		return false, nil, fmt.Errorf("received packet is not a clickWindow: %v", sPacket)
@@ -108,6 +137,7 @@ func HandleSClickWindow(inventory *items.Inventory, log *zap.Logger, sPacket pro
 	var cPackets []protocol.CPacket
 	var isInventoryUpdated bool
 
+	inventory := player.State.Inventory
 	switch windowClick.WindowID {
 	case items.InventoryWindow:
 		var err error
@@ -118,54 +148,196 @@ func HandleSClickWindow(inventory *items.Inventory, log *zap.Logger, sPacket pro
 			log.Warn("invalid window click received", zap.Error(err))
 			windowConfirm.Accepted = false
 
-			cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CWindowItems)
-			windowItems := cpacket.(*protocol.CPacketWindowItems)
-			inventorySlots := inventory.ToArray()
-			windowItems.SlotCount = int16(len(inventorySlots))
-			windowItems.Slots = inventorySlots
+			log.Debug("resetting cursor", zap.Any("cursor", inventory.GetCursor()))
+			cPackets = append(cPackets, windowConfirm, mkInventoryItems(inventory), mkCursor(inventory.GetCursor()))
+			break
+		}
 
-			cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
-			setSlot := cpacket.(*protocol.CPacketSetSlot)
-			setSlot.WindowID = items.CursorWindow
-			setSlot.SlotID = items.CursorSlot
-			setSlot.Slot = inventory.GetCursor()
-			log.Debug("resetting cursor", zap.Any("cursor", setSlot.Slot))
+		if droppedItem != nil {
+			if err := dropItem(*droppedItem); err != nil {
+				return isInventoryUpdated, nil, fmt.Errorf("failed to drop item: %w", err)
+			}
+		}
+		cPackets = append(cPackets, windowConfirm)
+
+		// Notchian client does not work out the crafting result, nor the crafted items going into the inventory.
+		if isInventoryUpdated && inventory.IsCraftingSlot(windowClick.SlotID) {
+			cPackets = append(cPackets, mkInventoryItems(inventory))
+		}
+	default:
+		window, ok := player.GetWindow(windowClick.WindowID)
+		if !ok {
+			return false, nil, fmt.Errorf("window ID %d is not open", windowClick.WindowID)
+		}
 
-			cPackets = append(cPackets, windowConfirm, windowItems, setSlot)
+		before := window.ContainerSlots()
+		droppedItem, isUpdated, err := window.HandleClick(
+			windowClick.ActionID, windowClick.SlotID, windowClick.Mode, windowClick.Button, windowClick.ClickedItem)
+		if err != nil {
+			log.Warn("invalid window click received", zap.Error(err))
+			windowConfirm.Accepted = false
+			cPackets = append(cPackets, windowConfirm, mkWindowItems(window), mkCursor(window.GetCursor()))
 			break
 		}
 
 		if droppedItem != nil {
-			// TODO handle dropped item
+			if err := dropItem(*droppedItem); err != nil {
+				return isUpdated, nil, fmt.Errorf("failed to drop item: %w", err)
+			}
 		}
 		cPackets = append(cPackets, windowConfirm)
-	default:
-		return false, nil, fmt.Errorf("window ID %d is not implemented", windowClick.WindowID)
+
+		// same as for the inventory, crafting results and taking the results out are not worked out by the client
+		if isUpdated && (window.Type == items.WindowCrafting || window.IsResultSlot(windowClick.SlotID)) {
+			cPackets = append(cPackets, mkWindowItems(window))
+		}
+		if isUpdated {
+			if err := publishContainerChanges(ps, player.ConnID, window, before); err != nil {
+				return isUpdated, nil, err
+			}
+		}
+		isInventoryUpdated = isUpdated
 	}
 
 	return isInventoryUpdated, cPackets, nil
 }
 
-func HandleSCloseWindow(player *players.Player, sPacket protocol.SPacket) error {
+// publishContainerChanges sends the container slots changed since before to everybody else viewing the container.
+func publishContainerChanges(ps nats.PubSub, connID uuid.UUID, window *items.ContainerWindow, before []items.Slot) error {
+	after := window.ContainerSlots()
+	for viewerConnID, windowID := range window.Viewers() {
+		if viewerConnID == connID {
+			continue
+		}
+
+		for slotID, slot := range after {
+			if was := before[slotID]; slot.ItemID == was.ItemID && slot.ItemCount == was.ItemCount {
+				continue // empty container slots are always zeroed
+			}
+
+			cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
+			setSlot := cpacket.(*protocol.CPacketSetSlot)
+			setSlot.WindowID = windowID
+			setSlot.SlotID = int16(slotID)
+			setSlot.Slot = slot
+
+			if err := ps.Publish(subj.MkConnTransmit(viewerConnID), envelope.MkCpacketEnvelope(setSlot)); err != nil {
+				return fmt.Errorf("failed to publish container slot update: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func HandleSCloseWindow(player *players.Player, dropItem ItemDropper, sPacket protocol.SPacket) (bool, []protocol.CPacket, error) {
 	closeWindow, ok := sPacket.(*protocol.SPacketCloseWindow)
 	if !ok {
-		return fmt.Errorf("received packet is not a closeWindow: %v", sPacket)
+		return false, nil, fmt.Errorf("received packet is not a closeWindow: %v", sPacket)
 	}
 
+	var cPackets []protocol.CPacket
+	var isInventoryUpdated bool
+
 	switch closeWindow.WindowID {
 	case items.InventoryWindow:
-		droppedItem := player.State.Inventory.CloseWindow()
-		if droppedItem.IsPresent {
-			// TODO handle dropped item
+		inventory := player.State.Inventory
+
+		droppedItems := inventory.ClearCraftingGrid()
+		if droppedItem := inventory.CloseWindow(); droppedItem.IsPresent {
+			droppedItems = append(droppedItems, droppedItem)
+		}
+		for _, droppedItem := range droppedItems {
+			if err := dropItem(droppedItem); err != nil {
+				return true, nil, fmt.Errorf("failed to drop item: %w", err)
+			}
 		}
+
+		isInventoryUpdated = true
+		cPackets = append(cPackets, mkInventoryItems(inventory))
 	default:
-		return fmt.Errorf("window ID %d is not implemented", closeWindow.WindowID)
+		window, ok := player.TakeWindow(closeWindow.WindowID)
+		if !ok {
+			return false, nil, nil // already closed by the server, e.g. when the container block was broken
+		}
+
+		for _, droppedItem := range window.Close(player.ConnID) {
+			if err := dropItem(droppedItem); err != nil {
+				return true, nil, fmt.Errorf("failed to drop item: %w", err)
+			}
+		}
+
+		isInventoryUpdated = true
+		cPackets = append(cPackets, mkInventoryItems(player.State.Inventory))
 	}
 
-	return nil
+	return isInventoryUpdated, cPackets, nil
 }
 
-func HandleSWindowConfirmation(inventory *items.Inventory, sPacket protocol.SPacket) error {
+// HandleSCraftRecipeRequest fills the crafting grid with the ingredients of the recipe picked in the recipe book.
+// If the player does not have the ingredients, the client is told to show the recipe in the grid instead.
+func HandleSCraftRecipeRequest(inventory *items.Inventory, sPacket protocol.SPacket) (bool, []protocol.CPacket, error) {
+	recipeRequest, ok := sPacket.(*protocol.SPacketCraftRecipeRequest)
+	if !ok {
+		return false, nil, fmt.Errorf("received packet is not a craftRecipeRequest: %v", sPacket)
+	}
+
+	if recipeRequest.WindowID != items.InventoryWindow {
+		return false, nil, fmt.Errorf("window ID %d is not implemented", recipeRequest.WindowID)
+	}
+
+	recipe, ok := recipes.Get(recipeRequest.RecipeID)
+	if !ok {
+		return false, nil, fmt.Errorf("recipe %s not found", recipeRequest.RecipeID)
+	}
+
+	isPlaced, err := inventory.PlaceRecipe(recipe, recipeRequest.MakeAll)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to place recipe: %w", err)
+	}
+
+	cPackets := []protocol.CPacket{mkInventoryItems(inventory)}
+	if !isPlaced {
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CCraftRecipeResponse)
+		recipeResponse := cpacket.(*protocol.CPacketCraftRecipeResponse)
+		recipeResponse.WindowID = recipeRequest.WindowID
+		recipeResponse.RecipeID = recipe.ID
+		cPackets = append(cPackets, recipeResponse)
+	}
+
+	return true, cPackets, nil
+}
+
+// mkWindowItems provides the packet setting all the container window slots.
+func mkWindowItems(window *items.ContainerWindow) *protocol.CPacketWindowItems {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CWindowItems)
+	windowItems := cpacket.(*protocol.CPacketWindowItems)
+	windowItems.WindowID = window.WindowID
+	windowItems.Slots = window.ToArray()
+	windowItems.SlotCount = int16(len(windowItems.Slots))
+	return windowItems
+}
+
+// mkCursor provides the packet setting the item held on the cursor.
+func mkCursor(cursor items.Slot) *protocol.CPacketSetSlot {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
+	setSlot := cpacket.(*protocol.CPacketSetSlot)
+	setSlot.WindowID = items.CursorWindow
+	setSlot.SlotID = items.CursorSlot
+	setSlot.Slot = cursor
+	return setSlot
+}
+
+// mkInventoryItems provides the packet setting all the player inventory slots.
+func mkInventoryItems(inventory *items.Inventory) *protocol.CPacketWindowItems {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CWindowItems)
+	windowItems := cpacket.(*protocol.CPacketWindowItems)
+	windowItems.WindowID = items.InventoryWindow
+	windowItems.Slots = inventory.ToArray()
+	windowItems.SlotCount = int16(len(windowItems.Slots))
+	return windowItems
+}
+
+func HandleSWindowConfirmation(player *players.Player, sPacket protocol.SPacket) error {
 	windowConfirm, ok := sPacket.(*protocol.SPacketWindowConfirmation)
 	if !ok {
 		return fmt.Errorf("received packet is not a windowConfirmation: %v", sPacket)
@@ -173,9 +345,13 @@ func HandleSWindowConfirmation(inventory *items.Inventory, sPacket protocol.SPac
 
 	switch windowConfirm.WindowID {
 	case items.InventoryWindow:
-		inventory.Apologise(windowConfirm.ActionID)
+		player.State.Inventory.Apologise(windowConfirm.ActionID)
 	default:
-		return fmt.Errorf("window ID %d is not implemented", windowConfirm.WindowID)
+		window, ok := player.GetWindow(windowConfirm.WindowID)
+		if !ok {
+			return fmt.Errorf("window ID %d is not open", windowConfirm.WindowID)
+		}
+		window.Apologise(windowConfirm.ActionID)
 	}
 
 	return nil
@@ -199,15 +375,29 @@ func HandleSAnimation(sPacket protocol.SPacket) error {
 	return nil
 }
 
-func HandleSPlayerDigging(ps nats.PubSub, sharder *world.Sharder, player *players.Player, sPacket protocol.SPacket) error {
+// HandleSPlayerDigging routes digging to the shard of the block dug. Item drops from the held slot are also reported
+// via digging, those are taken out of the inventory right away, returns true if the inventory was updated.
+func HandleSPlayerDigging(ps nats.PubSub, sharder *world.Sharder, player *players.Player, dropItem ItemDropper, sPacket protocol.SPacket) (bool, error) {
 	dig, ok := sPacket.(*protocol.SPacketPlayerDigging)
 	if !ok {
-		return fmt.Errorf("received packet is not a playerDigging: %v", sPacket)
+		return false, fmt.Errorf("received packet is not a playerDigging: %v", sPacket)
+	}
+
+	switch dig.Status {
+	case gamePlayer.DropItem, gamePlayer.DropItemStack:
+		dropped := player.GetState().Inventory.DropHeld(dig.Status == gamePlayer.DropItemStack)
+		if !dropped.IsPresent {
+			return false, nil
+		}
+		if err := dropItem(dropped); err != nil {
+			return true, fmt.Errorf("failed to drop item: %w", err)
+		}
+		return true, nil
 	}
 
 	shardID, ok := sharder.FindShardID(player.State.Dimension, dig.Position)
 	if !ok {
-		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", dig.Position.X, dig.Position.Z)
+		return false, fmt.Errorf("could not find shard for coords provided: x.%d z.%d", dig.Position.X, dig.Position.Z)
 	}
 
 	lope := envelope.PlayerDigging(&pb.PlayerDigging{
@@ -222,8 +412,240 @@ func HandleSPlayerDigging(ps nats.PubSub, sharder *world.Sharder, player *player
 	})
 
 	if err := ps.Publish(subj.MkShardEvent(string(shardID)), lope); err != nil {
-		return fmt.Errorf("failed to publish shard PlayerDigging event: %w", err)
+		return false, fmt.Errorf("failed to publish shard PlayerDigging event: %w", err)
+	}
+
+	return false, nil
+}
+
+// ItemDropper throws the item out of the player inventory into the world.
+type ItemDropper func(item items.Slot) error
+
+// DropItem publishes the item thrown out by the player to the shard the player is in.
+func DropItem(ps nats.PubSub, sharder *world.Sharder, player *players.Player, item items.Slot) error {
+	playerPos := player.GetLocation().PositionF.ToBlock()
+	shardID, ok := sharder.FindShardID(player.GetState().Dimension, playerPos)
+	if !ok {
+		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", playerPos.X, playerPos.Z)
+	}
+
+	lope := envelope.PlayerDroppedItem(&pb.PlayerDroppedItem{
+		PlayerId:  player.ConnID.String(),
+		ItemId:    int32(item.ItemID),
+		ItemCount: int32(item.ItemCount),
+	})
+
+	if err := ps.Publish(subj.MkShardEvent(string(shardID)), lope); err != nil {
+		return fmt.Errorf("failed to publish shard PlayerDroppedItem event: %w", err)
+	}
+
+	return nil
+}
+
+func HandleSPlayerBlockPlacement(ps nats.PubSub, sharder *world.Sharder, player *players.Player, sPacket protocol.SPacket) error {
+	placement, ok := sPacket.(*protocol.SPacketPlayerBlockPlacement)
+	if !ok {
+		return fmt.Errorf("received packet is not a playerBlockPlacement: %v", sPacket)
+	}
+
+	// the clicked block shard handles the placement, as the clicked block may be a container to open or a replaceable
+	//  block to place into. Otherwise the block is placed next to the one clicked, which may be in another shard.
+	shardID, ok := sharder.FindShardID(player.State.Dimension, placement.Location)
+	if !ok {
+		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", placement.Location.X, placement.Location.Z)
+	}
+	placePos := placement.Location.Facing(pb.BlockFace(placement.Face))
+	placeShardID, ok := sharder.FindShardID(player.State.Dimension, placePos)
+	if !ok {
+		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", placePos.X, placePos.Z)
+	}
+
+	lope := envelope.PlayerBlockPlacement(&pb.PlayerBlockPlacement{
+		PlayerId: player.ConnID.String(),
+		Hand:     pb.PlayerBlockPlacement_Hand(placement.Hand),
+		Pos: &pb.Position{
+			X: float64(placement.Location.X),
+			Y: float64(placement.Location.Y),
+			Z: float64(placement.Location.Z),
+		},
+		BlockFace:    pb.BlockFace(placement.Face),
+		PlaceShardId: string(placeShardID),
+	})
+
+	if err := ps.Publish(subj.MkShardEvent(string(shardID)), lope); err != nil {
+		return fmt.Errorf("failed to publish shard PlayerBlockPlacement event: %w", err)
+	}
+
+	return nil
+}
+
+func HandleSQueryBlockNBT(ps nats.PubSub, sharder *world.Sharder, player *players.Player, sPacket protocol.SPacket) error {
+	query, ok := sPacket.(*protocol.SPacketQueryBlockNBT)
+	if !ok {
+		return fmt.Errorf("received packet is not a queryBlockNBT: %v", sPacket)
+	}
+
+	shardID, ok := sharder.FindShardID(player.State.Dimension, query.Location)
+	if !ok {
+		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", query.Location.X, query.Location.Z)
+	}
+
+	lope := envelope.PlayerQueriedBlockNBT(&pb.PlayerQueriedBlockNBT{
+		PlayerId:      player.ConnID.String(),
+		TransactionId: query.TransactionID,
+		Pos: &pb.Position{
+			X: float64(query.Location.X),
+			Y: float64(query.Location.Y),
+			Z: float64(query.Location.Z),
+		},
+	})
+
+	if err := ps.Publish(subj.MkShardEvent(string(shardID)), lope); err != nil {
+		return fmt.Errorf("failed to publish shard PlayerQueriedBlockNBT event: %w", err)
+	}
+
+	return nil
+}
+
+// HandleSUpdateSign strips the formatting from the sign text written by the player, and passes it on to the shard
+// of the sign.
+func HandleSUpdateSign(ps nats.PubSub, sharder *world.Sharder, player *players.Player, sPacket protocol.SPacket) error {
+	update, ok := sPacket.(*protocol.SPacketUpdateSign)
+	if !ok {
+		return fmt.Errorf("received packet is not an updateSign: %v", sPacket)
+	}
+
+	shardID, ok := sharder.FindShardID(player.State.Dimension, update.Location)
+	if !ok {
+		return fmt.Errorf("could not find shard for coords provided: x.%d z.%d", update.Location.X, update.Location.Z)
+	}
+
+	lines := make([]string, len(update.Lines))
+	for i, line := range update.Lines {
+		lines[i] = chat.StripFormatting(line)
+	}
+
+	lope := envelope.PlayerUpdatedSign(&pb.PlayerUpdatedSign{
+		PlayerId: player.ConnID.String(),
+		Pos: &pb.Position{
+			X: float64(update.Location.X),
+			Y: float64(update.Location.Y),
+			Z: float64(update.Location.Z),
+		},
+		Lines: lines,
+	})
+
+	if err := ps.Publish(subj.MkShardEvent(string(shardID)), lope); err != nil {
+		return fmt.Errorf("failed to publish shard PlayerUpdatedSign event: %w", err)
+	}
+
+	return nil
+}
+
+// chatMessageMaxLength - longest chat message the Notchian server accepts, in characters.
+const chatMessageMaxLength = 256
+
+// HandleSChatMessage executes the command written by the player, or otherwise sends the message to the chat
+// of every player in the cluster. Players writing too long messages, illegal characters or spamming are kicked,
+// same as by the Notchian server.
+func HandleSChatMessage(log *zap.Logger, ps nats.PubSub, cmds *brigadier.Dispatcher, player *players.Player, sPacket protocol.SPacket) ([]protocol.CPacket, error) {
+	chatMessage, ok := sPacket.(*protocol.SPacketChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("received packet is not a chatMessage: %v", sPacket)
+	}
+
+	text := strings.TrimSpace(chatMessage.Message)
+	if length := utf8.RuneCountInString(text); length > chatMessageMaxLength {
+		return nil, newPacketError(IllegalChatErr, fmt.Errorf("chat message of %d characters written by %s is too long",
+			length, player.Username))
+	}
+	for _, r := range text {
+		if !chat.IsAllowedChar(r) {
+			return nil, newPacketError(IllegalChatErr, fmt.Errorf("illegal character %q written by %s", r, player.Username))
+		}
+	}
+
+	if settings := player.GetSettings(); settings != nil && settings.ChatMode == gamePlayer.Hidden {
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CChatMessage)
+		cannotSend := cpacket.(*protocol.CPacketChatMessage)
+		cannotSend.Message = *chat.New("Cannot send chat message").SetColor(chat.Red)
+		cannotSend.MessagePosition = chat.SystemChat
+		return []protocol.CPacket{cannotSend}, nil
+	}
+
+	if isSpamming := player.CountChatMessage(time.Now()); isSpamming {
+		return nil, newPacketError(ChatSpamErr, fmt.Errorf("too many messages written by %s", player.Username))
+	}
+
+	if text == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(text, "/") {
+		return nil, executeCommand(log, ps, cmds, player, text[1:])
+	}
+
+	message := chat.New(fmt.Sprintf("<%s> ", player.Username))
+	message.Add(text)
+
+	lope := envelope.ChatMessage(&pb.ChatMessage{
+		SenderId: player.ID.String(),
+		Message:  message.AsJson(),
+		Position: int32(chat.NormalChat),
+	})
+	if err := ps.Publish(subj.MkChatMessage(), lope); err != nil {
+		return nil, fmt.Errorf("failed to publish ChatMessage: %w", err)
+	}
+
+	return nil, nil
+}
+
+// executeCommand executes the command, explaining the failure to the player if it fails.
+func executeCommand(log *zap.Logger, ps nats.PubSub, cmds *brigadier.Dispatcher, player *players.Player, input string) error {
+	sender := commands.NewPlayerSender(log, ps, player)
+
+	err := cmds.Execute(sender, input)
+	if err == nil {
+		return nil
+	}
+
+	var failure *brigadier.Failure
+	if errors.As(err, &failure) {
+		sender.SendMessage(chat.New(failure.Error()).SetColor(chat.Red))
+		return nil
+	}
+
+	sender.SendMessage(chat.New("An unexpected error occurred trying to execute that command").SetColor(chat.Red))
+	return fmt.Errorf("failed to execute command %s: %w", input, err)
+}
+
+// HandleSTabComplete suggests the completions of the command argument being typed by the player.
+func HandleSTabComplete(log *zap.Logger, ps nats.PubSub, cmds *brigadier.Dispatcher, player *players.Player, sPacket protocol.SPacket) ([]protocol.CPacket, error) {
+	tabComplete, ok := sPacket.(*protocol.SPacketTabComplete)
+	if !ok {
+		return nil, fmt.Errorf("received packet is not a tabComplete: %v", sPacket)
+	}
+
+	if !strings.HasPrefix(tabComplete.Text, "/") {
+		return nil, nil // the Notchian client only asks for the command completions
+	}
+
+	start, matches := cmds.Suggest(commands.NewPlayerSender(log, ps, player), tabComplete.Text[1:])
+
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CTabComplete)
+	suggestions := cpacket.(*protocol.CPacketTabComplete)
+	suggestions.TransactionID = tabComplete.TransactionID
+	suggestions.Start = int32(start + 1) // past the leading slash
+	suggestions.Length = int32(len(tabComplete.Text) - start - 1)
+	suggestions.Matches = matches
+
+	return []protocol.CPacket{suggestions}, nil
+}
+
+func HandleSUseItem(sPacket protocol.SPacket) error {
+	if _, ok := sPacket.(*protocol.SPacketUseItem); !ok {
+		return fmt.Errorf("received packet is not a useItem: %v", sPacket)
 	}
 
+	// TODO using items (eating, throwing, drawing bows etc) is not implemented yet
 	return nil
 }
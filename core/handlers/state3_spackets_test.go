@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	psMocks "github.com/alexykot/cncraft/core/nats/mocks"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	gamePlayer "github.com/alexykot/cncraft/pkg/game/player"
+	"github.com/alexykot/cncraft/pkg/log"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+func TestHandleSChatMessage(t *testing.T) {
+	tests := []struct {
+		name          string
+		chatMode      gamePlayer.ChatMode
+		message       string
+		expectPublish bool
+		expectErr     error
+		expectReply   bool // the player is told the message cannot be sent
+	}{
+		{name: "sent", chatMode: gamePlayer.Full, message: "hello", expectPublish: true},
+		{name: "sent_commands_only", chatMode: gamePlayer.CommandsOnly, message: "hello", expectPublish: true},
+		{name: "longest", chatMode: gamePlayer.Full, message: strings.Repeat("a", chatMessageMaxLength), expectPublish: true},
+		{name: "blank", chatMode: gamePlayer.Full, message: "  "},
+		{name: "hidden", chatMode: gamePlayer.Hidden, message: "hello", expectReply: true},
+		{name: "too_long", chatMode: gamePlayer.Full, message: strings.Repeat("a", chatMessageMaxLength+1), expectErr: IllegalChatErr},
+		{name: "illegal_character", chatMode: gamePlayer.Full, message: "hello§cworld", expectErr: IllegalChatErr},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ps := psMocks.NewMockPubSub(ctrl)
+			if test.expectPublish {
+				ps.EXPECT().Publish(subj.MkChatMessage(), gomock.Any()).Return(nil)
+			}
+
+			p := &players.Player{
+				ID:       uuid.New(),
+				Username: "Alex",
+				Settings: &gamePlayer.Settings{ChatMode: test.chatMode},
+			}
+			cpackets, err := HandleSChatMessage(log.MustGetTestNamed(t.Name()), ps, nil, p,
+				&protocol.SPacketChatMessage{Message: test.message})
+
+			if test.expectErr != nil {
+				assert.True(t, errors.Is(err, test.expectErr), "unexpected error %v", err)
+				return
+			}
+			require.NoError(t, err)
+			if test.expectReply {
+				require.Len(t, cpackets, 1)
+				assert.IsType(t, &protocol.CPacketChatMessage{}, cpackets[0])
+			} else {
+				assert.Empty(t, cpackets)
+			}
+		})
+	}
+
+	t.Run("spamming", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ps := psMocks.NewMockPubSub(ctrl)
+		ps.EXPECT().Publish(subj.MkChatMessage(), gomock.Any()).Return(nil).Times(10)
+
+		p := &players.Player{ID: uuid.New(), Username: "Alex", Settings: &gamePlayer.Settings{}}
+		for i := 0; i < 10; i++ {
+			_, err := HandleSChatMessage(log.MustGetTestNamed(t.Name()), ps, nil, p, &protocol.SPacketChatMessage{Message: "spam"})
+			require.NoError(t, err)
+		}
+		_, err := HandleSChatMessage(log.MustGetTestNamed(t.Name()), ps, nil, p, &protocol.SPacketChatMessage{Message: "spam"})
+		assert.True(t, errors.Is(err, ChatSpamErr), "unexpected error %v", err)
+	})
+}
@@ -0,0 +1,7 @@
+package subj
+
+// *** Chat related subjects ***
+
+// MkChatMessage creates a subject name string for chat messages delivered to all players in the cluster.
+//  Every node delivers the message to the players connected to it.
+func MkChatMessage() Subj { return "chat.message" }
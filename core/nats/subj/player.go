@@ -20,3 +20,11 @@ func MkPlayerSpatialUpdate() Subj { return "players.update.spatial" }
 // MkPlayerInventoryUpdate creates a subject name string for announcing player inventory updates.
 //  This is sent every time player inventory changes (including hotbar).
 func MkPlayerInventoryUpdate() Subj { return "players.update.inventory" }
+
+// MkPlayerTeleport creates a subject name string for moving players to another position or dimension.
+//  This is sent when player enters a portal or is teleported by an admin.
+func MkPlayerTeleport() Subj { return "players.teleport" }
+
+// MkPlayerKick creates a subject name string for disconnecting players kicked off the server.
+//  This is handled by the node the player is connected to.
+func MkPlayerKick() Subj { return "players.kick" }
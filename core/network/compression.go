@@ -3,18 +3,24 @@ package network
 import (
 	"bytes"
 	"compress/zlib"
+	"fmt"
 	"io"
 )
 
 type compressor struct {
-	enabled bool
+	enabled   bool
+	threshold int32 // packets this long or longer are compressed, shorter ones are sent as is
+
+	reader io.ReadCloser // zlib reader, reset and reused for every packet inflated
 }
 
-func (c *compressor) Enable() {
+func (c *compressor) Enable(threshold int32) {
 	c.enabled = true
+	c.threshold = threshold
 }
 func (c *compressor) Disable() {
 	c.enabled = false
+	c.threshold = 0
 }
 
 func (c *compressor) Deflate(data []byte) []byte {
@@ -31,16 +37,32 @@ func (c *compressor) Deflate(data []byte) []byte {
 	return out.Bytes()
 }
 
-func (c *compressor) Inflate(data []byte) []byte {
+// Inflate decompresses the data into a packet of the given length, the data inflating into anything else is rejected.
+func (c *compressor) Inflate(data []byte, length int32) ([]byte, error) {
 	if !c.enabled {
-		return data
+		return data, nil
 	}
 
-	reader, _ := zlib.NewReader(bytes.NewReader(data)) // error should never happen with a plain bytes.Reader
+	var err error
+	if c.reader == nil {
+		c.reader, err = zlib.NewReader(bytes.NewReader(data))
+	} else {
+		err = c.reader.(zlib.Resetter).Reset(bytes.NewReader(data), nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zlib header: %w", err)
+	}
 
-	var out bytes.Buffer
-	_, _ = io.Copy(&out, reader) // error should never happen with a plain bytes.Buffer
-	_ = reader.Close()
+	// the packet grows as the data inflates, so that the length declared by the client is not allocated upfront
+	var packet bytes.Buffer
+	if _, err := io.CopyN(&packet, c.reader, int64(length)+1); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to inflate data: %w", err)
+	}
+	if packet.Len() > int(length) {
+		return nil, fmt.Errorf("data inflates into more than %d bytes", length)
+	} else if packet.Len() < int(length) {
+		return nil, fmt.Errorf("data inflates into %d bytes instead of %d", packet.Len(), length)
+	}
 
-	return out.Bytes()
+	return packet.Bytes(), nil
 }
@@ -1,13 +1,16 @@
 package network
 
 import (
+	"errors"
 	"fmt"
 	"net"
+	"sync"
 
 	"github.com/google/uuid"
 
 	"github.com/alexykot/cncraft/pkg/buffer"
 	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/forwarding"
 )
 
 type Connection interface {
@@ -17,39 +20,73 @@ type Connection interface {
 	GetState() protocol.State
 	SetState(protocol.State)
 
+	ReadProxyHeader() error
+
+	GetForwarded() *forwarding.Info
+	SetForwarded(*forwarding.Info)
+
 	EnableEncryption(secret []byte) error
-	EnableCompression()
+	EnableCompression(threshold int32)
 
-	Receive(bufIn *buffer.Buffer) (len int, err error)
+	IsLegacyPing() (bool, error)
+	Receive() (packet []byte, err error)
 	Transmit(bufOut *buffer.Buffer) (len int, err error)
 
 	Close() error
 }
 
 type connection struct {
-	tcp *net.TCPConn
+	tcp net.Conn
 	id  uuid.UUID
 
-	state protocol.State
+	state     protocol.State
+	proxied   net.Addr         // client address provided by the load balancer in the PROXY protocol header, if any
+	forwarded *forwarding.Info // client details forwarded by the proxy, if any
 
+	// packets are transmitted from the dispatcher and from the transmit handler, encryption and compression state
+	// changes with every packet, so the whole transmission is done holding the lock
+	mu  sync.Mutex
 	aes crypter
 	zip compressor
+
+	frames *frameReader
 }
 
 func NewConnection(conn *net.TCPConn) Connection {
-	return &connection{
+	return newConnection(conn)
+}
+
+func newConnection(conn net.Conn) *connection {
+	c := &connection{
 		tcp: conn,
 		id:  uuid.New(),
 
 		aes: crypter{},
 		zip: compressor{},
 	}
+	c.frames = newFrameReader(conn, &c.aes, &c.zip)
+	return c
 }
 
+// Address provides the address of the client, the one forwarded by the proxy or the load balancer if the client
+// connected through them.
 func (c *connection) Address() net.Addr {
+	if c.forwarded != nil {
+		return &net.TCPAddr{IP: c.forwarded.Address}
+	}
+	if c.proxied != nil {
+		return c.proxied
+	}
 	return c.tcp.RemoteAddr()
 }
 
+// ReadProxyHeader reads the PROXY protocol header sent by the load balancer, must be called before anything else
+// is read from the connection.
+func (c *connection) ReadProxyHeader() (err error) {
+	c.proxied, err = readProxyHeader(c.frames.src)
+	return err
+}
+
 func (c *connection) ID() uuid.UUID {
 	return c.id
 }
@@ -62,78 +99,90 @@ func (c *connection) SetState(state protocol.State) {
 	c.state = state
 }
 
+func (c *connection) GetForwarded() *forwarding.Info {
+	return c.forwarded
+}
+
+func (c *connection) SetForwarded(forwarded *forwarding.Info) {
+	c.forwarded = forwarded
+}
+
 // Close closes underlying TCP connection.
 func (c *connection) Close() (err error) {
 	return c.tcp.Close()
 }
 
 func (c *connection) EnableEncryption(secret []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	if err := c.aes.Enable(secret); err != nil {
 		return fmt.Errorf("failed to enable AES encryption: %w", err)
 	}
 	return nil
 }
-func (c *connection) EnableCompression() {
-	c.zip.Enable()
-}
 
-func (c *connection) Receive(bufIn *buffer.Buffer) (len int, err error) {
-	data := make([]byte, 2097151) // max possible packet size according to https://wiki.vg/Protocol#Packet_format
+// EnableCompression enables the compression of the packets at least as long as the threshold, negative threshold
+// leaves the compression disabled, same as for the client.
+func (c *connection) EnableCompression(threshold int32) {
+	if threshold < 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zip.Enable(threshold)
+}
 
-	readLen, err := c.pull(data)
+// IsLegacyPing tells if the connection opens with the legacy server list ping instead of the handshake.
+// Blocks until the client sends anything.
+func (c *connection) IsLegacyPing() (bool, error) {
+	isLegacy, err := c.frames.IsLegacyPing()
 	if err != nil {
-		return 0, err
-	}
-	if c.aes.enabled {
-		data = c.aes.Decrypt(data[:readLen])
+		return false, newNetworkError(ErrTCPReadFail, err)
 	}
+	return isLegacy, nil
+}
 
-	if c.zip.enabled {
-		bufIn.PushBytes(c.zip.Inflate(data), false)
-	} else {
-		bufIn.PushBytes(data, false)
+// Receive blocks until the next whole packet is received, and provides it decrypted and decompressed.
+func (c *connection) Receive() (packet []byte, err error) {
+	if packet, err = c.frames.Next(); err != nil {
+		if errors.Is(err, ErrInvalidFrame) {
+			return nil, err
+		}
+		return nil, newNetworkError(ErrTCPReadFail, err)
 	}
-	return readLen, nil
+	return packet, nil
 }
 
+// Transmit frames, compresses and encrypts the packet, and writes it to the client.
 func (c *connection) Transmit(bufOut *buffer.Buffer) (len int, err error) {
-	temp := buffer.New()
-	temp.PushVarInt(getPacketLength(bufOut))
-	temp.PushBytes(bufOut.Bytes(), false)
-	return c.push(temp.Bytes())
-
-	// TODO need to make it work without encryption/compression first
-	// if c.zip.enabled {
-	// 	deflated := buffer.New()
-	// 	deflated.PushBytes(c.zip.Deflate(bufOut.Bytes()), false)
-	// } else {
-	// 	temp.PushBytes(bufOut.Bytes(), false)
-	// }
-	//
-	// if c.aes.enabled {
-	// 	return c.push(c.aes.Encrypt(temp.Bytes()))
-	// } else {
-	// 	return c.push(temp.Bytes())
-	// }
-}
-
-func getPacketLength(bufOut *buffer.Buffer) int32 {
-	length := bufOut.Len()
-	// packetType := bufOut.PullVarInt()
-	// if packetType == 0x20 {
-	// 	length = length + 1
-	// 	println(fmt.Sprintf("increased length to %d", length))
-	// }
-
-	return int32(length)
-}
-
-func (c *connection) pull(data []byte) (int, error) {
-	readLen, err := c.tcp.Read(data)
-	if err != nil {
-		return 0, newNetworkError(ErrTCPReadFail, err)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.push(c.aes.Encrypt(c.frame(bufOut.Bytes())))
+}
+
+// frame prefixes the packet with its length, and compresses the packet if the compression is enabled and the packet
+// is long enough, as per https://wiki.vg/Protocol#Packet_format
+func (c *connection) frame(packet []byte) []byte {
+	data := buffer.NewFrom(make([]byte, 0, len(packet)+5))
+	if c.zip.enabled {
+		if int32(len(packet)) < c.zip.threshold {
+			data.PushVarInt(0) // zero data length marks the packet as not compressed
+			data.PushBytes(packet, false)
+		} else {
+			data.PushVarInt(int32(len(packet)))
+			data.PushBytes(c.zip.Deflate(packet), false)
+		}
+	} else {
+		data.PushBytes(packet, false)
 	}
-	return readLen, nil
+
+	temp := buffer.NewFrom(make([]byte, 0, data.Len()+5))
+	temp.PushVarInt(int32(data.Len()))
+	temp.PushBytes(data.Bytes(), false)
+	return temp.Bytes()
 }
 
 func (c *connection) push(data []byte) (int, error) {
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/buffer"
+)
+
+// loadNotchianPackets loads the packets captured from the Notchian server, skipping the annotated and empty ones.
+func loadNotchianPackets(t *testing.T) map[string][]byte {
+	paths, err := filepath.Glob("../../examples/notchian_cpackets/*.hex")
+	require.NoError(t, err)
+
+	packets := make(map[string][]byte)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_analysed.hex") {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		require.NoError(t, err)
+
+		packet, err := hex.DecodeString(strings.Join(strings.Fields(string(data)), ""))
+		require.NoError(t, err, path)
+		if len(packet) == 0 {
+			continue
+		}
+		packets[filepath.Base(path)] = packet
+	}
+	require.NotEmpty(t, packets)
+	return packets
+}
+
+func TestConnectionRoundTrip(t *testing.T) {
+	secret := []byte("0123456789abcdef")
+
+	tests := []struct {
+		name      string
+		encrypt   bool
+		threshold int32
+	}{
+		{name: "plain", threshold: -1},
+		{name: "compressed", threshold: 256},
+		{name: "compressed_all", threshold: 0},
+		{name: "encrypted", encrypt: true, threshold: -1},
+		{name: "encrypted_compressed", encrypt: true, threshold: 256},
+	}
+
+	packets := loadNotchianPackets(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverEnd, clientEnd := net.Pipe()
+			defer serverEnd.Close()
+			defer clientEnd.Close()
+
+			server, client := newConnection(serverEnd), newConnection(clientEnd)
+			server.EnableCompression(tt.threshold)
+			client.EnableCompression(tt.threshold)
+			if tt.encrypt {
+				require.NoError(t, server.EnableEncryption(secret))
+				require.NoError(t, client.EnableEncryption(secret))
+			}
+
+			// the same streams carry all the packets, so the cipher state has to stay in sync across them
+			for name, packet := range packets {
+				errs := make(chan error, 1)
+				go func() {
+					_, err := server.Transmit(buffer.NewFrom(packet))
+					errs <- err
+				}()
+
+				received, err := client.Receive()
+				require.NoError(t, err, name)
+				require.NoError(t, <-errs, name)
+				assert.Equal(t, packet, received, name)
+			}
+		})
+	}
+}
+
+func TestConnectionFrame(t *testing.T) {
+	packets := loadNotchianPackets(t)
+	c := &connection{}
+	c.EnableCompression(256)
+
+	short := packets["CServerDifficulty.hex"]
+	frame := buffer.NewFrom(c.frame(short))
+	assert.Equal(t, int32(len(short)+1), frame.PullVarInt())
+	assert.Equal(t, int32(0), frame.PullVarInt(), "packets shorter than threshold are not compressed")
+	assert.Equal(t, short, frame.Bytes()[frame.IndexI():])
+
+	long := packets["CJoinGame.hex"]
+	frame = buffer.NewFrom(c.frame(long))
+	frameLen := frame.PullVarInt()
+	assert.Equal(t, int32(len(long)), frame.PullVarInt(), "data length of the uncompressed packet expected")
+	assert.Less(t, int(frameLen), len(long))
+}
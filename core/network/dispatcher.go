@@ -18,8 +18,11 @@ import (
 	"github.com/alexykot/cncraft/core/nats/subj"
 	"github.com/alexykot/cncraft/core/players"
 	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/chat"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game/items"
 	"github.com/alexykot/cncraft/pkg/protocol"
 	"github.com/alexykot/cncraft/pkg/protocol/auth"
 )
@@ -41,6 +44,7 @@ type dispatcherTransmitter struct {
 	roster  players.Roster
 	aliver  *KeepAliver
 	sharder *world.Sharder
+	cmds    *brigadier.Dispatcher
 
 	// map of mutexes intended to control access to individual connections. Each connection needs to be thread-safe,
 	// but unrelated connections may be processed in parallel.
@@ -50,7 +54,7 @@ type dispatcherTransmitter struct {
 	connMapMu sync.Mutex
 }
 
-func NewDispatcher(log *zap.Logger, ps nats.PubSub, auth auth.A, roster players.Roster, aliver *KeepAliver, sharder *world.Sharder) Dispatcher {
+func NewDispatcher(log *zap.Logger, ps nats.PubSub, auth auth.A, roster players.Roster, aliver *KeepAliver, sharder *world.Sharder, cmds *brigadier.Dispatcher) Dispatcher {
 	return &dispatcherTransmitter{
 		log:     log,
 		ps:      ps,
@@ -58,6 +62,7 @@ func NewDispatcher(log *zap.Logger, ps nats.PubSub, auth auth.A, roster players.
 		roster:  roster,
 		aliver:  aliver,
 		sharder: sharder,
+		cmds:    cmds,
 
 		connMu: make(map[uuid.UUID]*sync.Mutex),
 	}
@@ -78,6 +83,11 @@ func (d *dispatcherTransmitter) Init(ctx context.Context) error {
 	}
 	d.log.Debug("registered conn broadcast handler")
 
+	if err := d.ps.Subscribe(subj.MkPlayerKick(), d.playerKickHandler); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", subj.MkPlayerKick().String(), err)
+	}
+	d.log.Debug("registered player kick handler")
+
 	d.log.Info("dispatcher started")
 	return nil
 }
@@ -109,7 +119,23 @@ func (d *dispatcherTransmitter) HandleSPacket(conn Connection, packetBytes []byt
 		if errors.Is(err, handlers.InvalidLoginErr) {
 			log.Info("invalid login attempt, evicting user", zap.Error(err))
 			d.auth.LoginFailure(conn.ID())
-			if err := d.forceDisconnect(conn.GetState(), conn.ID()); err != nil {
+			if err := d.forceDisconnect(conn.GetState(), conn.ID(), "Failed to log in"); err != nil {
+				log.Error("failed to trigger disconnect", zap.Error(err))
+			}
+		} else if errors.Is(err, handlers.ForwardingErr) {
+			log.Info("client details not forwarded, evicting user", zap.Error(err))
+			d.auth.LoginFailure(conn.ID())
+			if err := d.forceDisconnect(conn.GetState(), conn.ID(), "This server requires you to connect through the proxy"); err != nil {
+				log.Error("failed to trigger disconnect", zap.Error(err))
+			}
+		} else if errors.Is(err, handlers.ChatSpamErr) {
+			log.Info("player spamming chat, evicting user", zap.Error(err))
+			if err := d.forceDisconnect(conn.GetState(), conn.ID(), "Kicked for spamming"); err != nil {
+				log.Error("failed to trigger disconnect", zap.Error(err))
+			}
+		} else if errors.Is(err, handlers.IllegalChatErr) {
+			log.Info("player writing illegal characters in chat, evicting user", zap.Error(err))
+			if err := d.forceDisconnect(conn.GetState(), conn.ID(), "Illegal characters in chat"); err != nil {
 				log.Error("failed to trigger disconnect", zap.Error(err))
 			}
 		} else {
@@ -146,6 +172,7 @@ func (d *dispatcherTransmitter) parseSPacket(connState protocol.State, packetByt
 func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protocol.SPacket) error {
 	var err error
 	var cPackets []protocol.CPacket
+	var loadingLope *envelope.E // published once the LoginSuccess is transmitted, so that the join packets follow it
 
 	debugStateSetter := func(state protocol.State) { // only needed to add the debug log line
 		conn := conn
@@ -155,7 +182,7 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 
 	switch sPacket.Type() {
 	case protocol.SHandshake:
-		err = handlers.HandleSHandshake(debugStateSetter, sPacket)
+		err = handlers.HandleSHandshake(debugStateSetter, conn.SetForwarded, sPacket)
 	case protocol.SRequest:
 		if cPackets, err = handlers.HandleSRequest(sPacket); err != nil {
 			return fmt.Errorf("failed to handle SRequest packet: %w", err)
@@ -163,10 +190,14 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 	case protocol.SPing:
 		cPackets, err = handlers.HandleSPing(sPacket)
 	case protocol.SLoginStart:
-		cPackets, err = handlers.HandleSLoginStart(d.auth, d.ps, debugStateSetter, d.aliver.AddAliveConn, conn.ID(), sPacket)
+		cPackets, loadingLope, err = handlers.HandleSLoginStart(
+			d.auth, debugStateSetter, d.aliver.AddAliveConn, conn.GetForwarded(), conn.ID(), sPacket)
+	case protocol.SLoginPluginResponse:
+		cPackets, loadingLope, err = handlers.HandleSLoginPluginResponse(
+			d.auth, debugStateSetter, d.aliver.AddAliveConn, conn.SetForwarded, conn.ID(), sPacket)
 	case protocol.SEncryptionResponse:
-		cPackets, err = handlers.HandleSEncryptionResponse(
-			d.auth, d.ps, debugStateSetter, conn.EnableEncryption, conn.EnableCompression, d.aliver.AddAliveConn, conn.ID(), sPacket)
+		cPackets, loadingLope, err = handlers.HandleSEncryptionResponse(
+			d.auth, debugStateSetter, conn.EnableEncryption, d.aliver.AddAliveConn, conn.ID(), sPacket)
 	case protocol.SPluginMessage:
 		player, ok := d.roster.GetPlayerByConnID(conn.ID())
 		if !ok {
@@ -183,6 +214,13 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 		err = handlers.HandleSClientSettings(player, sPacket)
 	case protocol.SKeepAlive:
 		err = handlers.HandleSKeepAlive(d.aliver.receiveKeepAlive, conn.ID(), sPacket)
+	case protocol.STeleportConfirm:
+		player, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+		err = handlers.HandleSTeleportConfirm(d.log, player, sPacket)
 	case protocol.SPlayerPosition, protocol.SPlayerMovement:
 		err = handlers.HandleSPlayerSpatial(d.roster.SetPlayerSpatial, conn.ID(), sPacket)
 	case protocol.SEntityAction:
@@ -198,7 +236,7 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 			break
 		}
 		var inventoryUpdated bool
-		inventoryUpdated, cPackets, err = handlers.HandleSClickWindow(thisPlayer.State.Inventory, d.log, sPacket)
+		inventoryUpdated, cPackets, err = handlers.HandleSClickWindow(d.ps, thisPlayer, d.dropItem(thisPlayer), d.log, sPacket)
 		if inventoryUpdated {
 			d.roster.PlayerInventoryChanged(conn.ID())
 		}
@@ -209,21 +247,83 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 			break
 		}
 
-		err = handlers.HandleSPlayerDigging(d.ps, d.sharder, thisPlayer, sPacket)
+		var inventoryUpdated bool
+		inventoryUpdated, err = handlers.HandleSPlayerDigging(d.ps, d.sharder, thisPlayer, d.dropItem(thisPlayer), sPacket)
+		if inventoryUpdated {
+			d.roster.PlayerInventoryChanged(conn.ID())
+		}
+	case protocol.SPlayerBlockPlacement:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		err = handlers.HandleSPlayerBlockPlacement(d.ps, d.sharder, thisPlayer, sPacket)
+	case protocol.SChatMessage:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		cPackets, err = handlers.HandleSChatMessage(d.log, d.ps, d.cmds, thisPlayer, sPacket)
+	case protocol.STabComplete:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		cPackets, err = handlers.HandleSTabComplete(d.log, d.ps, d.cmds, thisPlayer, sPacket)
+	case protocol.SUseItem:
+		err = handlers.HandleSUseItem(sPacket)
+	case protocol.SQueryBlockNBT:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		err = handlers.HandleSQueryBlockNBT(d.ps, d.sharder, thisPlayer, sPacket)
+	case protocol.SUpdateSign:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		err = handlers.HandleSUpdateSign(d.ps, d.sharder, thisPlayer, sPacket)
 	case protocol.SCloseWindow:
 		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
 		if !ok {
 			err = fmt.Errorf("player %s not found ", conn.ID())
 			break
 		}
-		err = handlers.HandleSCloseWindow(thisPlayer, sPacket)
+		var inventoryUpdated bool
+		inventoryUpdated, cPackets, err = handlers.HandleSCloseWindow(thisPlayer, d.dropItem(thisPlayer), sPacket)
+		if inventoryUpdated {
+			d.roster.PlayerInventoryChanged(conn.ID())
+		}
+	case protocol.SCraftRecipeRequest:
+		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
+		if !ok {
+			err = fmt.Errorf("player %s not found ", conn.ID())
+			break
+		}
+
+		var inventoryUpdated bool
+		inventoryUpdated, cPackets, err = handlers.HandleSCraftRecipeRequest(thisPlayer.State.Inventory, sPacket)
+		if inventoryUpdated {
+			d.roster.PlayerInventoryChanged(conn.ID())
+		}
 	case protocol.SWindowConfirmation:
 		thisPlayer, ok := d.roster.GetPlayerByConnID(conn.ID())
 		if !ok {
 			err = fmt.Errorf("player %s not found ", conn.ID())
 			break
 		}
-		err = handlers.HandleSWindowConfirmation(thisPlayer.State.Inventory, sPacket)
+		err = handlers.HandleSWindowConfirmation(thisPlayer, sPacket)
 	default:
 		return nil
 		// DEBT turn this error back on once all expected packets are handled
@@ -238,12 +338,29 @@ func (d *dispatcherTransmitter) dispatchSPacket(conn Connection, sPacket protoco
 			if err := d.transmitCPacket(conn, cPacket); err != nil {
 				return fmt.Errorf("failed to transmit %s packet: %w", cPacket.Type().String(), err)
 			}
+			// SetCompression itself is sent uncompressed, all the packets after it both ways are compressed.
+			if setCompression, ok := cPacket.(*protocol.CPacketSetCompression); ok {
+				conn.EnableCompression(setCompression.Threshold)
+			}
+		}
+	}
+
+	if loadingLope != nil {
+		if err := d.ps.Publish(subj.MkPlayerLoading(), loadingLope); err != nil {
+			return fmt.Errorf("failed to publish player loading envelope: %w", err)
 		}
 	}
 
 	return nil
 }
 
+// dropItem provides the dropper throwing items out of the given player inventory.
+func (d *dispatcherTransmitter) dropItem(player *players.Player) handlers.ItemDropper {
+	return func(item items.Slot) error {
+		return handlers.DropItem(d.ps, d.sharder, player, item)
+	}
+}
+
 func (d *dispatcherTransmitter) connClosedHandler(lope *envelope.E) {
 	closeConn := lope.GetCloseConn()
 	if closeConn == nil {
@@ -258,6 +375,18 @@ func (d *dispatcherTransmitter) connClosedHandler(lope *envelope.E) {
 	}
 	d.log.Debug("connection closed", zap.String("conn", closeConn.ConnId))
 
+	// the container stops showing the player as a viewer, cursor items go back into the inventory before it is saved
+	if player, ok := d.roster.GetPlayerByConnID(connID); ok {
+		if window := player.SetWindow(nil); window != nil {
+			for _, item := range window.Close(connID) {
+				if err := d.dropItem(player)(item); err != nil {
+					d.log.Error("failed to drop item", zap.Error(err))
+				}
+			}
+			d.roster.PlayerInventoryChanged(connID)
+		}
+	}
+
 	if playerID, ok := d.roster.GetPlayerIDByConnID(connID); ok {
 		playerLeftLope := envelope.PlayerLeft(&pb.PlayerLeft{PlayerId: playerID.String()})
 		if err := d.ps.Publish(subj.MkPlayerLeft(), playerLeftLope); err != nil {
@@ -291,6 +420,29 @@ func (d *dispatcherTransmitter) packetBroadcastHandler(lope *envelope.E) {
 	d.connMapMu.Unlock()
 }
 
+// playerKickHandler disconnects the kicked player, if the player is connected to this node.
+func (d *dispatcherTransmitter) playerKickHandler(lope *envelope.E) {
+	kick := lope.GetPlayerKick()
+	if kick == nil {
+		d.log.Error("failed to parse envelope: there is no playerKick inside", zap.Any("envelope", lope))
+		return
+	}
+
+	connID, err := uuid.Parse(kick.ConnId)
+	if err != nil {
+		d.log.Error("failed to parse conn ID as UUID", zap.String("id", kick.ConnId))
+		return
+	}
+	if _, ok := d.roster.GetPlayerByConnID(connID); !ok {
+		return // connected to another node
+	}
+
+	d.log.Info("player kicked, evicting user", zap.String("conn", kick.ConnId), zap.String("reason", kick.Reason))
+	if err := d.forceDisconnect(protocol.Play, connID, kick.Reason); err != nil {
+		d.log.Error("failed to trigger disconnect", zap.Error(err))
+	}
+}
+
 func (d *dispatcherTransmitter) getTransmitHandler(conn Connection) func(lope *envelope.E) {
 	return func(lope *envelope.E) {
 		conn := conn
@@ -391,9 +543,8 @@ func (d *dispatcherTransmitter) transmitBuffer(conn Connection, bufOut *buffer.B
 	return nil
 }
 
-// TODO add chat message here to tell user why they were disconnected
 // DEBT looks like this is not actually dropping the TCP connection, need to add that as well.
-func (d *dispatcherTransmitter) forceDisconnect(connState protocol.State, connID uuid.UUID) error {
+func (d *dispatcherTransmitter) forceDisconnect(connState protocol.State, connID uuid.UUID, reason string) error {
 	d.log.Info("evicting player", zap.String("conn", connID.String()))
 
 	bufOut := buffer.New()
@@ -403,11 +554,13 @@ func (d *dispatcherTransmitter) forceDisconnect(connState protocol.State, connID
 	case protocol.Login:
 		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CDisconnectLogin)
 		disconnect := cpacket.(*protocol.CPacketDisconnectLogin)
+		disconnect.Reason = chat.New(reason)
 		disconnect.Push(bufOut)
 		pacType = disconnect.Type()
 	case protocol.Play:
 		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CDisconnectPlay)
 		disconnect := cpacket.(*protocol.CPacketDisconnectPlay)
+		disconnect.Reason = chat.New(reason)
 		disconnect.Push(bufOut)
 		pacType = disconnect.Type()
 	default:
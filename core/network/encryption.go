@@ -26,15 +26,15 @@ func (c *crypter) Encrypt(data []byte) []byte {
 	return output
 }
 
+// Decrypt decrypts the data in place, the received data is not needed once decrypted.
 func (c *crypter) Decrypt(data []byte) []byte {
 	if !c.enabled {
 		return data
 	}
 
-	output := make([]byte, len(data))
-	c.decrypt.XORKeyStream(output, data)
+	c.decrypt.XORKeyStream(data, data)
 
-	return output
+	return data
 }
 
 func (c *crypter) Enable(secret []byte) error {
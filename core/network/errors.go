@@ -25,6 +25,8 @@ func (e errType) Error() string { return string(e) }
 
 const ErrTCPWriteFail errType = "failed to write to TCP"
 const ErrTCPReadFail errType = "failed to read from TCP"
+const ErrInvalidFrame errType = "invalid packet frame"
+const ErrInvalidProxyHeader errType = "invalid PROXY protocol header"
 
 func newNetworkError(topErr error, wrappedErr error) netError {
 	wrappedMessage := fmt.Sprintf("%s: %s", topErr.Error(), wrappedErr.Error())
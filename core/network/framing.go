@@ -0,0 +1,121 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// maxFrameLength - longest packet frame allowed, the length of it must fit into 3 bytes long VarInt,
+// as per https://wiki.vg/Protocol#Packet_format
+const maxFrameLength = 2097151
+const maxFrameLengthBytes = 3
+
+// maxDataLength - longest packet allowed once decompressed, same as in the Notchian server.
+const maxDataLength = 8388608
+
+// legacyPingByte - first byte of the server list ping sent by the pre-1.7 clients. The legacy ping is not framed.
+const legacyPingByte = 0xFE
+
+// framePool provides the buffers the frames are read into before the packets are unpacked from them.
+var framePool = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+
+// frameReader reads the stream of packet frames from the connection, one packet at a time, regardless of how
+// the frames are split or coalesced by the TCP reads. The frames are decrypted as they are read, so the encryption
+// enabled in between the frames applies from the next frame on.
+type frameReader struct {
+	src *bufio.Reader
+	aes *crypter
+	zip *compressor
+}
+
+func newFrameReader(src io.Reader, aes *crypter, zip *compressor) *frameReader {
+	return &frameReader{src: bufio.NewReader(src), aes: aes, zip: zip}
+}
+
+func (r *frameReader) Read(data []byte) (int, error) {
+	n, err := r.src.Read(data)
+	r.aes.Decrypt(data[:n])
+	return n, err
+}
+
+func (r *frameReader) ReadByte() (byte, error) {
+	b, err := r.src.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	return r.aes.Decrypt([]byte{b})[0], nil
+}
+
+// IsLegacyPing tells if the next byte in the stream starts the legacy server list ping. Only makes sense
+// before the handshake, the legacy ping is never encrypted.
+func (r *frameReader) IsLegacyPing() (bool, error) {
+	next, err := r.src.Peek(1)
+	if err != nil {
+		return false, err
+	}
+	return next[0] == legacyPingByte, nil
+}
+
+// Next reads the next packet frame and unpacks the packet from it, as per https://wiki.vg/Protocol#Packet_format
+// Blocks until the whole frame is received.
+func (r *frameReader) Next() ([]byte, error) {
+	frameLen, err := readVarInt(r, maxFrameLengthBytes)
+	if err != nil {
+		return nil, err
+	}
+	if frameLen <= 0 || frameLen > maxFrameLength {
+		return nil, newNetworkError(ErrInvalidFrame, fmt.Errorf("frame length %d is out of bounds", frameLen))
+	}
+
+	// the frame grows as the data arrives, so that the length declared by the client is not allocated upfront
+	frame := framePool.Get().(*bytes.Buffer)
+	defer framePool.Put(frame)
+	frame.Reset()
+	if _, err := io.CopyN(frame, r, int64(frameLen)); err != nil {
+		return nil, err
+	}
+
+	if !r.zip.enabled {
+		return append([]byte(nil), frame.Bytes()...), nil
+	}
+
+	dataLen, err := readVarInt(frame, 5)
+	if err != nil {
+		return nil, newNetworkError(ErrInvalidFrame, fmt.Errorf("failed to read data length: %w", err))
+	}
+	if dataLen == 0 { // zero data length marks the packet as not compressed
+		if frame.Len() == 0 {
+			return nil, newNetworkError(ErrInvalidFrame, fmt.Errorf("frame holds no packet"))
+		}
+		return append([]byte(nil), frame.Bytes()...), nil
+	}
+	if dataLen < r.zip.threshold || dataLen > maxDataLength {
+		return nil, newNetworkError(ErrInvalidFrame, fmt.Errorf("data length %d is out of bounds", dataLen))
+	}
+
+	packet, err := r.zip.Inflate(frame.Bytes(), dataLen)
+	if err != nil {
+		return nil, newNetworkError(ErrInvalidFrame, fmt.Errorf("failed to decompress packet: %w", err))
+	}
+	return packet, nil
+}
+
+// readVarInt reads the VarInt no longer than maxBytes.
+func readVarInt(r io.ByteReader, maxBytes int) (int32, error) {
+	var value int32
+	for i := 0; i < maxBytes; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		value |= int32(b&0x7F) << uint(7*i)
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+	return 0, newNetworkError(ErrInvalidFrame, fmt.Errorf("VarInt is longer than %d bytes", maxBytes))
+}
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"bytes"
+	"io"
+	"runtime"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrameReaderSplitAndCoalesced(t *testing.T) {
+	packets := loadNotchianPackets(t)
+	c := &connection{}
+
+	var stream []byte
+	var expected [][]byte
+	for _, packet := range packets {
+		stream = append(stream, c.frame(packet)...)
+		expected = append(expected, packet)
+	}
+
+	tests := []struct {
+		name string
+		src  io.Reader
+	}{
+		{"coalesced", bytes.NewReader(stream)},
+		{"split", iotest.OneByteReader(bytes.NewReader(stream))},
+		{"halved", iotest.HalfReader(bytes.NewReader(stream))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFrameReader(tt.src, &crypter{}, &compressor{})
+			for _, packet := range expected {
+				received, err := r.Next()
+				require.NoError(t, err)
+				assert.Equal(t, packet, received)
+			}
+			_, err := r.Next()
+			assert.Equal(t, io.EOF, err)
+		})
+	}
+}
+
+func TestFrameReaderCompressed(t *testing.T) {
+	packets := loadNotchianPackets(t)
+	zip := &compressor{}
+	zip.Enable(64)
+	c := &connection{zip: *zip}
+
+	var stream []byte
+	for _, name := range []string{"CServerDifficulty.hex", "CJoinGame.hex", "CPluginMessage.hex"} {
+		stream = append(stream, c.frame(packets[name])...)
+	}
+
+	r := newFrameReader(iotest.HalfReader(bytes.NewReader(stream)), &crypter{}, zip)
+	for _, name := range []string{"CServerDifficulty.hex", "CJoinGame.hex", "CPluginMessage.hex"} {
+		received, err := r.Next()
+		require.NoError(t, err, name)
+		assert.Equal(t, packets[name], received, name)
+	}
+}
+
+func TestFrameReaderInvalid(t *testing.T) {
+	zip := &compressor{}
+	zip.Enable(64)
+
+	// 100 zero bytes deflated, framed with the data length declared wrong
+	mkMisdeclared := func(dataLen byte) []byte {
+		deflated := zip.Deflate(make([]byte, 100))
+		return append([]byte{byte(len(deflated) + 1), dataLen}, deflated...)
+	}
+
+	tests := []struct {
+		name   string
+		stream []byte
+		zip    *compressor
+	}{
+		{"zero_length", []byte{0x00}, &compressor{}},
+		{"varint_too_long", []byte{0xFF, 0xFF, 0xFF, 0x01}, &compressor{}},
+		{"empty_uncompressed", []byte{0x01, 0x00}, zip},
+		{"compressed_below_threshold", []byte{0x03, 0x10, 0x78, 0x9C}, zip},
+		{"compressed_too_long", []byte{0x05, 0x80, 0x80, 0x80, 0x08, 0x00}, zip},
+		{"not_zlib", []byte{0x03, 0x40, 0x01, 0x02}, zip},
+		{"inflates_shorter", mkMisdeclared(120), zip},
+		{"inflates_longer", mkMisdeclared(80), zip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newFrameReader(bytes.NewReader(tt.stream), &crypter{}, tt.zip).Next()
+			assert.ErrorIs(t, err, ErrInvalidFrame)
+		})
+	}
+}
+
+func TestFrameReaderLegacyPing(t *testing.T) {
+	isLegacy, err := newFrameReader(bytes.NewReader([]byte{0xFE, 0x01, 0xFA}), &crypter{}, &compressor{}).IsLegacyPing()
+	require.NoError(t, err)
+	assert.True(t, isLegacy)
+
+	isLegacy, err = newFrameReader(bytes.NewReader([]byte{0x10, 0x00}), &crypter{}, &compressor{}).IsLegacyPing()
+	require.NoError(t, err)
+	assert.False(t, isLegacy)
+}
+
+func TestFrameReaderTruncated(t *testing.T) {
+	zip := &compressor{}
+	zip.Enable(64)
+
+	// the longest frame declared, with only a few bytes of it sent
+	stream := []byte{0xFF, 0xFF, 0x7F, 0x00, 0x01, 0x02}
+
+	for name, zip := range map[string]*compressor{"uncompressed": {}, "compressed": zip} {
+		t.Run(name, func(t *testing.T) {
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+			_, err := newFrameReader(bytes.NewReader(stream), &crypter{}, zip).Next()
+			runtime.ReadMemStats(&after)
+
+			assert.Error(t, err)
+			assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(maxFrameLength/2),
+				"declared frame length must not be allocated upfront")
+		})
+	}
+}
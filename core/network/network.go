@@ -2,6 +2,7 @@ package network
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -11,14 +12,14 @@ import (
 	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/core/nats"
 	"github.com/alexykot/cncraft/core/nats/subj"
-	"github.com/alexykot/cncraft/pkg/buffer"
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
 )
 
 type Network struct {
-	host string
-	port int
+	host          string
+	port          int
+	proxyProtocol bool
 
 	log *zap.Logger
 
@@ -30,12 +31,13 @@ type Network struct {
 
 func NewNetwork(log *zap.Logger, ctrlChan chan control.Command, conf control.NetworkConf, bus nats.PubSub, disp Dispatcher) *Network {
 	return &Network{
-		host:       conf.Host,
-		port:       conf.Port,
-		dispatcher: disp,
-		control:    ctrlChan,
-		log:        log,
-		ps:         bus,
+		host:          conf.Host,
+		port:          conf.Port,
+		proxyProtocol: conf.ProxyProtocol,
+		dispatcher:    disp,
+		control:       ctrlChan,
+		log:           log,
+		ps:            bus,
 	}
 }
 
@@ -118,6 +120,14 @@ func (n *Network) startListening(ctx context.Context) error {
 }
 
 func (n *Network) handleNewConnection(ctx context.Context, conn Connection) {
+	if n.proxyProtocol {
+		if err := conn.ReadProxyHeader(); err != nil { // nothing is registered yet, no need to publish the closing
+			n.log.Info("dropping connection", zap.Error(err), zap.String("conn", conn.ID().String()))
+			_ = conn.Close()
+			return
+		}
+	}
+
 	n.log.Debug("new connection", zap.Any("address", conn.Address().String()))
 
 	if err := n.dispatcher.RegisterNewConn(conn); err != nil {
@@ -140,12 +150,20 @@ func (n *Network) handleNewConnection(ctx context.Context, conn Connection) {
 		}
 	}()
 
-	for {
-		bufIn := buffer.New()
-		size, err := conn.Receive(bufIn) // this blocking call will wait until some bytes will appear on the wire
+	if isLegacy, err := conn.IsLegacyPing(); err == nil && isLegacy {
+		// DEBT legacy server list ping is not answered, the pre-1.7 clients are not supported
+		n.log.Debug("dropping legacy server list ping", zap.String("conn", conn.ID().String()))
+		_ = conn.Close() // the following Receive fails and the closing is handled as usual
+	}
 
-		if err != nil && (err.Error() == "EOF" || size == 0) {
-			n.log.Debug("connection lost", zap.String("conn", conn.ID().String()))
+	for {
+		packet, err := conn.Receive() // this blocking call will wait until a whole packet will appear on the wire
+		if err != nil {
+			if errors.Is(err, ErrInvalidFrame) { // the stream cannot be followed past the broken frame
+				n.log.Info("dropping connection", zap.Error(err), zap.String("conn", conn.ID().String()))
+			} else {
+				n.log.Debug("connection lost", zap.Error(err), zap.String("conn", conn.ID().String()))
+			}
 			_ = conn.Close() // errors here don't really matter, 'cus connection is already dead anyway
 
 			lope := envelope.CloseConn(&pb.CloseConn{
@@ -160,29 +178,9 @@ func (n *Network) handleNewConnection(ctx context.Context, conn Connection) {
 			break
 		}
 
-		// decompression
-		// decryption
-
-		if bufIn.Bytes()[0] == 0xFE { // LEGACY PING
-			continue
-		}
-
-		n.log.Debug("reading blob of bytes", zap.Int("len", bufIn.Len()), zap.String("conn", conn.ID().String()))
-		var packetCount int
-		for {
-			packetLen := bufIn.PullVarInt()
-			if packetLen == 0 {
-				break // no more packets in this blob
-			}
-
-			packetBytes := bufIn.Bytes()[bufIn.IndexI() : bufIn.IndexI()+packetLen]
-			bufIn.SkipLen(packetLen)
-			packetCount++
-
-			n.log.Debug(fmt.Sprintf("read a packet %d in blob", packetCount),
-				zap.Int("packetLen", int(packetLen)), zap.String("bytes", fmt.Sprintf("%X", packetBytes)))
-			n.dispatcher.HandleSPacket(conn, packetBytes)
-		}
+		n.log.Debug("read a packet", zap.Int("packetLen", len(packet)),
+			zap.String("bytes", fmt.Sprintf("%X", packet)), zap.String("conn", conn.ID().String()))
+		n.dispatcher.HandleSPacket(conn, packet)
 	}
 }
 
@@ -0,0 +1,138 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// proxyV1Prefix - prefix of the human-readable PROXY protocol header,
+// see https://www.haproxy.org/download/2.4/doc/proxy-protocol.txt for details.
+const proxyV1Prefix = "PROXY "
+
+// proxyV1MaxLength - longest v1 header allowed, including the trailing CRLF.
+const proxyV1MaxLength = 107
+
+// proxyV2Signature - signature starting the binary PROXY protocol header.
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+const (
+	proxyV2HeaderLength = 16 // signature, version and command, family, address length
+	proxyV2Version      = 0x2
+	proxyV2CmdLocal     = 0x0 // connection established by the load balancer itself, e.g. a health check
+	proxyV2CmdProxy     = 0x1
+	proxyV2FamilyInet   = 0x1
+	proxyV2FamilyInet6  = 0x2
+)
+
+// readProxyHeader reads the PROXY protocol header, either v1 or v2, sent by the load balancer before any of
+// the client data. Provides the address of the client, or nil if the header carries none, e.g. for the
+// load balancer health checks.
+func readProxyHeader(src *bufio.Reader) (net.Addr, error) {
+	next, err := src.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch next[0] {
+	case proxyV1Prefix[0]:
+		return readProxyHeaderV1(src)
+	case proxyV2Signature[0]:
+		return readProxyHeaderV2(src)
+	default:
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("connection does not start with the header"))
+	}
+}
+
+// readProxyHeaderV1 reads the header like `PROXY TCP4 192.168.0.1 192.168.0.11 56324 443\r\n`.
+func readProxyHeaderV1(src *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, 0, proxyV1MaxLength)
+	for !bytes.HasSuffix(header, []byte("\r\n")) {
+		if len(header) == proxyV1MaxLength {
+			return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("v1 header is longer than %d bytes", proxyV1MaxLength))
+		}
+		b, err := src.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		header = append(header, b)
+	}
+
+	fields := strings.Split(strings.TrimSuffix(string(header), "\r\n"), " ")
+	if fields[0]+" " != proxyV1Prefix || len(fields) < 2 {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("malformed v1 header %q", header))
+	}
+
+	switch fields[1] {
+	case "UNKNOWN": // the rest of the line is to be ignored
+		return nil, nil
+	case "TCP4", "TCP6":
+	default:
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("unsupported v1 protocol %s", fields[1]))
+	}
+
+	if len(fields) != 6 {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("malformed v1 header %q", header))
+	}
+	ip := net.ParseIP(fields[2])
+	if ip == nil {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("failed to parse v1 source address %s", fields[2]))
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("failed to parse v1 source port: %w", err))
+	}
+
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+// readProxyHeaderV2 reads the binary header. Only the source address is used, the TLVs following the addresses
+// are skipped.
+func readProxyHeaderV2(src *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, proxyV2HeaderLength)
+	if _, err := io.ReadFull(src, header); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(proxyV2Signature)], proxyV2Signature) {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("v2 signature mismatch"))
+	}
+	if version := header[12] >> 4; version != proxyV2Version {
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("unsupported v2 version %d", version))
+	}
+
+	addresses := make([]byte, binary.BigEndian.Uint16(header[14:16]))
+	if _, err := io.ReadFull(src, addresses); err != nil {
+		return nil, err
+	}
+
+	switch cmd := header[12] & 0x0F; cmd {
+	case proxyV2CmdLocal:
+		return nil, nil
+	case proxyV2CmdProxy:
+	default:
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("unsupported v2 command %d", cmd))
+	}
+
+	var ipLength int
+	switch family := header[13] >> 4; family {
+	case proxyV2FamilyInet:
+		ipLength = net.IPv4len
+	case proxyV2FamilyInet6:
+		ipLength = net.IPv6len
+	default: // unix sockets or unspecified, no use for the address
+		return nil, nil
+	}
+
+	if len(addresses) < 2*ipLength+4 { // source and destination addresses, then source and destination ports
+		return nil, newNetworkError(ErrInvalidProxyHeader, fmt.Errorf("v2 addresses are too short"))
+	}
+	return &net.TCPAddr{
+		IP:   net.IP(addresses[:ipLength]),
+		Port: int(binary.BigEndian.Uint16(addresses[2*ipLength:])),
+	}, nil
+}
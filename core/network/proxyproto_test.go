@@ -0,0 +1,93 @@
+package network
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mkProxyHeaderV2(cmd, family byte, addresses []byte) []byte {
+	header := append([]byte(nil), proxyV2Signature...)
+	header = append(header, proxyV2Version<<4|cmd, family, byte(len(addresses)>>8), byte(len(addresses)))
+	return append(header, addresses...)
+}
+
+func TestReadProxyHeader(t *testing.T) {
+	inet := []byte{192, 168, 0, 1, 10, 0, 0, 1, 0xDC, 0x04, 0x63, 0xDD}
+	inet6 := append(append(net.ParseIP("2001:db8::1").To16(), net.ParseIP("2001:db8::2").To16()...), 0xDC, 0x04, 0x63, 0xDD)
+
+	tests := []struct {
+		name   string
+		header []byte
+		addr   net.Addr
+	}{
+		{"v1_tcp4", []byte("PROXY TCP4 192.168.0.1 10.0.0.1 56324 25565\r\n"),
+			&net.TCPAddr{IP: net.ParseIP("192.168.0.1"), Port: 56324}},
+		{"v1_tcp6", []byte("PROXY TCP6 2001:db8::1 2001:db8::2 56324 25565\r\n"),
+			&net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 56324}},
+		{"v1_unknown", []byte("PROXY UNKNOWN\r\n"), nil},
+		{"v2_inet", mkProxyHeaderV2(proxyV2CmdProxy, 0x11, inet),
+			&net.TCPAddr{IP: net.IPv4(192, 168, 0, 1).To4(), Port: 56324}},
+		{"v2_inet6", mkProxyHeaderV2(proxyV2CmdProxy, 0x21, inet6),
+			&net.TCPAddr{IP: net.ParseIP("2001:db8::1"), Port: 56324}},
+		{"v2_inet_tlv", mkProxyHeaderV2(proxyV2CmdProxy, 0x11, append(inet, 0x04, 0x00, 0x01, 0xFF)),
+			&net.TCPAddr{IP: net.IPv4(192, 168, 0, 1).To4(), Port: 56324}},
+		{"v2_local", mkProxyHeaderV2(proxyV2CmdLocal, 0x00, nil), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handshake := []byte{0x10, 0x00}
+			src := bufio.NewReader(iotest.OneByteReader(bytes.NewReader(append(tt.header, handshake...))))
+
+			addr, err := readProxyHeader(src)
+			require.NoError(t, err)
+			assert.Equal(t, tt.addr, addr)
+
+			rest, err := src.Peek(len(handshake))
+			require.NoError(t, err)
+			assert.Equal(t, handshake, rest, "the data following the header is expected to be left intact")
+		})
+	}
+}
+
+func TestReadProxyHeaderInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		header []byte
+	}{
+		{"no_header", []byte{0x10, 0x00, 0xF2, 0x05}},
+		{"v1_too_long", append([]byte("PROXY TCP4 "), bytes.Repeat([]byte("1"), proxyV1MaxLength)...)},
+		{"v1_bad_protocol", []byte("PROXY UDP4 192.168.0.1 10.0.0.1 56324 25565\r\n")},
+		{"v1_bad_address", []byte("PROXY TCP4 192.168.0 10.0.0.1 56324 25565\r\n")},
+		{"v1_bad_port", []byte("PROXY TCP4 192.168.0.1 10.0.0.1 65536 25565\r\n")},
+		{"v1_missing_fields", []byte("PROXY TCP4 192.168.0.1\r\n")},
+		{"v2_bad_version", append(mkProxyHeaderV2(proxyV2CmdProxy, 0x11, nil)[:12], 0x11, 0x11, 0x00, 0x00)},
+		{"v2_bad_command", mkProxyHeaderV2(0x2, 0x11, nil)},
+		{"v2_short_addresses", mkProxyHeaderV2(proxyV2CmdProxy, 0x11, []byte{192, 168, 0, 1})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readProxyHeader(bufio.NewReader(bytes.NewReader(tt.header)))
+			assert.ErrorIs(t, err, ErrInvalidProxyHeader)
+		})
+	}
+}
+
+func TestConnectionProxiedAddress(t *testing.T) {
+	serverEnd, clientEnd := net.Pipe()
+	defer serverEnd.Close()
+	defer clientEnd.Close()
+
+	go func() { _, _ = clientEnd.Write([]byte("PROXY TCP4 192.168.0.1 10.0.0.1 56324 25565\r\n")) }()
+
+	conn := newConnection(serverEnd)
+	require.NoError(t, conn.ReadProxyHeader())
+	assert.Equal(t, "192.168.0.1:56324", conn.Address().String())
+}
@@ -13,6 +13,8 @@ import (
 
 	players "github.com/alexykot/cncraft/core/players"
 	data "github.com/alexykot/cncraft/pkg/game/data"
+	level "github.com/alexykot/cncraft/pkg/game/level"
+	player "github.com/alexykot/cncraft/pkg/game/player"
 )
 
 // MockRoster is a mock of Roster interface
@@ -51,18 +53,33 @@ func (mr *MockRosterMockRecorder) Start(ctx interface{}) *gomock.Call {
 }
 
 // AddPlayer mocks base method
-func (m *MockRoster) AddPlayer(username string, connID, dimensionID uuid.UUID) (*players.Player, error) {
+func (m *MockRoster) AddPlayer(profile player.Profile, connID, startDimensionID uuid.UUID, dimensions map[uuid.UUID]level.Dimension) (*players.Player, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPlayer", username, connID, dimensionID)
+	ret := m.ctrl.Call(m, "AddPlayer", profile, connID, startDimensionID, dimensions)
 	ret0, _ := ret[0].(*players.Player)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddPlayer indicates an expected call of AddPlayer
-func (mr *MockRosterMockRecorder) AddPlayer(username, connID, dimensionID interface{}) *gomock.Call {
+func (mr *MockRosterMockRecorder) AddPlayer(profile, connID, startDimensionID, dimensions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlayer", reflect.TypeOf((*MockRoster)(nil).AddPlayer), username, connID, dimensionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlayer", reflect.TypeOf((*MockRoster)(nil).AddPlayer), profile, connID, startDimensionID, dimensions)
+}
+
+// GetPlayerByID mocks base method
+func (m *MockRoster) GetPlayerByID(playerID uuid.UUID) (*players.Player, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerByID", playerID)
+	ret0, _ := ret[0].(*players.Player)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPlayerByID indicates an expected call of GetPlayerByID
+func (mr *MockRosterMockRecorder) GetPlayerByID(playerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerByID", reflect.TypeOf((*MockRoster)(nil).GetPlayerByID), playerID)
 }
 
 // GetPlayerByConnID mocks base method
@@ -95,6 +112,35 @@ func (mr *MockRosterMockRecorder) GetPlayerIDByConnID(connID interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerIDByConnID", reflect.TypeOf((*MockRoster)(nil).GetPlayerIDByConnID), connID)
 }
 
+// GetPlayerByUsername mocks base method
+func (m *MockRoster) GetPlayerByUsername(username string) (*players.Player, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayerByUsername", username)
+	ret0, _ := ret[0].(*players.Player)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetPlayerByUsername indicates an expected call of GetPlayerByUsername
+func (mr *MockRosterMockRecorder) GetPlayerByUsername(username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayerByUsername", reflect.TypeOf((*MockRoster)(nil).GetPlayerByUsername), username)
+}
+
+// GetPlayers mocks base method
+func (m *MockRoster) GetPlayers() []*players.Player {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayers")
+	ret0, _ := ret[0].([]*players.Player)
+	return ret0
+}
+
+// GetPlayers indicates an expected call of GetPlayers
+func (mr *MockRosterMockRecorder) GetPlayers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayers", reflect.TypeOf((*MockRoster)(nil).GetPlayers))
+}
+
 // SetPlayerSpatial mocks base method
 func (m *MockRoster) SetPlayerSpatial(connID uuid.UUID, position *data.PositionF, rotation *data.RotationF, onGround *bool) {
 	m.ctrl.T.Helper()
@@ -107,6 +153,18 @@ func (mr *MockRosterMockRecorder) SetPlayerSpatial(connID, position, rotation, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPlayerSpatial", reflect.TypeOf((*MockRoster)(nil).SetPlayerSpatial), connID, position, rotation, onGround)
 }
 
+// SetPlayerDimension mocks base method
+func (m *MockRoster) SetPlayerDimension(connID, dimensionID uuid.UUID, position data.PositionF) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPlayerDimension", connID, dimensionID, position)
+}
+
+// SetPlayerDimension indicates an expected call of SetPlayerDimension
+func (mr *MockRosterMockRecorder) SetPlayerDimension(connID, dimensionID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPlayerDimension", reflect.TypeOf((*MockRoster)(nil).SetPlayerDimension), connID, dimensionID, position)
+}
+
 // SetPlayerHeldItem mocks base method
 func (m *MockRoster) SetPlayerHeldItem(connID uuid.UUID, heldItem uint8) {
 	m.ctrl.T.Helper()
@@ -130,3 +188,32 @@ func (mr *MockRosterMockRecorder) PlayerInventoryChanged(connID interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayerInventoryChanged", reflect.TypeOf((*MockRoster)(nil).PlayerInventoryChanged), connID)
 }
+
+// SetPlayerOpLevel mocks base method
+func (m *MockRoster) SetPlayerOpLevel(username string, opLevel player.OpLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPlayerOpLevel", username, opLevel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPlayerOpLevel indicates an expected call of SetPlayerOpLevel
+func (mr *MockRosterMockRecorder) SetPlayerOpLevel(username, opLevel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPlayerOpLevel", reflect.TypeOf((*MockRoster)(nil).SetPlayerOpLevel), username, opLevel)
+}
+
+// GetOperators mocks base method
+func (m *MockRoster) GetOperators() (map[string]player.OpLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperators")
+	ret0, _ := ret[0].(map[string]player.OpLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperators indicates an expected call of GetOperators
+func (mr *MockRosterMockRecorder) GetOperators() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperators", reflect.TypeOf((*MockRoster)(nil).GetOperators))
+}
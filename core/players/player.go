@@ -4,26 +4,51 @@ package players
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/alexykot/cncraft/pkg/game"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/entities"
+	"github.com/alexykot/cncraft/pkg/game/items"
 	"github.com/alexykot/cncraft/pkg/game/player"
 )
 
 type Player struct {
-	ID        uuid.UUID
-	ConnID    uuid.UUID
-	PC        entities.PlayerCharacter
-	Username  string
-	Settings  *player.Settings
-	Abilities *player.Abilities
-	State     *player.State
+	ID          uuid.UUID // ID the player is persisted with, same as the profile ID unless first joined cracked
+	ConnID      uuid.UUID
+	PC          entities.PlayerCharacter
+	Username    string
+	Profile     player.Profile // profile the player has logged in with
+	Settings    *player.Settings
+	Abilities   *player.Abilities
+	State       *player.State
+	Permissions *player.Permissions
+
+	window       *items.ContainerWindow // container window the player has open, if any
+	lastWindowID items.WindowID
+
+	chatSpam   time.Duration // chat spam allowance used up, wears off as time passes
+	lastChatAt time.Time
+
+	teleportID        int32     // ID of the last teleport sent to the client
+	isTeleportPending bool      // the client has not confirmed the last teleport yet
+	portalUsedAt      time.Time // last time the player was sent through a portal
 
 	mu sync.Mutex
 }
 
+// Chat spam limits, same as in the Notchian server: every message uses up a second of the allowance, the player
+// writing faster than a message per second on average for longer than 10 seconds is spamming.
+const (
+	chatSpamCost  = time.Second
+	chatSpamLimit = 10 * time.Second
+)
+
+// maxWindowID - container window IDs are cycled through, same as the Notchian server does, 0 is the inventory.
+const maxWindowID = 100
+
 func (p *Player) GetState() *player.State {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -52,6 +77,20 @@ func (p *Player) SetSettings(settings *player.Settings) {
 	p.Settings = settings
 }
 
+func (p *Player) GetPermissions() *player.Permissions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.Permissions
+}
+
+func (p *Player) SetPermissions(permissions *player.Permissions) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.Permissions = permissions
+}
+
 // GetLocation - get current location of the player
 func (p *Player) GetLocation() data.Location {
 	p.mu.Lock()
@@ -80,3 +119,130 @@ func (p *Player) SetOnGround(onGround bool) {
 
 	p.State.Location.OnGround = onGround
 }
+
+func (p *Player) SetDimension(dimensionID uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.State.Dimension = dimensionID
+}
+
+// NextWindowID provides the ID for the next container window opened by the player.
+func (p *Player) NextWindowID() items.WindowID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastWindowID = p.lastWindowID%maxWindowID + 1
+	return p.lastWindowID
+}
+
+// SetWindow sets the container window the player has open, nil if none. Provides the previously open window,
+// if there was one, for the caller to close it.
+func (p *Player) SetWindow(window *items.ContainerWindow) *items.ContainerWindow {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	previous := p.window
+	p.window = window
+	return previous
+}
+
+// GetWindow provides the container window the player has open, if its ID matches.
+func (p *Player) GetWindow(windowID items.WindowID) (*items.ContainerWindow, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.window == nil || p.window.WindowID != windowID {
+		return nil, false
+	}
+	return p.window, true
+}
+
+// TakeWindow unsets the container window the player has open and provides it for the caller to close it,
+// if its ID matches.
+func (p *Player) TakeWindow(windowID items.WindowID) (*items.ContainerWindow, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.window == nil || p.window.WindowID != windowID {
+		return nil, false
+	}
+	window := p.window
+	p.window = nil
+	return window, true
+}
+
+// CountChatMessage counts the chat message written by the player at the given time against the chat spam allowance.
+// Tells if the player is spamming.
+func (p *Player) CountChatMessage(at time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.chatSpam -= at.Sub(p.lastChatAt)
+	if p.chatSpam < 0 {
+		p.chatSpam = 0
+	}
+	p.lastChatAt = at
+	p.chatSpam += chatSpamCost
+
+	return p.chatSpam > chatSpamLimit
+}
+
+// StartTeleport provides the ID of the teleport about to be sent to the client. Positions the client sends are stale
+// until it confirms the teleport.
+func (p *Player) StartTeleport() int32 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.teleportID++
+	p.isTeleportPending = true
+	return p.teleportID
+}
+
+// ConfirmTeleport confirms the last teleport sent to the client. Tells if the teleport ID matches.
+func (p *Player) ConfirmTeleport(teleportID int32) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.isTeleportPending || teleportID != p.teleportID {
+		return false
+	}
+	p.isTeleportPending = false
+	return true
+}
+
+// UsePortal tells if the player can be sent through a portal at the given time, i.e. the cooldown since the portal
+// was last used has passed. Starts the cooldown anew if so.
+func (p *Player) UsePortal(at time.Time, cooldown time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if at.Sub(p.portalUsedAt) < cooldown {
+		return false
+	}
+	p.portalUsedAt = at
+	return true
+}
+
+func (p *Player) IsTeleportPending() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.isTeleportPending
+}
+
+func (p *Player) GetAbilities() player.Abilities {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return *p.Abilities
+}
+
+// SetGamemode switches the player to the gamemode, along with the abilities the gamemode gives.
+func (p *Player) SetGamemode(mode game.Gamemode) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.PC.SetGameMode(mode)
+	p.Abilities = player.NewAbilities(mode)
+}
@@ -0,0 +1,45 @@
+package players
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayer_CountChatMessage(t *testing.T) {
+	start := time.Date(2021, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval time.Duration // between the messages
+		messages int
+		expected bool // the last message is spamming
+	}{
+		{name: "single_message", interval: 0, messages: 1, expected: false},
+		{name: "allowance_used_up", interval: 0, messages: 10, expected: false},
+		{name: "allowance_exceeded", interval: 0, messages: 11, expected: true},
+		{name: "message_per_second", interval: time.Second, messages: 100, expected: false},
+		{name: "faster_than_message_per_second", interval: 900 * time.Millisecond, messages: 100, expected: true},
+		{name: "slower_than_message_per_second", interval: 2 * time.Second, messages: 100, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &Player{}
+			var isSpamming bool
+			for i := 0; i < test.messages; i++ {
+				isSpamming = p.CountChatMessage(start.Add(time.Duration(i) * test.interval))
+			}
+			assert.Equal(t, test.expected, isSpamming)
+		})
+	}
+
+	t.Run("allowance_wears_off", func(t *testing.T) {
+		p := &Player{}
+		for i := 0; i < 10; i++ {
+			assert.False(t, p.CountChatMessage(start))
+		}
+		assert.False(t, p.CountChatMessage(start.Add(time.Minute)), "spam allowance wears off as time passes")
+	})
+}
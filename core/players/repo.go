@@ -5,22 +5,30 @@ package players
 import "C"
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.uber.org/zap"
 
+	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/core/db"
 	"github.com/alexykot/cncraft/core/db/orm"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/entities"
 	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/level"
 	"github.com/alexykot/cncraft/pkg/game/player"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
+// ErrUnknownPlayer - the player has never joined the server, so there is nothing known about it.
+var ErrUnknownPlayer = errors.New("unknown player")
+
 // repo is a player repository, it implements handling the persistent storage of player data.
 type repo struct {
 	windowLog *zap.Logger
@@ -31,26 +39,45 @@ func newRepo(log *zap.Logger, db *sql.DB) *repo {
 	return &repo{log, db}
 }
 
-func (r *repo) InitPlayer(username string, connID, dimensionID uuid.UUID) (p *Player, isNew bool, err error) {
+// InitPlayer loads the player from persistence, or creates the player joining for the first time. Players rejoin
+// the dimension they left, unless it is not among the known dimensions of the world any more, new players and
+// these ones join the start dimension at the spawn point.
+func (r *repo) InitPlayer(profile player.Profile, connID, startDimensionID uuid.UUID,
+	dimensions map[uuid.UUID]level.Dimension) (p *Player, isNew bool, err error) {
 	tx, err := r.db.BeginTx(db.Ctx(), nil)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
-	dbPlayer, err := orm.Players(orm.PlayerWhere.Username.EQ(username)).One(db.Ctx(), tx)
+	// Profile IDs of the authenticated or forwarded players are stable, so these are looked up by the ID and keep
+	// their data when renaming the account. Profile IDs of the cracked players are made up for every connection,
+	// so these can only be looked up by the username.
+	var dbPlayer *orm.Player
+	if conf := control.GetCurrentConfig(); conf.IsCracked && conf.Forwarding.Mode == control.ForwardingNone {
+		dbPlayer, err = orm.Players(orm.PlayerWhere.Username.EQ(profile.Name)).One(db.Ctx(), tx)
+	} else {
+		if err = r.releaseUsername(tx, profile); err != nil {
+			return nil, false, fmt.Errorf("failed to release username: %w", err)
+		}
+		dbPlayer, err = orm.Players(orm.PlayerWhere.ID.EQ(profile.ID)).One(db.Ctx(), tx)
+	}
 	if err != nil && err != sql.ErrNoRows {
 		return nil, false, fmt.Errorf("failed to load player data: %w", err)
 	}
 
 	if err == sql.ErrNoRows {
-		p = r.createNewPlayer(username, connID, dimensionID)
+		p = r.createNewPlayer(profile, connID, startDimensionID)
 		isNew = true
 	} else {
-		if p, err = r.loadPlayer(tx, dbPlayer, username, connID, dimensionID); err != nil {
+		if p, err = r.loadPlayer(tx, dbPlayer, profile, connID, startDimensionID, dimensions); err != nil {
 			return nil, false, fmt.Errorf("failed to load player: %w", err)
 		}
 	}
 
+	if p.Permissions, err = r.loadPermissions(tx, p.ID); err != nil {
+		return nil, false, fmt.Errorf("failed to load player permissions: %w", err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return nil, false, fmt.Errorf("failed to commit tx: %w", err)
 	}
@@ -58,14 +85,36 @@ func (r *repo) InitPlayer(username string, connID, dimensionID uuid.UUID) (p *Pl
 	return p, isNew, nil
 }
 
-func (r *repo) createNewPlayer(username string, connID, dimensionID uuid.UUID) *Player {
+// releaseUsername takes the username of the given profile away from any other player persisted with it. The username
+// belongs to the authenticated profile now, the other player has renamed the account since it last joined, or has
+// joined cracked. The other player is left with its ID for the username until it rejoins.
+func (r *repo) releaseUsername(tx *sql.Tx, profile player.Profile) error {
+	dbPlayer, err := orm.Players(
+		orm.PlayerWhere.Username.EQ(profile.Name),
+		orm.PlayerWhere.ID.NEQ(profile.ID),
+	).One(db.Ctx(), tx)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to query player by username: %w", err)
+	}
+
+	dbPlayer.Username = dbPlayer.ID.String()
+	if _, err := dbPlayer.Update(db.Ctx(), tx, boil.Whitelist(orm.PlayerColumns.Username)); err != nil {
+		return fmt.Errorf("failed to update player: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) createNewPlayer(profile player.Profile, connID, dimensionID uuid.UUID) *Player {
 	inventory := items.NewInventory(r.windowLog)
 
 	return &Player{
-		ID:       uuid.New(),
+		ID:       profile.ID,
 		ConnID:   connID,
-		PC:       entities.NewPC(username, player.MaxHealth),
-		Username: username,
+		PC:       entities.NewPC(profile.Name, player.MaxHealth),
+		Username: profile.Name,
+		Profile:  profile,
 		Settings: &player.Settings{
 			ViewDistance: 7,
 			FlyingSpeed:  0.05,
@@ -75,20 +124,29 @@ func (r *repo) createNewPlayer(username string, connID, dimensionID uuid.UUID) *
 		State: &player.State{
 			Dimension: dimensionID,
 			Inventory: inventory,
-			Location: data.Location{ // DEBT this needs to be replaced with proper spawn point and starting conditions.
-				PositionF: data.PositionF{
-					X: 0,
-					Y: 10,
-					Z: 0,
-				},
-			},
+			Location:  spawnLocation(),
 		},
 	}
 }
 
-func (r *repo) loadPlayer(tx *sql.Tx, dbPlayer *orm.Player, username string, connID, dimensionID uuid.UUID) (*Player, error) {
+// spawnLocation provides the location new players join at.
+func spawnLocation() data.Location {
+	return data.Location{ // DEBT this needs to be replaced with proper spawn point and starting conditions.
+		PositionF: data.PositionF{
+			X: 0,
+			Y: 10,
+			Z: 0,
+		},
+	}
+}
+
+func (r *repo) loadPlayer(tx *sql.Tx, dbPlayer *orm.Player, profile player.Profile, connID, startDimensionID uuid.UUID,
+	dimensions map[uuid.UUID]level.Dimension) (*Player, error) {
 	dbPlayer.ConnID = null.StringFrom(connID.String())
-	_, err := dbPlayer.Update(db.Ctx(), tx, boil.Whitelist(orm.PlayerColumns.ConnID))
+	dbPlayer.Username = profile.Name // the player may have renamed the account since the last join
+	if _, err := dbPlayer.Update(db.Ctx(), tx, boil.Whitelist(orm.PlayerColumns.ConnID, orm.PlayerColumns.Username)); err != nil {
+		return nil, fmt.Errorf("failed to update player: %w", err)
+	}
 
 	dbInventories, err := orm.Inventories(orm.InventoryWhere.PlayerID.EQ(dbPlayer.ID)).All(db.Ctx(), tx)
 	if err != nil {
@@ -98,6 +156,13 @@ func (r *repo) loadPlayer(tx *sql.Tx, dbPlayer *orm.Player, username string, con
 	inventory := items.NewInventory(r.windowLog)
 	inventory.CurrentHotbarSlot = uint8(dbPlayer.CurrentHotbar)
 
+	// the position is only meaningful in the dimension it was saved in
+	dimensionID, location := startDimensionID, spawnLocation()
+	if _, ok := dimensions[dbPlayer.DimensionID]; ok {
+		dimensionID = dbPlayer.DimensionID
+		location.PositionF = data.PositionF{X: dbPlayer.PositionX, Y: dbPlayer.PositionY, Z: dbPlayer.PositionZ}
+	}
+
 	for _, dbItem := range dbInventories {
 		inventory.SetSlot(dbItem.SlotNumber, items.Slot{ItemID: objects.ItemID(dbItem.ItemID), ItemCount: dbItem.ItemCount})
 	}
@@ -105,8 +170,9 @@ func (r *repo) loadPlayer(tx *sql.Tx, dbPlayer *orm.Player, username string, con
 	return &Player{
 		ID:       dbPlayer.ID,
 		ConnID:   connID,
-		PC:       entities.NewPC(username, player.MaxHealth),
-		Username: username,
+		PC:       entities.NewPC(profile.Name, player.MaxHealth),
+		Username: profile.Name,
+		Profile:  profile,
 		Settings: &player.Settings{
 			ViewDistance: 7,
 			FlyingSpeed:  0.05,
@@ -114,19 +180,103 @@ func (r *repo) loadPlayer(tx *sql.Tx, dbPlayer *orm.Player, username string, con
 		},
 		Abilities: &player.Abilities{},
 		State: &player.State{
-			// not using the previously saved dimension for the player here because player may
-			// join a dimension different from what they left previously.
 			Dimension: dimensionID,
 			Inventory: inventory,
-			Location: data.Location{
-				// DEBT this does not account for the fact that the player may join a different dimension from
-				//  what they left previously, and last recorded location in that dimension will be irrelevant.
-				PositionF: data.PositionF{
-					X: dbPlayer.PositionX,
-					Y: dbPlayer.PositionY,
-					Z: dbPlayer.PositionZ,
-				},
-			},
+			Location:  location,
 		},
 	}, nil
 }
+
+// loadPermissions loads the permissions the player has. The player holds the roles granted to it, along with
+// the roles of its op level and below.
+func (r *repo) loadPermissions(exec boil.ContextExecutor, playerID uuid.UUID) (*player.Permissions, error) {
+	opLevel := player.OpLevelNone
+	dbOperator, err := orm.FindOperator(db.Ctx(), exec, playerID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to query operator: %w", err)
+	}
+	if dbOperator != nil {
+		opLevel = player.OpLevel(dbOperator.OpLevel)
+	}
+
+	dbRoles, err := orm.Roles(
+		qm.Where(orm.RoleColumns.OpLevel+" <= ?", opLevel),
+		qm.Or(orm.RoleColumns.Name+" IN (SELECT role_name FROM cncraft.player_roles WHERE player_id = ?)", playerID),
+	).All(db.Ctx(), exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query player roles: %w", err)
+	}
+
+	var roles []string
+	var roleNames []interface{}
+	for _, dbRole := range dbRoles {
+		roles = append(roles, dbRole.Name)
+		roleNames = append(roleNames, dbRole.Name)
+	}
+	if len(roles) == 0 {
+		return player.NewPermissions(opLevel, nil), nil
+	}
+
+	dbPermissions, err := orm.RolePermissions(qm.WhereIn(orm.RolePermissionColumns.RoleName+" IN ?", roleNames...)).
+		All(db.Ctx(), exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query role permissions: %w", err)
+	}
+
+	nodes := make([]string, len(dbPermissions))
+	for i, dbPermission := range dbPermissions {
+		nodes[i] = dbPermission.Permission
+	}
+	return player.NewPermissions(opLevel, roles, nodes...), nil
+}
+
+// SetOpLevel adds the player to the ops list with the given op level, or removes from the list for OpLevelNone.
+// Provides the ID of the player, the player must have joined the server before.
+func (r *repo) SetOpLevel(username string, opLevel player.OpLevel) (uuid.UUID, error) {
+	dbPlayer, err := orm.Players(orm.PlayerWhere.Username.EQ(username)).One(db.Ctx(), r.db)
+	if err == sql.ErrNoRows {
+		return uuid.UUID{}, fmt.Errorf("player %s has never joined: %w", username, ErrUnknownPlayer)
+	} else if err != nil {
+		return uuid.UUID{}, fmt.Errorf("failed to query player: %w", err)
+	}
+
+	if opLevel == player.OpLevelNone {
+		if _, err := orm.Operators(orm.OperatorWhere.PlayerID.EQ(dbPlayer.ID)).DeleteAll(db.Ctx(), r.db); err != nil {
+			return uuid.UUID{}, fmt.Errorf("failed to delete operator: %w", err)
+		}
+		return dbPlayer.ID, nil
+	}
+
+	dbOperator := &orm.Operator{PlayerID: dbPlayer.ID, OpLevel: int16(opLevel), CreatedAt: time.Now()}
+	if err := dbOperator.Upsert(db.Ctx(), r.db, true, []string{orm.OperatorColumns.PlayerID},
+		boil.Whitelist(orm.OperatorColumns.OpLevel), boil.Infer()); err != nil {
+		return uuid.UUID{}, fmt.Errorf("failed to upsert operator: %w", err)
+	}
+	return dbPlayer.ID, nil
+}
+
+// SetOpLevel sets the op level of the player right in persistence. Meant for tooling, the server itself sets op levels
+// through the roster so that the online players are updated as well.
+func SetOpLevel(conn *sql.DB, username string, opLevel player.OpLevel) error {
+	_, err := newRepo(zap.NewNop(), conn).SetOpLevel(username, opLevel)
+	return err
+}
+
+// GetOperators provides the op levels of all the players in the ops list, by the player names.
+func (r *repo) GetOperators() (map[string]player.OpLevel, error) {
+	dbOperators, err := orm.Operators(qm.Load(orm.OperatorRels.Player)).All(db.Ctx(), r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operators: %w", err)
+	}
+
+	operators := make(map[string]player.OpLevel, len(dbOperators))
+	for _, dbOperator := range dbOperators {
+		operators[dbOperator.R.Player.Username] = player.OpLevel(dbOperator.OpLevel)
+	}
+	return operators, nil
+}
+
+// LoadPermissions loads the permissions the player has.
+func (r *repo) LoadPermissions(playerID uuid.UUID) (*player.Permissions, error) {
+	return r.loadPermissions(r.db, playerID)
+}
@@ -19,17 +19,25 @@ import (
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
 	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/game/player"
 )
 
 // Roster handles the map of all players logged into this server.
 type Roster interface {
 	Start(ctx context.Context)
-	AddPlayer(username string, connID, dimensionID uuid.UUID) (*Player, error)
+	AddPlayer(profile player.Profile, connID, startDimensionID uuid.UUID, dimensions map[uuid.UUID]level.Dimension) (*Player, error)
+	GetPlayerByID(playerID uuid.UUID) (*Player, bool)
 	GetPlayerByConnID(connID uuid.UUID) (*Player, bool)
 	GetPlayerIDByConnID(connID uuid.UUID) (uuid.UUID, bool)
+	GetPlayerByUsername(username string) (*Player, bool)
+	GetPlayers() []*Player
 	SetPlayerSpatial(connID uuid.UUID, position *data.PositionF, rotation *data.RotationF, onGround *bool)
+	SetPlayerDimension(connID, dimensionID uuid.UUID, position data.PositionF)
 	SetPlayerHeldItem(connID uuid.UUID, heldItem uint8)
 	PlayerInventoryChanged(connID uuid.UUID)
+	SetPlayerOpLevel(username string, opLevel player.OpLevel) error
+	GetOperators() (map[string]player.OpLevel, error)
 }
 
 type roster struct {
@@ -64,7 +72,10 @@ func NewRoster(log, windowLog *zap.Logger, ctrlChan chan control.Command, ps nat
 	}
 }
 
-func (r *roster) AddPlayer(username string, connID, dimensionID uuid.UUID) (*Player, error) {
+// AddPlayer loads the player from persistence or creates the new one, and adds it to the roster. Players rejoin
+// the dimension they left if it is among the given dimensions of the world, the start dimension otherwise.
+func (r *roster) AddPlayer(profile player.Profile, connID, startDimensionID uuid.UUID,
+	dimensions map[uuid.UUID]level.Dimension) (*Player, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -73,20 +84,20 @@ func (r *roster) AddPlayer(username string, connID, dimensionID uuid.UUID) (*Pla
 	//  Afterthought: ID is actually useless and the username is in fact a globally unique ID of the player.
 	var found bool
 	for _, existing := range r.players {
-		if existing.Username == username {
+		if existing.Username == profile.Name {
 			found = true
 			break
 		}
 	}
 
 	if found {
-		return nil, fmt.Errorf("player %s already exists", username)
+		return nil, fmt.Errorf("player %s already exists", profile.Name)
 	}
 
 	var err error
 	var isNew bool
 	var p *Player
-	if p, isNew, err = r.repo.InitPlayer(username, connID, dimensionID); err != nil {
+	if p, isNew, err = r.repo.InitPlayer(profile, connID, startDimensionID, dimensions); err != nil {
 		return nil, fmt.Errorf("failed to init player: %w", err)
 	}
 
@@ -95,12 +106,21 @@ func (r *roster) AddPlayer(username string, connID, dimensionID uuid.UUID) (*Pla
 	} else {
 		r.log.Debug("rejoining player loaded", zap.String("name", p.Username))
 	}
+	// Added before announcing, so that the player can be found by anyone handling the announcement.
+	r.players[p.ID] = p
 	r.publishPlayerJoined(p)
 
-	r.players[p.ID] = p
 	return p, nil
 }
 
+func (r *roster) GetPlayerByID(playerID uuid.UUID) (*Player, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.players[playerID]
+	return p, ok
+}
+
 func (r *roster) GetPlayerByConnID(connID uuid.UUID) (*Player, bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -127,6 +147,31 @@ func (r *roster) GetPlayerIDByConnID(connID uuid.UUID) (uuid.UUID, bool) {
 	return uuid.UUID{}, false
 }
 
+func (r *roster) GetPlayerByUsername(username string) (*Player, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, p := range r.players {
+		if p.Username == username {
+			return p, true
+		}
+	}
+
+	return nil, false
+}
+
+// GetPlayers provides all players connected to this node.
+func (r *roster) GetPlayers() []*Player {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	players := make([]*Player, 0, len(r.players))
+	for _, p := range r.players {
+		players = append(players, p)
+	}
+	return players
+}
+
 // SetPlayerSpatial - pointer types are used here to separate possible default values from an absence of value to update.
 func (r *roster) SetPlayerSpatial(connID uuid.UUID, position *data.PositionF, rotation *data.RotationF, onGround *bool) {
 	p, ok := r.GetPlayerByConnID(connID)
@@ -134,7 +179,9 @@ func (r *roster) SetPlayerSpatial(connID uuid.UUID, position *data.PositionF, ro
 		return
 	}
 
-	if position != nil {
+	// positions sent before the client confirmed the teleport would move the player back, same as in the Notchian
+	// server these are ignored
+	if position != nil && !p.IsTeleportPending() {
 		p.SetPosition(*position)
 	}
 
@@ -149,12 +196,25 @@ func (r *roster) SetPlayerSpatial(connID uuid.UUID, position *data.PositionF, ro
 	r.publishPlayerSpatialUpdate(p)
 }
 
+// SetPlayerDimension - moves player to the given position in the given dimension, which may be the current one.
+func (r *roster) SetPlayerDimension(connID, dimensionID uuid.UUID, position data.PositionF) {
+	p, ok := r.GetPlayerByConnID(connID)
+	if !ok {
+		return
+	}
+
+	p.SetDimension(dimensionID)
+	p.SetPosition(position)
+
+	r.publishPlayerSpatialUpdate(p)
+}
+
 func (r *roster) SetPlayerHeldItem(connID uuid.UUID, heldItem uint8) {
 	p, ok := r.GetPlayerByConnID(connID)
 	if !ok {
 		return
 	}
-	p.State.Inventory.CurrentHotbarSlot = heldItem
+	p.State.Inventory.SetCurrentHotbarSlot(heldItem)
 	r.publishPlayerInventoryUpdate(p)
 }
 
@@ -166,6 +226,33 @@ func (r *roster) PlayerInventoryChanged(connID uuid.UUID) {
 	r.publishPlayerInventoryUpdate(p)
 }
 
+// SetPlayerOpLevel adds the player to the ops list with the given op level, or removes from the list for OpLevelNone.
+// Permissions of the player are reloaded, if the player is connected to this node.
+// DEBT permissions of the player connected to another node in the cluster are only reloaded when it rejoins.
+func (r *roster) SetPlayerOpLevel(username string, opLevel player.OpLevel) error {
+	playerID, err := r.repo.SetOpLevel(username, opLevel)
+	if err != nil {
+		return fmt.Errorf("failed to set op level: %w", err)
+	}
+
+	p, ok := r.GetPlayerByID(playerID)
+	if !ok {
+		return nil
+	}
+
+	permissions, err := r.repo.LoadPermissions(playerID)
+	if err != nil {
+		return fmt.Errorf("failed to reload permissions: %w", err)
+	}
+	p.SetPermissions(permissions)
+	return nil
+}
+
+// GetOperators provides the op levels of all the players in the ops list, by the player names.
+func (r *roster) GetOperators() (map[string]player.OpLevel, error) {
+	return r.repo.GetOperators()
+}
+
 func (r *roster) Start(_ context.Context) {
 	// DEBT When cluster mode will be developed - this will also need to start a context watching goroutine
 	//  and unsubscribe from the player channels.
@@ -233,7 +320,8 @@ func (r *roster) publishPlayerSpatialUpdate(p *Player) {
 			Yaw:   p.State.Location.Yaw,
 			Pitch: p.State.Location.Pitch,
 		},
-		OnGround: p.State.Location.OnGround,
+		OnGround:    p.State.Location.OnGround,
+		DimensionId: p.State.Dimension.String(),
 	})
 	if err := r.ps.Publish(subj.MkPlayerSpatialUpdate(), lope); err != nil {
 		r.log.Error("failed to publish position update", zap.Error(err))
@@ -241,7 +329,7 @@ func (r *roster) publishPlayerSpatialUpdate(p *Player) {
 }
 
 func (r *roster) publishPlayerInventoryUpdate(p *Player) {
-	update := &pb.PlayerInventoryUpdate{PlayerId: p.ID.String(), CurrentHotbar: int32(p.State.Inventory.CurrentHotbarSlot)}
+	update := &pb.PlayerInventoryUpdate{PlayerId: p.ID.String(), CurrentHotbar: int32(p.State.Inventory.GetCurrentHotbarSlot())}
 	for i, item := range p.State.Inventory.ToArray() {
 		if item.IsPresent {
 			update.Inventory = append(update.Inventory, &pb.InventoryItem{
@@ -13,6 +13,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/alexykot/cncraft/core/commands"
 	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/core/db"
 	"github.com/alexykot/cncraft/core/handlers"
@@ -20,6 +21,7 @@ import (
 	"github.com/alexykot/cncraft/core/network"
 	"github.com/alexykot/cncraft/core/players"
 	"github.com/alexykot/cncraft/core/world"
+	brigadier "github.com/alexykot/cncraft/pkg/commands"
 	"github.com/alexykot/cncraft/pkg/log"
 	"github.com/alexykot/cncraft/pkg/protocol/auth"
 )
@@ -40,9 +42,12 @@ type Server struct {
 
 	net *network.Network
 
-	roster  players.Roster
-	world   *world.World
-	sharder *world.Sharder
+	roster   players.Roster
+	world    *world.World
+	sharder  *world.Sharder
+	streamer *world.Streamer
+	clock    *world.Clock
+	commands *brigadier.Dispatcher
 }
 
 // NewServer wires up and provides new server instance.
@@ -56,6 +61,17 @@ func NewServer(config control.ServerConf) (*Server, error) {
 
 	srv.ctx, srv.cancelFunc = signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM, syscall.SIGKILL)
 
+	switch srv.config.Forwarding.Mode {
+	case control.ForwardingNone, control.ForwardingLegacy, control.ForwardingModern:
+	default:
+		srv.cancelFunc()
+		return nil, fmt.Errorf("unknown forwarding mode `%s`", srv.config.Forwarding.Mode)
+	}
+	if srv.config.Forwarding.Mode == control.ForwardingModern && srv.config.Forwarding.Secret == "" {
+		srv.cancelFunc()
+		return nil, fmt.Errorf("forwarding secret is required for the modern forwarding")
+	}
+
 	if srv.log, err = log.GetRoot(srv.config.Log.Baseline); err != nil {
 		srv.cancelFunc()
 		return nil, fmt.Errorf("could not instantiate root logger: %w", err)
@@ -85,14 +101,20 @@ func NewServer(config control.ServerConf) (*Server, error) {
 		return nil, fmt.Errorf("could not instantiate world: %w", err)
 	}
 
-	srv.sharder = world.NewSharder(log.NamedLevelUp(srv.log, "sharder", srv.config.Log.Sharder), srv.control, srv.config.World, srv.ps, srv.world, srv.roster)
+	srv.streamer = world.NewStreamer(log.NamedLevelUp(srv.log, "world", srv.config.Log.World), srv.control, srv.config.World, srv.ps, srv.world, srv.roster)
+	srv.sharder = world.NewSharder(log.NamedLevelUp(srv.log, "sharder", srv.config.Log.Sharder), srv.control, srv.config.World, srv.ps, srv.world, srv.streamer, srv.roster)
+
+	srv.clock = world.NewClock(log.NamedLevelUp(srv.log, "clock", srv.config.Log.World), srv.control, srv.ps, srv.roster)
+
+	srv.commands = commands.New(log.NamedLevelUp(srv.log, "commands", srv.config.Log.Players), srv.ps, srv.roster, srv.clock)
 
 	dispatcher := network.NewDispatcher(
 		log.NamedLevelUp(srv.log, "dispatcher", srv.config.Log.Dispatcher),
-		srv.ps, auth.GetAuther(),
+		srv.ps, auth.NewAuther(srv.config.ServerID, srv.config.SessionServerURL),
 		srv.roster,
 		network.NewKeepAliver(log.NamedLevelUp(srv.log, "aliver", srv.config.Log.Dispatcher), srv.control, srv.ps),
 		srv.sharder,
+		srv.commands,
 	)
 	srv.net = network.NewNetwork(log.NamedLevelUp(srv.log, "network", srv.config.Log.Network), srv.control, srv.config.Net, srv.ps, dispatcher)
 
@@ -188,12 +210,14 @@ func (s *Server) startServer() {
 
 	s.net.Start(s.ctx)
 
-	s.world.Load(s.ctx, s.control)
-
 	s.sharder.Start(s.ctx)
 
+	s.streamer.Start(s.ctx)
+
+	s.clock.Start(s.ctx)
+
 	handlers.RegisterEventHandlersState3(log.NamedLevelUp(s.log, "players", s.config.Log.Players),
-		s.control, s.ps, s.roster, s.world)
+		s.control, s.ps, s.roster, s.world, s.streamer, s.clock, s.commands)
 
 	s.roster.Start(s.ctx)
 
@@ -0,0 +1,226 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/control"
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+// Times of day, in ticks since the dawn, as used by the /time command.
+const (
+	TimeDay      = 1000
+	TimeNoon     = 6000
+	TimeNight    = 13000
+	TimeMidnight = 18000
+
+	TicksPerDay = 24000
+)
+
+// timeUpdateInterval - ticks between the time updates sent to the clients, same as in the Notchian server.
+// The clients advance the time on their own in between.
+const timeUpdateInterval = game.TicksPerSecond
+
+// Clock keeps the time of day and the weather of the world, and keeps the players connected to this node in sync
+// with them.
+// DEBT every node in the cluster keeps its own clock, and neither the time nor the weather are persisted, the world
+//  starts in the morning with clear weather every time the server starts. There is no natural weather cycle either,
+//  the weather only changes by the /weather command and clears up once the duration set runs out.
+type Clock struct {
+	mu sync.Mutex
+
+	log     *zap.Logger
+	control chan control.Command
+	ps      nats.PubSub
+	roster  players.Roster
+
+	age         int64 // ticks since the server started
+	timeOfDay   int64 // ticks since the dawn of the first day
+	weather     game.Weather
+	weatherLeft int32 // ticks until the weather clears up
+}
+
+func NewClock(log *zap.Logger, control chan control.Command, ps nats.PubSub, roster players.Roster) *Clock {
+	return &Clock{
+		log:       log,
+		control:   control,
+		ps:        ps,
+		roster:    roster,
+		timeOfDay: TimeDay,
+	}
+}
+
+func (c *Clock) Start(ctx context.Context) {
+	go c.run(ctx)
+	c.signal(control.READY, nil)
+}
+
+func (c *Clock) run(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			c.signal(control.FAILED, fmt.Errorf("clock panicked: %v", r))
+		}
+	}()
+
+	ticker := time.NewTicker(game.TickSpeed)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			c.signal(control.STOPPED, nil)
+			return
+		case <-ticker.C:
+			if outLopes := c.tick(); len(outLopes) > 0 {
+				c.broadcast(outLopes...)
+			}
+		}
+	}
+}
+
+// tick advances the clock by a single tick. Provides the packets to update the clients with, if it is time to.
+func (c *Clock) tick() []*envelope.E {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.age++
+	c.timeOfDay++
+
+	var outLopes []*envelope.E
+	if c.weather != game.WeatherClear {
+		c.weatherLeft--
+		if c.weatherLeft <= 0 {
+			c.weather = game.WeatherClear
+			outLopes = append(outLopes, weatherLopes(c.weather)...)
+		}
+	}
+	if c.age%timeUpdateInterval == 0 {
+		outLopes = append(outLopes, c.timeLope())
+	}
+	return outLopes
+}
+
+// Time provides the ticks since the server started and the time of day, in ticks since the dawn of the first day.
+func (c *Clock) Time() (int64, int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.age, c.timeOfDay
+}
+
+// SetTime sets the time of day, in ticks since the dawn of the first day.
+func (c *Clock) SetTime(timeOfDay int64) {
+	c.mu.Lock()
+	c.timeOfDay = timeOfDay
+	timeLope := c.timeLope()
+	c.mu.Unlock()
+
+	c.broadcast(timeLope)
+}
+
+// AddTime moves the time of day forward by the given ticks. Provides the new time of day.
+func (c *Clock) AddTime(ticks int64) int64 {
+	c.mu.Lock()
+	c.timeOfDay += ticks
+	timeOfDay, timeLope := c.timeOfDay, c.timeLope()
+	c.mu.Unlock()
+
+	c.broadcast(timeLope)
+	return timeOfDay
+}
+
+func (c *Clock) Weather() game.Weather {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.weather
+}
+
+// SetWeather sets the weather for the given duration in ticks, the weather clears up after.
+func (c *Clock) SetWeather(weather game.Weather, duration int32) {
+	c.mu.Lock()
+	c.weather = weather
+	c.weatherLeft = duration
+	c.mu.Unlock()
+
+	c.broadcast(weatherLopes(weather)...)
+}
+
+// Lopes provides the packets bringing the client of the joining player up to date with the time and the weather.
+func (c *Clock) Lopes() []*envelope.E {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	outLopes := []*envelope.E{c.timeLope()}
+	if c.weather != game.WeatherClear {
+		outLopes = append(outLopes, weatherLopes(c.weather)...)
+	}
+	return outLopes
+}
+
+func (c *Clock) timeLope() *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CTimeUpdate)
+	timeUpdate := cpacket.(*protocol.CPacketTimeUpdate)
+	timeUpdate.WorldAge = c.age
+	timeUpdate.TimeOfDay = c.timeOfDay
+	return envelope.MkCpacketEnvelope(timeUpdate)
+}
+
+// weatherLopes provides the packets switching the client to the given weather.
+func weatherLopes(weather game.Weather) []*envelope.E {
+	mkGameState := func(reason protocol.GameStateReason, value float32) *envelope.E {
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CChangeGameState)
+		gameState := cpacket.(*protocol.CPacketChangeGameState)
+		gameState.Reason = reason
+		gameState.Value = value
+		return envelope.MkCpacketEnvelope(gameState)
+	}
+
+	switch weather {
+	case game.WeatherRain:
+		return []*envelope.E{
+			mkGameState(protocol.GameStateBeginRaining, 0),
+			mkGameState(protocol.GameStateRainLevel, 1),
+			mkGameState(protocol.GameStateThunderLevel, 0),
+		}
+	case game.WeatherThunder:
+		return []*envelope.E{
+			mkGameState(protocol.GameStateBeginRaining, 0),
+			mkGameState(protocol.GameStateRainLevel, 1),
+			mkGameState(protocol.GameStateThunderLevel, 1),
+		}
+	default:
+		return []*envelope.E{
+			mkGameState(protocol.GameStateEndRaining, 0),
+			mkGameState(protocol.GameStateRainLevel, 0),
+			mkGameState(protocol.GameStateThunderLevel, 0),
+		}
+	}
+}
+
+// broadcast sends the packets to every player connected to this node.
+func (c *Clock) broadcast(outLopes ...*envelope.E) {
+	for _, p := range c.roster.GetPlayers() {
+		if err := c.ps.Publish(subj.MkConnTransmit(p.ConnID), outLopes...); err != nil {
+			c.log.Error("failed to publish conn.transmit message", zap.Error(err), zap.Any("conn", p.ConnID))
+		}
+	}
+}
+
+func (c *Clock) signal(state control.ComponentState, err error) {
+	c.control <- control.Command{
+		Signal:    control.COMPONENT,
+		Component: control.CLOCK,
+		State:     state,
+		Err:       err,
+	}
+}
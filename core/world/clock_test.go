@@ -0,0 +1,42 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/log"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+func TestClockTick(t *testing.T) {
+	clock := NewClock(log.MustGetTestNamed(t.Name()), nil, nil, nil)
+
+	for i := 1; i < timeUpdateInterval; i++ {
+		assert.Empty(t, clock.tick(), "time is only sent every second")
+	}
+	outLopes := clock.tick()
+	require.Len(t, outLopes, 1)
+	assert.Equal(t, protocol.CTimeUpdate.Value(), outLopes[0].GetCpacket().PacketType)
+
+	age, timeOfDay := clock.Time()
+	assert.Equal(t, int64(timeUpdateInterval), age)
+	assert.Equal(t, int64(TimeDay+timeUpdateInterval), timeOfDay)
+}
+
+func TestClockWeather(t *testing.T) {
+	clock := NewClock(log.MustGetTestNamed(t.Name()), nil, nil, nil)
+	clock.weather, clock.weatherLeft = game.WeatherThunder, 2
+
+	assert.Len(t, clock.Lopes(), 4, "time and weather expected for the joining players")
+
+	assert.Empty(t, clock.tick())
+	assert.Equal(t, game.WeatherThunder, clock.Weather())
+
+	outLopes := clock.tick()
+	assert.Len(t, outLopes, 3, "weather clearing up expected")
+	assert.Equal(t, game.WeatherClear, clock.Weather())
+	assert.Len(t, clock.Lopes(), 1)
+}
@@ -0,0 +1,108 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/alexykot/cncraft/pkg/chat"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// isContainer tells if the block opens a container window when used.
+func isContainer(block objects.BlockID) bool {
+	return block.IsChest() || block.IsFurnace() || block.IsCraftingTable()
+}
+
+// containerEntity provides the block entity of the container block, creating an empty one if the block has none yet,
+// e.g. if the block was placed and never changed since, so its entity was never saved.
+func containerEntity(chunk level.Chunk, block objects.BlockID, blockPosI data.PositionI) (level.BlockEntity, error) {
+	if entity, ok := chunk.GetBlockEntity(blockPosI); ok {
+		return entity, nil
+	}
+
+	entity, ok := level.NewBlockEntity(block, blockPosI)
+	if !ok {
+		return nil, fmt.Errorf("block %s has no block entity", block.String())
+	}
+	if err := chunk.SetBlockEntity(entity); err != nil {
+		return nil, fmt.Errorf("failed to set block entity, x:y:z %s: %w", blockPosI.String(), err)
+	}
+	return entity, nil
+}
+
+// entityContainer provides the item slots of the block entity, if it has any.
+func entityContainer(entity level.BlockEntity) (*items.Container, bool) {
+	switch e := entity.(type) {
+	case *level.ChestEntity:
+		return e.Container, true
+	case *level.FurnaceEntity:
+		return e.Container, true
+	}
+	return nil, false
+}
+
+// openWindowPacket produces a CPacket opening the container window on the client.
+func openWindowPacket(window *items.ContainerWindow) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.COpenWindow)
+	openWindow := cpacket.(*protocol.CPacketOpenWindow)
+
+	openWindow.WindowID = window.WindowID
+	openWindow.WindowType = window.Type
+	openWindow.Title = chat.New(window.Title)
+
+	return envelope.MkCpacketEnvelope(openWindow)
+}
+
+// closeWindowPacket produces a CPacket closing the container window on the client.
+func closeWindowPacket(windowID items.WindowID) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CCloseWindow)
+	closeWindow := cpacket.(*protocol.CPacketCloseWindow)
+
+	closeWindow.WindowID = windowID
+
+	return envelope.MkCpacketEnvelope(closeWindow)
+}
+
+// windowItemsPacket produces a CPacket setting all slots of the window.
+func windowItemsPacket(windowID items.WindowID, slots []items.Slot) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CWindowItems)
+	windowItems := cpacket.(*protocol.CPacketWindowItems)
+
+	windowItems.WindowID = windowID
+	windowItems.Slots = slots
+	windowItems.SlotCount = int16(len(slots))
+
+	return envelope.MkCpacketEnvelope(windowItems)
+}
+
+// windowSlotPacket produces a CPacket setting the window slot to the given item.
+func windowSlotPacket(windowID items.WindowID, slotID int16, slot items.Slot) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
+	setSlot := cpacket.(*protocol.CPacketSetSlot)
+
+	setSlot.WindowID = windowID
+	setSlot.SlotID = slotID
+	setSlot.Slot = slot
+
+	return envelope.MkCpacketEnvelope(setSlot)
+}
+
+// windowPropertyPackets produces CPackets setting all the given window properties, numbered in the given order.
+func windowPropertyPackets(windowID items.WindowID, properties []int16) []*envelope.E {
+	lopes := make([]*envelope.E, len(properties))
+	for property, value := range properties {
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CWindowProperty)
+		windowProperty := cpacket.(*protocol.CPacketWindowProperty)
+
+		windowProperty.WindowID = windowID
+		windowProperty.Property = int16(property)
+		windowProperty.Value = value
+
+		lopes[property] = envelope.MkCpacketEnvelope(windowProperty)
+	}
+	return lopes
+}
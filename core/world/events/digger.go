@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/alexykot/cncraft/pkg/game"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/game/loot"
 	"github.com/alexykot/cncraft/pkg/game/player"
 	"github.com/alexykot/cncraft/pkg/protocol"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
@@ -25,30 +27,55 @@ import (
 // more details at https://wiki.vg/index.php?title=Protocol&oldid=16676#Player_Digging
 const maxDigDistance = 7.5
 
+// finishedDigProgress - share of the dig time that needs to pass before the client can finish digging. Notchian server
+// is lenient the same way to allow for the client lag.
+const finishedDigProgress = 0.7
+
+// abandonedDigTicks - digs not finished within this many ticks past their dig time are abandoned, e.g. when
+// the client has disconnected mid-dig.
+const abandonedDigTicks = 100
+
+// noBreakStage - break animation stage that removes the animation.
+const noBreakStage = -1
+
 type digger struct {
 	sync.RWMutex
 
-	chunks     []level.Chunk
+	chunkIDs   []level.ChunkID
+	loadChunk  ChunkLoader
+	viewers    ChunkViewers
 	activeDigs map[data.PositionI]activeDig // block positions and active dig details
 	roster     players.Roster
+	drops      *dropper
+	rand       *rand.Rand // loot randomness
 }
 
 type activeDig struct {
 	startTime   game.Tick // tick time when digging started
 	diggerCount int       // number of players simultaneously digging the block
+
+	connID   uuid.UUID // connection of the player that started digging, the break animation is shown as theirs
+	entityID int32     // entity of the player that started digging
+	digTicks int64     // ticks it takes to dig the block with the tool the digging was started with
+	stage    int8      // break animation stage last shown
 }
 
-func newDigger(chunks []level.Chunk, roster players.Roster) Handler {
+func newDigger(chunkIDs []level.ChunkID, loadChunk ChunkLoader, viewers ChunkViewers, roster players.Roster, drops *dropper) Handler {
 	return &digger{
-		chunks: chunks,
-		roster: roster,
+		chunkIDs:   chunkIDs,
+		loadChunk:  loadChunk,
+		viewers:    viewers,
+		activeDigs: make(map[data.PositionI]activeDig),
+		roster:     roster,
+		drops:      drops,
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
 func (d *digger) Name() string { return "digger" }
 
 func (d *digger) GetTickHandler() TickHandler {
-	return nil // TODO this needs to handle ongoing active digs
+	return d.handleTick
 }
 
 func (d *digger) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
@@ -57,8 +84,31 @@ func (d *digger) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
 	}
 }
 
-func (d *digger) handleTick(tick game.Tick) (map[uuid.UUID][]*envelope.E, error) {
-	return nil, nil
+// handleTick progresses active digs, showing the break animation to the players around whenever the dig advances
+// to the next stage, and drops the abandoned digs.
+func (d *digger) handleTick(tick game.Tick) (map[subj.Subj][]*envelope.E, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	for blockPosI, dig := range d.activeDigs {
+		elapsed := ticksSince(dig.startTime, tick)
+		if elapsed > dig.digTicks+abandonedDigTicks {
+			delete(d.activeDigs, blockPosI)
+			d.addForViewers(outLopes, blockPosI, dig.connID, d.breakAnimationPacket(dig.entityID, blockPosI, noBreakStage))
+			continue
+		}
+
+		stage := breakStage(elapsed, dig.digTicks)
+		if stage == dig.stage {
+			continue
+		}
+		dig.stage = stage
+		d.activeDigs[blockPosI] = dig
+		d.addForViewers(outLopes, blockPosI, dig.connID, d.breakAnimationPacket(dig.entityID, blockPosI, stage))
+	}
+
+	return outLopes, nil
 }
 
 func (d *digger) handlePlayerDiggingEvent(tick game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error) {
@@ -100,7 +150,8 @@ func (d *digger) handleDig(tick game.Tick, digAction player.DiggingAction, playe
 }
 
 // handleStartedDigging handles digging starts sent by clients. It accounts for multiple clients potentially trying
-// to dig the same block at the same time.
+// to dig the same block at the same time. Blocks dug instantly are broken right away, clients do not send
+// the digging finish for them.
 func (d *digger) handleStartedDigging(tick game.Tick, playerID uuid.UUID, blockPosF data.PositionF) (map[subj.Subj][]*envelope.E, error) {
 	blockPosI := blockPosF.ToInt()
 	block, err := d.getBlockAtCoords(blockPosI)
@@ -108,20 +159,30 @@ func (d *digger) handleStartedDigging(tick game.Tick, playerID uuid.UUID, blockP
 		return nil, fmt.Errorf("failed to find block at coords %s: %w", blockPosI.String(), err)
 	}
 
-	_, isLegal, err := d.digIsLegal(playerID, block, blockPosF)
+	pl, digTicks, isLegal, err := d.digIsLegal(playerID, block, blockPosF)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine if dig is legal for player %s, coords %s: %w", playerID, blockPosF.String(), err)
 	} else if !isLegal {
 		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
-			d.ackPacket(false, blockPosI, block, player.StartedDigging),
+			d.ackPacket(false, blockPosI, block.ID(), player.StartedDigging),
 		}}, nil
 	}
 
+	if digTicks == 0 || pl.GetAbilities().InstantBuild {
+		return d.breakBlock(pl, block, blockPosI, player.StartedDigging)
+	}
+
 	d.Lock()
 	dig, ok := d.activeDigs[blockPosI]
 	if !ok || dig.diggerCount == 0 { // new digging effort starting
-		dig.startTime = tick
-		dig.diggerCount = 1
+		dig = activeDig{
+			startTime:   tick,
+			diggerCount: 1,
+			connID:      playerID,
+			entityID:    pl.PC.ID(),
+			digTicks:    digTicks,
+			stage:       noBreakStage,
+		}
 	} else { // player joining ongoing digging effort
 		dig.diggerCount++
 	}
@@ -129,7 +190,7 @@ func (d *digger) handleStartedDigging(tick game.Tick, playerID uuid.UUID, blockP
 	d.Unlock()
 
 	return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
-		d.ackPacket(true, blockPosI, block, player.StartedDigging),
+		d.ackPacket(true, blockPosI, block.ID(), player.StartedDigging),
 	}}, nil
 }
 
@@ -140,11 +201,13 @@ func (d *digger) handleFinishedDigging(tick game.Tick, playerID uuid.UUID, block
 		return nil, fmt.Errorf("failed to find block at coords %s: %w", blockPosI.String(), err)
 	}
 
-	digDuration, isLegal, err := d.digIsLegal(playerID, block, blockPosF)
+	pl, _, isLegal, err := d.digIsLegal(playerID, block, blockPosF)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine if dig is legal for player %s, coords %s: %w", playerID, blockPosF.String(), err)
 	} else if !isLegal {
-		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {d.ackPacket(false, blockPosI, block, player.FinishedDigging)}}, nil
+		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
+			d.ackPacket(false, blockPosI, block.ID(), player.FinishedDigging),
+		}}, nil
 	}
 
 	d.Lock()
@@ -152,32 +215,27 @@ func (d *digger) handleFinishedDigging(tick game.Tick, playerID uuid.UUID, block
 	if !ok || dig.diggerCount == 0 { // no digging was actually happening on this block, NAck.
 		d.Unlock()
 		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
-			d.ackPacket(false, blockPosI, block, player.FinishedDigging),
+			d.ackPacket(false, blockPosI, block.ID(), player.FinishedDigging),
 		}}, nil
 	}
 
-	// enough time has passed to dig out the given block with given tool, Ack and broadcast world state update
-	if tick.AsTime().Sub(dig.startTime.AsTime()) >= digDuration {
-		delete(d.activeDigs, blockPosI) // block dug successfully, all digging now stops
-		d.Unlock()
-
-		// TODO need to actually update the world state here
-
-		return map[subj.Subj][]*envelope.E{
-			subj.MkConnTransmit(playerID): {
-				d.ackPacket(true, blockPosI, block, player.FinishedDigging),
-			},
-			subj.MkConnBroadcast(): {
-				d.blockMinedPacket(blockPosI),
-				// TODO broadcast block entity spawned (no idea yet how to do that)
-			},
-		}, nil
-	} else { // enough time has passed to dig out the given block with given tool, NAck
+	// not enough time has passed to dig out the block with the tool the dig started with, NAck
+	if float64(ticksSince(dig.startTime, tick)) < float64(dig.digTicks)*finishedDigProgress {
 		d.Unlock()
 		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
-			d.ackPacket(false, blockPosI, block, player.FinishedDigging),
+			d.ackPacket(false, blockPosI, block.ID(), player.FinishedDigging),
 		}}, nil
 	}
+
+	delete(d.activeDigs, blockPosI) // block dug successfully, all digging now stops
+	d.Unlock()
+
+	outLopes, err := d.breakBlock(pl, block, blockPosI, player.FinishedDigging)
+	if err != nil {
+		return nil, err
+	}
+	d.addForViewers(outLopes, blockPosI, dig.connID, d.breakAnimationPacket(dig.entityID, blockPosI, noBreakStage))
+	return outLopes, nil
 }
 
 // handleCancelledDigging handles digging cancellations sent by clients. It does not consider/handle abandoned digs,
@@ -202,15 +260,154 @@ func (d *digger) handleCancelledDigging(_ game.Tick, playerID uuid.UUID, blockPo
 		return nil, fmt.Errorf("failed to find block at coords %s: %w", blockPosI.String(), err)
 	}
 
-	return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
-		d.ackPacket(true, blockPosI, block, player.CancelledDigging),
-	}}, nil
+	outLopes := map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
+		d.ackPacket(true, blockPosI, block.ID(), player.CancelledDigging),
+	}}
+	if ok && dig.diggerCount == 0 {
+		d.addForViewers(outLopes, blockPosI, dig.connID, d.breakAnimationPacket(dig.entityID, blockPosI, noBreakStage))
+	}
+	return outLopes, nil
+}
+
+// breakBlock sets the dug out block to air, drops the item from it along with the container contents, acknowledges
+// the dig and updates the block and the chunk light for everybody who has the chunk loaded.
+func (d *digger) breakBlock(pl *players.Player, block level.Block, blockPosI data.PositionI, action player.DiggingAction) (map[subj.Subj][]*envelope.E, error) {
+	playerID := pl.ConnID
+	chunk, err := d.getChunkAtCoords(blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+	if err := chunk.SetGlobalBlock(blockPosI, level.NewBlock(objects.BlockAir)); err != nil {
+		return nil, fmt.Errorf("failed to set block to air, x:y:z %s: %w", blockPosI.String(), err)
+	}
+
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	d.emptyContainer(outLopes, chunk, blockPosI)
+
+	changes := map[data.PositionI]objects.BlockID{blockPosI: objects.BlockAir}
+	if partnerPosI, partner, ok := d.splitChest(block.ID(), blockPosI); ok {
+		changes[partnerPosI] = partner
+	}
+
+	tool := pl.GetState().Inventory.GetCurrentTool().ItemID
+	if !pl.GetAbilities().InstantBuild && block.ID().CanHarvest(tool) { // nothing drops for creative players
+		drops := loot.BlockDrops(&loot.Context{
+			Rand:      d.rand,
+			Block:     block.ID(),
+			Position:  blockPosI,
+			BlockAt:   d.getBlockIDAtCoords,
+			Tool:      tool,
+			HasEntity: true,
+		})
+		for _, stack := range drops {
+			d.drops.DropBlock(stack, blockPosI)
+		}
+	}
+
+	outLopes[subj.MkConnTransmit(playerID)] = append(outLopes[subj.MkConnTransmit(playerID)],
+		d.ackPacket(true, blockPosI, objects.BlockAir, action))
+	viewers := d.viewers(level.FindChunkID(blockPosI))
+	if !hasConn(viewers, playerID) {
+		viewers = append(viewers, playerID)
+	}
+	for _, connID := range viewers {
+		subject := subj.MkConnTransmit(connID)
+		for changedPosI, changed := range changes {
+			outLopes[subject] = append(outLopes[subject], blockChangePacket(changedPosI, changed))
+		}
+		outLopes[subject] = append(outLopes[subject], updateLightPacket(chunk))
+	}
+	return outLopes, nil
 }
 
-func (d *digger) digIsLegal(playerID uuid.UUID, block level.Block, blockPosF data.PositionF) (digDuration time.Duration, isLegal bool, err error) {
+// emptyContainer removes the block entity of the broken block, dropping the container contents and closing
+// the container windows of everybody viewing it.
+func (d *digger) emptyContainer(outLopes map[subj.Subj][]*envelope.E, chunk level.Chunk, blockPosI data.PositionI) {
+	entity, ok := chunk.GetBlockEntity(blockPosI)
+	if !ok {
+		return
+	}
+	chunk.RemoveBlockEntity(blockPosI)
+
+	container, ok := entityContainer(entity)
+	if !ok {
+		return
+	}
+
+	entity.Lock()
+	viewers := container.Viewers()
+	contents := container.TakeAll()
+	entity.Unlock()
+
+	for _, stack := range contents {
+		d.drops.DropBlock(stack, blockPosI)
+	}
+
+	for connID, windowID := range viewers {
+		viewer, ok := d.roster.GetPlayerByConnID(connID)
+		if !ok {
+			continue
+		}
+		window, ok := viewer.TakeWindow(windowID)
+		if !ok {
+			continue
+		}
+
+		for _, item := range window.Close(connID) {
+			d.drops.DropThrown(viewer, item)
+		}
+		d.roster.PlayerInventoryChanged(connID)
+
+		inventory := viewer.GetState().Inventory
+		outLopes[subj.MkConnTransmit(connID)] = append(outLopes[subj.MkConnTransmit(connID)],
+			closeWindowPacket(windowID), windowItemsPacket(inventory.WindowID, inventory.ToArray()))
+	}
+}
+
+// splitChest turns the other half of the broken double chest into a single chest. Provides the position and
+// the block of the other half if there was one.
+func (d *digger) splitChest(block objects.BlockID, blockPosI data.PositionI) (data.PositionI, objects.BlockID, bool) {
+	if !block.IsChest() {
+		return data.PositionI{}, objects.BlockAir, false
+	}
+	partnerFacing, ok := block.ChestPartner()
+	if !ok {
+		return data.PositionI{}, objects.BlockAir, false
+	}
+
+	dx, dz := partnerFacing.Offset()
+	partnerPosI := data.PositionI{X: blockPosI.X + dx, Y: blockPosI.Y, Z: blockPosI.Z + dz}
+	chunk, err := d.getChunkAtCoords(partnerPosI)
+	if err != nil {
+		return data.PositionI{}, objects.BlockAir, false
+	}
+	partner, err := chunk.GetGlobalBlock(partnerPosI)
+	if err != nil || !partner.ID().IsChest() {
+		return data.PositionI{}, objects.BlockAir, false
+	}
+
+	single := partner.ID().WithChestType(objects.ChestSingle)
+	if err := chunk.SetGlobalBlock(partnerPosI, level.NewBlock(single)); err != nil {
+		return data.PositionI{}, objects.BlockAir, false
+	}
+	return partnerPosI, single, true
+}
+
+// addForViewers adds the given envelope for everybody who has the chunk of the given block loaded,
+// except the player with the given connection.
+func (d *digger) addForViewers(outLopes map[subj.Subj][]*envelope.E, blockPosI data.PositionI, exceptConnID uuid.UUID, lope *envelope.E) {
+	for _, connID := range d.viewers(level.FindChunkID(blockPosI)) {
+		if connID == exceptConnID {
+			continue
+		}
+		outLopes[subj.MkConnTransmit(connID)] = append(outLopes[subj.MkConnTransmit(connID)], lope)
+	}
+}
+
+func (d *digger) digIsLegal(playerID uuid.UUID, block level.Block, blockPosF data.PositionF) (pl *players.Player, digTicks int64, isLegal bool, err error) {
 	pl, ok := d.roster.GetPlayerByConnID(playerID)
 	if !ok {
-		return 0, false, fmt.Errorf("player %s not found", playerID.String())
+		return nil, 0, false, fmt.Errorf("player %s not found", playerID.String())
 	}
 
 	playerLoc := pl.GetLocation()
@@ -219,15 +416,15 @@ func (d *digger) digIsLegal(playerID uuid.UUID, block level.Block, blockPosF dat
 	zDistance := math.Abs(playerLoc.PositionF.Z - blockPosF.Z)
 	// DEBT likely not the correct algorithm according to Notchian server, but good enough for now.
 	if xDistance > maxDigDistance || yDistance > maxDigDistance || zDistance > maxDigDistance {
-		return 0, false, nil
+		return pl, 0, false, nil
 	}
 
 	tool := pl.GetState().Inventory.GetCurrentTool()
 	if !block.ID().IsDiggable(tool.ItemID) {
-		return 0, false, nil
+		return pl, 0, false, nil
 	}
 
-	return block.ID().DigTime(tool.ItemID), true, nil
+	return pl, block.ID().DigTicks(tool.ItemID), true, nil
 }
 
 func (d *digger) getBlockAtCoords(blockPosI data.PositionI) (level.Block, error) {
@@ -244,38 +441,53 @@ func (d *digger) getBlockAtCoords(blockPosI data.PositionI) (level.Block, error)
 	return block, nil
 }
 
-func (d *digger) getChunkAtCoords(blockPosI data.PositionI) (level.Chunk, error) {
-	chunkID := level.FindChunkID(blockPosI)
-	for _, chunk := range d.chunks {
-		if chunk.ID() == chunkID {
-			return chunk, nil
-		}
+// getBlockIDAtCoords provides the block state at the given coords for the loot tables, if the block is available.
+func (d *digger) getBlockIDAtCoords(blockPosI data.PositionI) (objects.BlockID, bool) {
+	block, err := d.getBlockAtCoords(blockPosI)
+	if err != nil {
+		return objects.BlockAir, false
 	}
+	return block.ID(), true
+}
 
-	// DEBT ideally add shardID to this error
-	return nil, fmt.Errorf("chunk %s not found in shard", chunkID.String())
+func (d *digger) getChunkAtCoords(blockPosI data.PositionI) (level.Chunk, error) {
+	return findChunk(d.chunkIDs, d.loadChunk, blockPosI)
 }
 
 // ackPacket produces AcknowledgePlayerDigging response. It will produce ack OR nack response, depending on params.
-func (d *digger) ackPacket(ack bool, blockPosI data.PositionI, block level.Block, action player.DiggingAction) *envelope.E {
+func (d *digger) ackPacket(ack bool, blockPosI data.PositionI, block objects.BlockID, action player.DiggingAction) *envelope.E {
 	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CAcknowledgePlayerDigging)
 	nack := cpacket.(*protocol.CPacketAcknowledgePlayerDigging)
 
 	nack.Location = blockPosI
-	nack.Block = block.ID()
+	nack.Block = block
 	nack.Status = action
 	nack.Successful = ack
 
 	return envelope.MkCpacketEnvelope(nack)
 }
 
-// blockUpdate produces a block update CPacket, setting the position of mined block to Air.
-func (d *digger) blockMinedPacket(blockPosI data.PositionI) *envelope.E {
-	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CBlockChange)
-	change := cpacket.(*protocol.CPacketBlockChange)
+// breakAnimationPacket produces a block break animation CPacket, showing the given dig stage of the block.
+func (d *digger) breakAnimationPacket(entityID int32, blockPosI data.PositionI, stage int8) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CBlockBreakAnimation)
+	animation := cpacket.(*protocol.CPacketBlockBreakAnimation)
 
-	change.Location = blockPosI
-	change.Block = objects.BlockAir
+	animation.EntityID = entityID
+	animation.Location = blockPosI
+	animation.DestroyStage = stage
 
-	return envelope.MkCpacketEnvelope(change)
+	return envelope.MkCpacketEnvelope(animation)
+}
+
+// ticksSince provides the number of whole ticks passed from the given tick to now.
+func ticksSince(since, now game.Tick) int64 {
+	return int64(now.AsTime().Sub(since.AsTime()) / game.TickSpeed)
+}
+
+// breakStage provides the break animation stage, 0 to 9, for the dig that has lasted the given number of ticks.
+func breakStage(elapsed, digTicks int64) int8 {
+	if digTicks <= 0 || elapsed >= digTicks {
+		return 9
+	}
+	return int8(elapsed * 10 / digTicks)
 }
@@ -0,0 +1,336 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/entities"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// itemSyncTicks - moving items have their position synced to the clients every this many ticks, clients simulate
+// the item movement in between.
+const itemSyncTicks = 20
+
+// Player item throwing details, as per Notchian server.
+const (
+	playerEyeHeight = 1.62
+	throwHeight     = playerEyeHeight - 0.3
+	throwSpeed      = 0.3
+	throwLift       = 0.1
+)
+
+// Items are picked up when the item is within the player bounding box expanded by these distances.
+const (
+	pickupReachXZ = 1
+	pickupReachY  = 0.5
+)
+
+// dropper keeps track of the items lying in the world, moving them around, merging the stacks, and letting
+// the players pick them up.
+// DEBT items are only shown to the players that have the chunk loaded when the item is spawned, players that load
+//  the chunk later do not see them. Items crossing shard boundaries are stopped at the boundary.
+type dropper struct {
+	sync.Mutex
+
+	chunkIDs  []level.ChunkID
+	loadChunk ChunkLoader
+	viewers   ChunkViewers
+	roster    players.Roster
+	items     map[int32]*droppedItem // item entities by entity ID
+}
+
+type droppedItem struct {
+	entities.Item
+	isSpawned bool // spawned on the clients
+}
+
+func newDropper(chunkIDs []level.ChunkID, loadChunk ChunkLoader, viewers ChunkViewers, roster players.Roster) *dropper {
+	return &dropper{
+		chunkIDs:  chunkIDs,
+		loadChunk: loadChunk,
+		viewers:   viewers,
+		roster:    roster,
+		items:     make(map[int32]*droppedItem),
+	}
+}
+
+func (d *dropper) Name() string { return "dropper" }
+
+func (d *dropper) GetTickHandler() TickHandler {
+	return d.handleTick
+}
+
+func (d *dropper) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
+	return map[pb.OneOfEvent]EventHandler{
+		pb.Event_PlayerDroppedItem: d.handlePlayerDroppedItemEvent,
+	}
+}
+
+// Drop puts the item into the world, it is spawned on the clients on the next tick.
+func (d *dropper) Drop(item items.Slot, position data.PositionF, velocity data.VelocityF, pickupDelay int64) {
+	if !item.IsPresent || item.ItemCount <= 0 {
+		return
+	}
+
+	it := entities.NewItem(item, position, velocity, pickupDelay)
+
+	d.Lock()
+	d.items[it.ID()] = &droppedItem{Item: it}
+	d.Unlock()
+}
+
+// DropBlock drops the item stack from the dug block, popping it up a little in a random direction.
+func (d *dropper) DropBlock(stack items.Slot, blockPosI data.PositionI) {
+	position := blockPosI.ToFloat()
+	position.X += 0.5
+	position.Y += 0.25
+	position.Z += 0.5
+
+	velocity := data.VelocityF{
+		X: rand.Float64()*0.2 - 0.1,
+		Y: 0.2,
+		Z: rand.Float64()*0.2 - 0.1,
+	}
+
+	d.Drop(stack, position, velocity, entities.BlockDropPickupDelay)
+}
+
+func (d *dropper) handlePlayerDroppedItemEvent(_ game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error) {
+	shardEvent := event.GetShardEvent()
+	if shardEvent == nil {
+		return nil, errors.New("provided event is not a shardEvent")
+	}
+
+	dropped := shardEvent.GetPlayerDroppedItem()
+	if dropped == nil {
+		return nil, errors.New("provided event is not a playerDroppedItem event")
+	}
+
+	playerID, err := uuid.Parse(dropped.PlayerId)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerId invalid: %w", err)
+	}
+
+	pl, ok := d.roster.GetPlayerByConnID(playerID)
+	if !ok {
+		return nil, fmt.Errorf("player %s not found", playerID.String())
+	}
+
+	d.DropThrown(pl, items.Slot{IsPresent: true, ItemID: objects.ItemID(dropped.ItemId), ItemCount: int16(dropped.ItemCount)})
+	return nil, nil
+}
+
+// DropThrown drops the item thrown by the player out of the player eyes in the direction the player is looking.
+func (d *dropper) DropThrown(pl *players.Player, item items.Slot) {
+	location := pl.GetLocation()
+	position := location.PositionF
+	position.Y += throwHeight
+
+	yaw := float64(location.Yaw) * math.Pi / 180
+	pitch := float64(location.Pitch) * math.Pi / 180
+	velocity := data.VelocityF{
+		X: -math.Sin(yaw) * math.Cos(pitch) * throwSpeed,
+		Y: -math.Sin(pitch)*throwSpeed + throwLift,
+		Z: math.Cos(yaw) * math.Cos(pitch) * throwSpeed,
+	}
+
+	d.Drop(item, position, velocity, entities.PlayerDropPickupDelay)
+}
+
+// handleTick spawns newly dropped items, moves the items around, merges the stacks lying close to each other,
+// despawns the expired items and gives the items to the players standing close enough.
+func (d *dropper) handleTick(_ game.Tick) (map[subj.Subj][]*envelope.E, error) {
+	d.Lock()
+	defer d.Unlock()
+
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	for entityID, it := range d.items {
+		if !it.isSpawned {
+			it.isSpawned = true
+			d.addForViewers(outLopes, it.GetPosition(), spawnItemPacket(it), entityMetadataPacket(it))
+		}
+
+		wasOnGround := it.IsOnGround()
+		moved := it.Tick(d.isSolid)
+
+		if it.IsExpired() {
+			delete(d.items, entityID)
+			d.addForViewers(outLopes, it.GetPosition(), destroyEntitiesPacket(entityID))
+			continue
+		}
+
+		if moved && (it.IsOnGround() != wasOnGround || it.Age()%itemSyncTicks == 0) {
+			d.addForViewers(outLopes, it.GetPosition(), entityTeleportPacket(it))
+		}
+	}
+
+	d.mergeItems(outLopes)
+	d.pickUpItems(outLopes)
+
+	return outLopes, nil
+}
+
+// mergeItems merges stacks of the same item lying close to each other, the merged stacks are despawned.
+func (d *dropper) mergeItems(outLopes map[subj.Subj][]*envelope.E) {
+	for entityID, it := range d.items {
+		if !it.IsOnGround() {
+			continue
+		}
+
+		for otherID, other := range d.items {
+			if otherID == entityID || !other.IsOnGround() || !it.MergeWith(other.Item) {
+				continue
+			}
+
+			delete(d.items, otherID)
+			d.addForViewers(outLopes, other.GetPosition(), destroyEntitiesPacket(otherID))
+			d.addForViewers(outLopes, it.GetPosition(), entityMetadataPacket(it))
+		}
+	}
+}
+
+// pickUpItems gives the items to the players standing close enough, as much as fits into their inventories.
+func (d *dropper) pickUpItems(outLopes map[subj.Subj][]*envelope.E) {
+	for entityID, it := range d.items {
+		if !it.isSpawned || !it.CanBePickedUp() {
+			continue
+		}
+
+		itemPos := it.GetPosition()
+		for _, connID := range d.viewers(level.FindChunkID(itemPos.ToBlock())) {
+			pl, ok := d.roster.GetPlayerByConnID(connID)
+			if !ok || pl.PC.GetGameMode() == game.Spectator || !canReachItem(pl.GetLocation().PositionF, itemPos) {
+				continue
+			}
+
+			inventory := pl.GetState().Inventory
+			item := it.GetSlot()
+			left, updated := inventory.PickUp(item)
+			if len(updated) == 0 {
+				continue // no space in the inventory
+			}
+			d.roster.PlayerInventoryChanged(connID)
+
+			transmit := subj.MkConnTransmit(connID)
+			for _, slotID := range updated {
+				outLopes[transmit] = append(outLopes[transmit], setSlotPacket(slotID, inventory.GetSlot(slotID)))
+			}
+
+			d.addForViewers(outLopes, itemPos, collectItemPacket(entityID, pl.PC.ID(), item.ItemCount-left.ItemCount))
+			if !left.IsPresent {
+				delete(d.items, entityID)
+				d.addForViewers(outLopes, itemPos, destroyEntitiesPacket(entityID))
+				break
+			}
+
+			it.SetSlot(left)
+			d.addForViewers(outLopes, itemPos, entityMetadataPacket(it))
+		}
+	}
+}
+
+// isSolid tells if the block stops items from moving into it. Blocks outside of the shard chunks are considered solid,
+// so items do not leave the shard.
+// DEBT non-solid blocks like flowers and torches stop the items as well.
+func (d *dropper) isSolid(blockPosI data.PositionI) bool {
+	if blockPosI.Y < 0 {
+		return true
+	}
+
+	chunk, err := findChunk(d.chunkIDs, d.loadChunk, blockPosI)
+	if err != nil {
+		return true
+	}
+
+	block, err := chunk.GetGlobalBlock(blockPosI)
+	if err != nil {
+		return false // sections not loaded are empty
+	}
+	return !block.ID().IsReplaceable()
+}
+
+// addForViewers adds the given envelopes for everybody who has the chunk of the given position loaded.
+func (d *dropper) addForViewers(outLopes map[subj.Subj][]*envelope.E, position data.PositionF, lopes ...*envelope.E) {
+	for _, connID := range d.viewers(level.FindChunkID(position.ToBlock())) {
+		outLopes[subj.MkConnTransmit(connID)] = append(outLopes[subj.MkConnTransmit(connID)], lopes...)
+	}
+}
+
+// canReachItem tells if the player standing at the given position is close enough to pick up the item.
+func canReachItem(playerPosF, itemPosF data.PositionF) bool {
+	return math.Abs(playerPosF.X-itemPosF.X) <= playerWidth/2+pickupReachXZ &&
+		math.Abs(playerPosF.Z-itemPosF.Z) <= playerWidth/2+pickupReachXZ &&
+		itemPosF.Y >= playerPosF.Y-pickupReachY && itemPosF.Y <= playerPosF.Y+playerHeight+pickupReachY
+}
+
+// spawnItemPacket produces a CPacket spawning the item entity.
+func spawnItemPacket(it entities.Item) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSpawnEntity)
+	spawn := cpacket.(*protocol.CPacketSpawnEntity)
+
+	spawn.EntityID = it.ID()
+	spawn.ObjectUUID = it.UUID()
+	spawn.EntityType = entities.ItemEntityType
+	spawn.Position = it.GetPosition()
+	spawn.Data = 1 // Notchian server sends 1 for items, the value is not used
+	spawn.Velocity = it.GetVelocity()
+
+	return envelope.MkCpacketEnvelope(spawn)
+}
+
+// entityMetadataPacket produces an entity metadata CPacket, for items it carries the item stack.
+func entityMetadataPacket(entity entities.Entity) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CEntityMetadata)
+	metadata := cpacket.(*protocol.CPacketEntityMetadata)
+	metadata.Entity = entity
+
+	return envelope.MkCpacketEnvelope(metadata)
+}
+
+// entityTeleportPacket produces a CPacket syncing the item entity position.
+func entityTeleportPacket(it entities.Item) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CEntityTeleport)
+	teleport := cpacket.(*protocol.CPacketEntityTeleport)
+
+	teleport.EntityID = it.ID()
+	teleport.Position = it.GetPosition()
+	teleport.OnGround = it.IsOnGround()
+
+	return envelope.MkCpacketEnvelope(teleport)
+}
+
+// collectItemPacket produces a CPacket showing the item picked up by the player.
+func collectItemPacket(itemEntityID, playerEntityID int32, count int16) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CCollectItem)
+	collect := cpacket.(*protocol.CPacketCollectItem)
+
+	collect.CollectedEntityID = itemEntityID
+	collect.CollectorEntityID = playerEntityID
+	collect.PickupItemCount = int32(count)
+
+	return envelope.MkCpacketEnvelope(collect)
+}
+
+// destroyEntitiesPacket produces a CPacket removing the entities from the clients.
+func destroyEntitiesPacket(entityIDs ...int32) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CDestroyEntities)
+	destroy := cpacket.(*protocol.CPacketDestroyEntities)
+	destroy.EntityIDs = entityIDs
+
+	return envelope.MkCpacketEnvelope(destroy)
+}
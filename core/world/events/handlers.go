@@ -1,25 +1,115 @@
 package events
 
 import (
+	"fmt"
+
+	"github.com/google/uuid"
+
 	"github.com/alexykot/cncraft/core/nats/subj"
 	"github.com/alexykot/cncraft/core/players"
 	"github.com/alexykot/cncraft/pkg/envelope"
 	"github.com/alexykot/cncraft/pkg/envelope/pb"
 	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
 type EventHandler func(tick game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error)
 type TickHandler func(tick game.Tick) (map[subj.Subj][]*envelope.E, error)
 
+// ChunkLoader provides the chunk, loading it into memory if needed.
+type ChunkLoader func(chunkID level.ChunkID) (level.Chunk, error)
+
+// ChunkViewers provides connection IDs of the players that have the chunk loaded.
+type ChunkViewers func(chunkID level.ChunkID) []uuid.UUID
+
+// LoadedChunks provides the shard chunks currently loaded into memory, without loading the rest.
+type LoadedChunks func() []level.Chunk
+
 type Handler interface {
 	Name() string
 	GetTickHandler() TickHandler
 	GetEventHandlers() map[pb.OneOfEvent]EventHandler
 }
 
-func NewHandlers(chunks []level.Chunk, roster players.Roster) []Handler {
+func NewHandlers(chunkIDs []level.ChunkID, loadChunk ChunkLoader, loadedChunks LoadedChunks, viewers ChunkViewers, roster players.Roster) []Handler {
+	drops := newDropper(chunkIDs, loadChunk, viewers, roster)
+
 	return []Handler{
-		newDigger(chunks, roster),
+		drops,
+		newDigger(chunkIDs, loadChunk, viewers, roster, drops),
+		newPlacer(chunkIDs, loadChunk, viewers, roster, drops),
+		newSmelter(loadedChunks, viewers),
+		newInspector(chunkIDs, loadChunk, roster),
+		newScribe(chunkIDs, loadChunk, viewers),
+	}
+}
+
+// findChunk provides the chunk containing the given block, if the chunk belongs to the given shard chunks.
+func findChunk(chunkIDs []level.ChunkID, loadChunk ChunkLoader, blockPosI data.PositionI) (level.Chunk, error) {
+	chunkID := level.FindChunkID(blockPosI)
+	for _, id := range chunkIDs {
+		if id == chunkID {
+			return loadChunk(chunkID)
+		}
+	}
+
+	// DEBT ideally add shardID to this error
+	return nil, fmt.Errorf("chunk %s not found in shard", chunkID.String())
+}
+
+// blockChangePacket produces a block update CPacket, setting the given position to the given block.
+func blockChangePacket(blockPosI data.PositionI, block objects.BlockID) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CBlockChange)
+	change := cpacket.(*protocol.CPacketBlockChange)
+
+	change.Location = blockPosI
+	change.Block = block
+
+	return envelope.MkCpacketEnvelope(change)
+}
+
+// blockEntityDataPacket produces a CPacket updating the block entity on the clients, if the entity type is one the
+// clients are updated with. Provides nil otherwise.
+func blockEntityDataPacket(entity level.BlockEntity) (*envelope.E, error) {
+	action, ok := entity.Type().UpdateAction()
+	if !ok {
+		return nil, nil
+	}
+
+	entity.Lock()
+	entityNBT, err := level.MarshalClientBlockEntity(entity)
+	entity.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal block entity, x:y:z %s: %w", entity.Position().String(), err)
+	}
+
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CBlockEntityData)
+	entityData := cpacket.(*protocol.CPacketBlockEntityData)
+
+	entityData.Location = entity.Position()
+	entityData.Action = action
+	entityData.NBT = entityNBT
+
+	return envelope.MkCpacketEnvelope(entityData), nil
+}
+
+// updateLightPacket produces a light update CPacket for the whole chunk.
+func updateLightPacket(chunk level.Chunk) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CUpdateLight)
+	updateLight := cpacket.(*protocol.CPacketUpdateLight)
+	updateLight.Chunk = chunk
+
+	return envelope.MkCpacketEnvelope(updateLight)
+}
+
+func hasConn(connIDs []uuid.UUID, connID uuid.UUID) bool {
+	for _, id := range connIDs {
+		if id == connID {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,106 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/game/player"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+// PermissionQueryNBT - permission node allowing the players below the gamemaster op level to query the block NBT.
+const PermissionQueryNBT = "cncraft.query.nbt"
+
+// inspector answers the block entity NBT queries the clients make for the debug screen.
+type inspector struct {
+	chunkIDs  []level.ChunkID
+	loadChunk ChunkLoader
+	roster    players.Roster
+}
+
+func newInspector(chunkIDs []level.ChunkID, loadChunk ChunkLoader, roster players.Roster) Handler {
+	return &inspector{
+		chunkIDs:  chunkIDs,
+		loadChunk: loadChunk,
+		roster:    roster,
+	}
+}
+
+func (i *inspector) Name() string { return "inspector" }
+
+func (i *inspector) GetTickHandler() TickHandler { return nil }
+
+func (i *inspector) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
+	return map[pb.OneOfEvent]EventHandler{
+		pb.Event_PlayerQueriedBlockNbt: i.handlePlayerQueriedBlockNBTEvent,
+	}
+}
+
+// handlePlayerQueriedBlockNBTEvent responds with the NBT of the block entity at the queried position, or with
+// no NBT if there is no block entity there. Same as the Notchian server, only the players permitted to use cheats
+// are answered, queries of the rest are ignored.
+func (i *inspector) handlePlayerQueriedBlockNBTEvent(_ game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error) {
+	shardEvent := event.GetShardEvent()
+	if shardEvent == nil {
+		return nil, errors.New("provided event is not a shardEvent")
+	}
+
+	query := shardEvent.GetPlayerQueriedBlockNbt()
+	if query == nil {
+		return nil, errors.New("provided event is not a playerQueriedBlockNBT event")
+	}
+
+	playerID, err := uuid.Parse(query.PlayerId)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerId invalid: %w", err)
+	}
+
+	pl, ok := i.roster.GetPlayerByConnID(playerID)
+	if !ok {
+		return nil, fmt.Errorf("player %s not found", playerID.String())
+	}
+	if !canQueryNBT(pl.GetPermissions()) {
+		return nil, nil
+	}
+
+	blockPosI := data.PositionFFromPb(query.Pos).ToInt()
+	chunk, err := findChunk(i.chunkIDs, i.loadChunk, blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+
+	var entityNBT []byte
+	if entity, ok := chunk.GetBlockEntity(blockPosI); ok {
+		entity.Lock()
+		entityNBT, err = level.MarshalBlockEntity(entity)
+		entity.Unlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CNBTQueryResponse)
+	response := cpacket.(*protocol.CPacketNBTQueryResponse)
+	response.TransactionID = query.TransactionId
+	response.NBT = entityNBT
+
+	return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {envelope.MkCpacketEnvelope(response)}}, nil
+}
+
+// canQueryNBT tells if the player is permitted to query the block NBT, i.e. has the gamemaster op level or
+// the query permission node.
+func canQueryNBT(permissions *player.Permissions) bool {
+	if permissions == nil {
+		return false
+	}
+	return permissions.OpLevel >= player.OpLevelGamemaster || permissions.Has(PermissionQueryNBT)
+}
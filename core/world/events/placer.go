@@ -0,0 +1,428 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// maxPlaceDistance - very crude way to determine if placing is within legal distance, same as for digging.
+const maxPlaceDistance = maxDigDistance
+
+// Player bounding box dimensions, the position of the player is at the bottom centre of the box.
+const (
+	playerWidth  = 0.6
+	playerHeight = 1.8
+)
+
+// Window slot IDs of the item held in each hand, as per https://wiki.vg/Inventory#Player_Inventory
+const (
+	hotbarFirstSlot = 36
+	offhandSlot     = 45
+)
+
+type placer struct {
+	chunkIDs  []level.ChunkID
+	loadChunk ChunkLoader
+	viewers   ChunkViewers
+	roster    players.Roster
+	drops     *dropper
+}
+
+func newPlacer(chunkIDs []level.ChunkID, loadChunk ChunkLoader, viewers ChunkViewers, roster players.Roster, drops *dropper) Handler {
+	return &placer{
+		chunkIDs:  chunkIDs,
+		loadChunk: loadChunk,
+		viewers:   viewers,
+		roster:    roster,
+		drops:     drops,
+	}
+}
+
+func (p *placer) Name() string { return "placer" }
+
+func (p *placer) GetTickHandler() TickHandler { return nil }
+
+func (p *placer) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
+	return map[pb.OneOfEvent]EventHandler{
+		pb.Event_PlayerBlockPlacement: p.handlePlayerBlockPlacementEvent,
+	}
+}
+
+func (p *placer) handlePlayerBlockPlacementEvent(_ game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error) {
+	shardEvent := event.GetShardEvent()
+	if shardEvent == nil {
+		return nil, errors.New("provided event is not a shardEvent")
+	}
+
+	placement := shardEvent.GetPlayerBlockPlacement()
+	if placement == nil {
+		return nil, errors.New("provided event is not a playerBlockPlacement event")
+	}
+
+	playerID, err := uuid.Parse(placement.PlayerId)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerId invalid: %w", err)
+	}
+
+	res, err := p.handlePlacement(playerID, placement)
+	if err != nil {
+		return nil, fmt.Errorf("failed to handle player block placement: %w", err)
+	}
+	return res, nil
+}
+
+// handlePlacement opens the window of the clicked container block, or otherwise places the block from the held item
+// next to the clicked block, or into the clicked block itself if that one is replaceable. Placements next to
+// the clicked block in another shard are passed on to that shard. Illegal placements are reverted on the client
+// by resending the actual block and the held item.
+func (p *placer) handlePlacement(playerID uuid.UUID, placement *pb.PlayerBlockPlacement) (map[subj.Subj][]*envelope.E, error) {
+	pl, ok := p.roster.GetPlayerByConnID(playerID)
+	if !ok {
+		return nil, fmt.Errorf("player %s not found", playerID.String())
+	}
+
+	clickedPosI := data.PositionFFromPb(placement.Pos).ToInt()
+	blockPosI := clickedPosI.Facing(placement.BlockFace)
+	if clicked, err := p.getBlockAtCoords(clickedPosI); err == nil {
+		// DEBT sneaking players should place blocks against the containers instead, sneaking is not tracked yet
+		if isContainer(clicked.ID()) {
+			return p.openContainer(pl, clicked.ID(), clickedPosI)
+		}
+		if clicked.ID().IsReplaceable() {
+			blockPosI = clickedPosI
+		}
+	}
+
+	chunk, err := findChunk(p.chunkIDs, p.loadChunk, blockPosI)
+	if err != nil {
+		if placement.PlaceShardId == "" {
+			return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+		}
+
+		// passed on without the place shard, so the placement cannot bounce between the shards
+		return map[subj.Subj][]*envelope.E{subj.MkShardEvent(placement.PlaceShardId): {
+			envelope.PlayerBlockPlacement(&pb.PlayerBlockPlacement{
+				PlayerId:  placement.PlayerId,
+				Hand:      placement.Hand,
+				Pos:       placement.Pos,
+				BlockFace: placement.BlockFace,
+			}),
+		}}, nil
+	}
+	current, err := chunk.GetGlobalBlock(blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("block not found in the chunk, x:y:z %s", blockPosI.String())
+	}
+
+	inventory := pl.GetState().Inventory
+	slotID := heldSlotID(inventory, placement.Hand)
+	held := inventory.GetSlot(slotID)
+
+	newBlock, isLegal := p.placementIsLegal(pl, held, current, blockPosI)
+	if !isLegal {
+		return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
+			blockChangePacket(blockPosI, current.ID()),
+			setSlotPacket(slotID, held),
+		}}, nil
+	}
+
+	var neighbourPosI data.PositionI
+	var neighbour objects.BlockID
+	var isJoined bool
+	if newBlock.IsChest() {
+		newBlock, neighbourPosI, neighbour, isJoined = p.joinChest(newBlock, blockPosI)
+	}
+
+	if err := chunk.SetGlobalBlock(blockPosI, level.NewBlock(newBlock)); err != nil {
+		return nil, fmt.Errorf("failed to place block, x:y:z %s: %w", blockPosI.String(), err)
+	}
+
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	if !pl.GetAbilities().InstantBuild { // creative players do not run out of blocks
+		// the slot may have changed since it was read, by a window click or a command in another goroutine
+		left, ok := inventory.Consume(slotID, held.ItemID, 1)
+		if !ok {
+			if err := chunk.SetGlobalBlock(blockPosI, current); err != nil {
+				return nil, fmt.Errorf("failed to roll back placed block, x:y:z %s: %w", blockPosI.String(), err)
+			}
+			return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(playerID): {
+				blockChangePacket(blockPosI, current.ID()),
+				setSlotPacket(slotID, left),
+			}}, nil
+		}
+		p.roster.PlayerInventoryChanged(playerID)
+
+		outLopes[subj.MkConnTransmit(playerID)] = []*envelope.E{setSlotPacket(slotID, left)}
+	}
+
+	changes := map[data.PositionI]objects.BlockID{blockPosI: newBlock}
+	if isJoined {
+		if err := p.setBlock(neighbourPosI, neighbour); err != nil {
+			return nil, err
+		}
+		changes[neighbourPosI] = neighbour
+	}
+
+	var entityLope *envelope.E
+	var isSign bool
+	if entity, ok := level.NewBlockEntity(newBlock, blockPosI); ok {
+		if sign, ok := entity.(*level.SignEntity); ok {
+			sign.SetEditor(playerID) // the sign text is written right after placing
+			isSign = true
+		}
+		if err := chunk.SetBlockEntity(entity); err != nil {
+			return nil, fmt.Errorf("failed to set block entity, x:y:z %s: %w", blockPosI.String(), err)
+		}
+		if entityLope, err = blockEntityDataPacket(entity); err != nil {
+			return nil, err
+		}
+	}
+
+	viewers := p.viewers(level.FindChunkID(blockPosI))
+	if !hasConn(viewers, playerID) {
+		viewers = append(viewers, playerID)
+	}
+	for _, connID := range viewers {
+		subject := subj.MkConnTransmit(connID)
+		for changedPosI, changed := range changes {
+			outLopes[subject] = append(outLopes[subject], blockChangePacket(changedPosI, changed))
+		}
+		if entityLope != nil {
+			outLopes[subject] = append(outLopes[subject], entityLope)
+		}
+		outLopes[subject] = append(outLopes[subject], updateLightPacket(chunk))
+	}
+	if isSign {
+		subject := subj.MkConnTransmit(playerID)
+		outLopes[subject] = append(outLopes[subject], openSignEditorPacket(blockPosI))
+	}
+	return outLopes, nil
+}
+
+// openContainer opens the window of the clicked container block for the player, closing the window the player
+// had open before, if any.
+func (p *placer) openContainer(pl *players.Player, block objects.BlockID, blockPosI data.PositionI) (map[subj.Subj][]*envelope.E, error) {
+	chunk, err := findChunk(p.chunkIDs, p.loadChunk, blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+
+	inventory := pl.GetState().Inventory
+	windowID := pl.NextWindowID()
+
+	var window *items.ContainerWindow
+	switch {
+	case block.IsCraftingTable():
+		window = items.NewCraftingWindow(windowID, inventory)
+	case block.IsFurnace():
+		entity, err := containerEntity(chunk, block, blockPosI)
+		if err != nil {
+			return nil, err
+		}
+		furnace, ok := entity.(*level.FurnaceEntity)
+		if !ok {
+			return nil, fmt.Errorf("block entity at x:y:z %s is not a furnace", blockPosI.String())
+		}
+		window = items.NewFurnaceWindow(windowID, inventory, furnace.Furnace)
+	case block.IsChest():
+		containers, err := p.chestContainers(chunk, block, blockPosI)
+		if err != nil {
+			return nil, err
+		}
+		window = items.NewChestWindow(windowID, inventory, containers...)
+	default:
+		return nil, fmt.Errorf("block %s is not a container", block.String())
+	}
+
+	if previous := pl.SetWindow(window); previous != nil {
+		for _, item := range previous.Close(pl.ConnID) {
+			p.drops.DropThrown(pl, item)
+		}
+	}
+	window.Open(pl.ConnID)
+
+	lopes := []*envelope.E{openWindowPacket(window), windowItemsPacket(window.WindowID, window.ToArray())}
+	lopes = append(lopes, windowPropertyPackets(window.WindowID, window.Properties())...)
+	return map[subj.Subj][]*envelope.E{subj.MkConnTransmit(pl.ConnID): lopes}, nil
+}
+
+// chestContainers provides the containers of the chest, of both halves if the chest is double with the right half
+// going first, same as the Notchian server shows them.
+// DEBT the other half of a double chest is not reachable if it is in another shard, such chests open as single ones.
+func (p *placer) chestContainers(chunk level.Chunk, block objects.BlockID, blockPosI data.PositionI) ([]*items.Container, error) {
+	chest, err := p.chestContainer(chunk, block, blockPosI)
+	if err != nil {
+		return nil, err
+	}
+
+	partnerFacing, ok := block.ChestPartner()
+	if !ok {
+		return []*items.Container{chest}, nil
+	}
+
+	dx, dz := partnerFacing.Offset()
+	partnerPosI := data.PositionI{X: blockPosI.X + dx, Y: blockPosI.Y, Z: blockPosI.Z + dz}
+	partnerChunk, err := findChunk(p.chunkIDs, p.loadChunk, partnerPosI)
+	if err != nil {
+		return []*items.Container{chest}, nil
+	}
+	partnerBlock, err := partnerChunk.GetGlobalBlock(partnerPosI)
+	if err != nil || !partnerBlock.ID().IsChest() {
+		return []*items.Container{chest}, nil
+	}
+	partner, err := p.chestContainer(partnerChunk, partnerBlock.ID(), partnerPosI)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, chestType := block.ChestState(); chestType == objects.ChestRight {
+		return []*items.Container{chest, partner}, nil
+	}
+	return []*items.Container{partner, chest}, nil
+}
+
+func (p *placer) chestContainer(chunk level.Chunk, block objects.BlockID, blockPosI data.PositionI) (*items.Container, error) {
+	entity, err := containerEntity(chunk, block, blockPosI)
+	if err != nil {
+		return nil, err
+	}
+	chest, ok := entity.(*level.ChestEntity)
+	if !ok {
+		return nil, fmt.Errorf("block entity at x:y:z %s is not a chest", blockPosI.String())
+	}
+	return chest.Container, nil
+}
+
+// joinChest makes a double chest out of the chest being placed and a single chest facing the same way next to it,
+// if there is one. Provides the placed chest block, and the position and the block of the other half if joined.
+func (p *placer) joinChest(block objects.BlockID, blockPosI data.PositionI) (objects.BlockID, data.PositionI, objects.BlockID, bool) {
+	facing, _ := block.ChestState()
+	sides := []struct {
+		direction objects.Facing
+		placed    objects.ChestType
+		neighbour objects.ChestType
+	}{
+		{direction: facing.Clockwise(), placed: objects.ChestLeft, neighbour: objects.ChestRight},
+		{direction: facing.CounterClockwise(), placed: objects.ChestRight, neighbour: objects.ChestLeft},
+	}
+
+	for _, side := range sides {
+		dx, dz := side.direction.Offset()
+		neighbourPosI := data.PositionI{X: blockPosI.X + dx, Y: blockPosI.Y, Z: blockPosI.Z + dz}
+		neighbour, err := p.getBlockAtCoords(neighbourPosI)
+		if err != nil || !neighbour.ID().IsChest() {
+			continue
+		}
+		if neighbourFacing, chestType := neighbour.ID().ChestState(); neighbourFacing != facing || chestType != objects.ChestSingle {
+			continue
+		}
+		return block.WithChestType(side.placed), neighbourPosI, neighbour.ID().WithChestType(side.neighbour), true
+	}
+	return block, data.PositionI{}, objects.BlockAir, false
+}
+
+func (p *placer) setBlock(blockPosI data.PositionI, block objects.BlockID) error {
+	chunk, err := findChunk(p.chunkIDs, p.loadChunk, blockPosI)
+	if err != nil {
+		return fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+	if err := chunk.SetGlobalBlock(blockPosI, level.NewBlock(block)); err != nil {
+		return fmt.Errorf("failed to set block, x:y:z %s: %w", blockPosI.String(), err)
+	}
+	return nil
+}
+
+// placementIsLegal checks if the held item can be placed as a block into the given position, and provides the block.
+func (p *placer) placementIsLegal(pl *players.Player, held items.Slot, current level.Block, blockPosI data.PositionI) (objects.BlockID, bool) {
+	if !held.IsPresent || held.ItemCount <= 0 {
+		return objects.BlockAir, false
+	}
+
+	newBlock, ok := held.ItemID.BlockState()
+	if !ok {
+		return objects.BlockAir, false
+	}
+
+	if !current.ID().IsReplaceable() {
+		return objects.BlockAir, false
+	}
+
+	playerLoc := pl.GetLocation()
+	blockPosF := blockPosI.ToFloat()
+	xDistance := math.Abs(playerLoc.PositionF.X - blockPosF.X)
+	yDistance := math.Abs(playerLoc.PositionF.Y - blockPosF.Y)
+	zDistance := math.Abs(playerLoc.PositionF.Z - blockPosF.Z)
+	// DEBT likely not the correct algorithm according to Notchian server, but good enough for now.
+	if xDistance > maxPlaceDistance || yDistance > maxPlaceDistance || zDistance > maxPlaceDistance {
+		return objects.BlockAir, false
+	}
+
+	// DEBT only players are checked for collisions, other entities are not tracked by shards yet. Non-solid blocks
+	//  like torches and flowers should not collide at all.
+	for _, connID := range p.viewers(level.FindChunkID(blockPosI)) {
+		if viewer, ok := p.roster.GetPlayerByConnID(connID); ok && collidesWithPlayer(viewer.GetLocation().PositionF, blockPosI) {
+			return objects.BlockAir, false
+		}
+	}
+	if collidesWithPlayer(playerLoc.PositionF, blockPosI) {
+		return objects.BlockAir, false
+	}
+
+	return newBlock, true
+}
+
+func (p *placer) getBlockAtCoords(blockPosI data.PositionI) (level.Block, error) {
+	chunk, err := findChunk(p.chunkIDs, p.loadChunk, blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+
+	block, err := chunk.GetGlobalBlock(blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("block not found in the chunk, x:y:z %s", blockPosI.String())
+	}
+
+	return block, nil
+}
+
+// heldSlotID provides the inventory window slot ID of the item held in the given hand.
+func heldSlotID(inventory *items.Inventory, hand pb.PlayerBlockPlacement_Hand) int16 {
+	if hand == pb.PlayerBlockPlacement_OFF_HAND {
+		return offhandSlot
+	}
+	return hotbarFirstSlot + int16(inventory.GetCurrentHotbarSlot())
+}
+
+// collidesWithPlayer tells if the bounding box of the player standing at the given position intersects the block.
+func collidesWithPlayer(playerPosF data.PositionF, blockPosI data.PositionI) bool {
+	block := blockPosI.ToFloat()
+	return playerPosF.X+playerWidth/2 > block.X && playerPosF.X-playerWidth/2 < block.X+1 &&
+		playerPosF.Y+playerHeight > block.Y && playerPosF.Y < block.Y+1 &&
+		playerPosF.Z+playerWidth/2 > block.Z && playerPosF.Z-playerWidth/2 < block.Z+1
+}
+
+// setSlotPacket produces a CPacket setting the player inventory slot to the given item.
+func setSlotPacket(slotID int16, slot items.Slot) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CSetSlot)
+	setSlot := cpacket.(*protocol.CPacketSetSlot)
+
+	setSlot.WindowID = items.InventoryWindow
+	setSlot.SlotID = slotID
+	setSlot.Slot = slot
+
+	return envelope.MkCpacketEnvelope(setSlot)
+}
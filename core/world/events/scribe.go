@@ -0,0 +1,113 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+// scribe writes the text the players put on the signs they placed.
+type scribe struct {
+	chunkIDs  []level.ChunkID
+	loadChunk ChunkLoader
+	viewers   ChunkViewers
+}
+
+func newScribe(chunkIDs []level.ChunkID, loadChunk ChunkLoader, viewers ChunkViewers) Handler {
+	return &scribe{
+		chunkIDs:  chunkIDs,
+		loadChunk: loadChunk,
+		viewers:   viewers,
+	}
+}
+
+func (s *scribe) Name() string { return "scribe" }
+
+func (s *scribe) GetTickHandler() TickHandler { return nil }
+
+func (s *scribe) GetEventHandlers() map[pb.OneOfEvent]EventHandler {
+	return map[pb.OneOfEvent]EventHandler{
+		pb.Event_PlayerUpdatedSign: s.handlePlayerUpdatedSignEvent,
+	}
+}
+
+// handlePlayerUpdatedSignEvent writes the text on the sign and updates the sign for everybody who has the chunk
+// loaded. Updates from the players not allowed to write on the sign are ignored, same as in the Notchian server.
+func (s *scribe) handlePlayerUpdatedSignEvent(_ game.Tick, event *envelope.E) (map[subj.Subj][]*envelope.E, error) {
+	shardEvent := event.GetShardEvent()
+	if shardEvent == nil {
+		return nil, errors.New("provided event is not a shardEvent")
+	}
+
+	update := shardEvent.GetPlayerUpdatedSign()
+	if update == nil {
+		return nil, errors.New("provided event is not a playerUpdatedSign event")
+	}
+
+	playerID, err := uuid.Parse(update.PlayerId)
+	if err != nil {
+		return nil, fmt.Errorf("PlayerId invalid: %w", err)
+	}
+
+	var lines [level.SignLineCount]string
+	if len(update.Lines) != len(lines) {
+		return nil, fmt.Errorf("sign update has %d lines, expected %d", len(update.Lines), len(lines))
+	}
+	copy(lines[:], update.Lines)
+
+	blockPosI := data.PositionFFromPb(update.Pos).ToInt()
+	chunk, err := findChunk(s.chunkIDs, s.loadChunk, blockPosI)
+	if err != nil {
+		return nil, fmt.Errorf("no chunk available for given coords, x:y:z %s", blockPosI.String())
+	}
+
+	entity, ok := chunk.GetBlockEntity(blockPosI)
+	if !ok {
+		return nil, nil // the sign was broken before the update arrived
+	}
+	sign, ok := entity.(*level.SignEntity)
+	if !ok {
+		return nil, fmt.Errorf("block entity is not a sign, x:y:z %s", blockPosI.String())
+	}
+
+	sign.Lock()
+	isWritten := sign.Write(playerID, lines)
+	sign.Unlock()
+	if !isWritten {
+		return nil, nil
+	}
+
+	entityLope, err := blockEntityDataPacket(sign)
+	if err != nil {
+		return nil, err
+	}
+
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	viewers := s.viewers(chunk.ID())
+	if !hasConn(viewers, playerID) {
+		viewers = append(viewers, playerID)
+	}
+	for _, connID := range viewers {
+		outLopes[subj.MkConnTransmit(connID)] = []*envelope.E{entityLope}
+	}
+	return outLopes, nil
+}
+
+// openSignEditorPacket produces a CPacket opening the sign text editor on the client.
+func openSignEditorPacket(blockPosI data.PositionI) *envelope.E {
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.COpenSignEditor)
+	openEditor := cpacket.(*protocol.CPacketOpenSignEditor)
+
+	openEditor.Location = blockPosI
+
+	return envelope.MkCpacketEnvelope(openEditor)
+}
@@ -0,0 +1,95 @@
+package events
+
+import (
+	"fmt"
+
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/envelope/pb"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/level"
+)
+
+// smelter burns the fuel and cooks the items in the furnaces of the loaded shard chunks. Furnaces in unloaded chunks
+// stand still, same as in the Notchian server.
+type smelter struct {
+	loadedChunks LoadedChunks
+	viewers      ChunkViewers
+}
+
+func newSmelter(loadedChunks LoadedChunks, viewers ChunkViewers) Handler {
+	return &smelter{
+		loadedChunks: loadedChunks,
+		viewers:      viewers,
+	}
+}
+
+func (s *smelter) Name() string { return "smelter" }
+
+func (s *smelter) GetTickHandler() TickHandler {
+	return s.handleTick
+}
+
+func (s *smelter) GetEventHandlers() map[pb.OneOfEvent]EventHandler { return nil }
+
+// handleTick progresses every furnace by one tick, updating the furnace windows of the players viewing them, and
+// lighting up or putting out the furnace blocks for everybody who has the chunk loaded.
+func (s *smelter) handleTick(_ game.Tick) (map[subj.Subj][]*envelope.E, error) {
+	outLopes := make(map[subj.Subj][]*envelope.E)
+	for _, chunk := range s.loadedChunks() {
+		for _, entity := range chunk.BlockEntities() {
+			furnace, ok := entity.(*level.FurnaceEntity)
+			if !ok {
+				continue
+			}
+			if err := s.tickFurnace(outLopes, chunk, furnace); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return outLopes, nil
+}
+
+func (s *smelter) tickFurnace(outLopes map[subj.Subj][]*envelope.E, chunk level.Chunk, furnace *level.FurnaceEntity) error {
+	furnace.Lock()
+	isUpdated, isSlotsUpdated := furnace.Tick()
+	isLit := furnace.IsLit()
+	properties := furnace.Properties()
+	slots := furnace.Slots()
+	viewers := furnace.Viewers()
+	furnace.Unlock()
+
+	for connID, windowID := range viewers {
+		subject := subj.MkConnTransmit(connID)
+		if isUpdated {
+			outLopes[subject] = append(outLopes[subject], windowPropertyPackets(windowID, properties)...)
+		}
+		if isSlotsUpdated {
+			for slotID, slot := range slots {
+				outLopes[subject] = append(outLopes[subject], windowSlotPacket(windowID, int16(slotID), slot))
+			}
+		}
+	}
+
+	blockPosI := furnace.Position()
+	block, err := chunk.GetGlobalBlock(blockPosI)
+	if err != nil {
+		return fmt.Errorf("block not found in the chunk, x:y:z %s", blockPosI.String())
+	}
+	if !block.ID().IsFurnace() {
+		return nil
+	}
+	if _, wasLit := block.ID().FurnaceState(); wasLit == isLit {
+		return nil
+	}
+
+	litBlock := block.ID().WithFurnaceLit(isLit)
+	if err := chunk.SetGlobalBlock(blockPosI, level.NewBlock(litBlock)); err != nil {
+		return fmt.Errorf("failed to set furnace block, x:y:z %s: %w", blockPosI.String(), err)
+	}
+	for _, connID := range s.viewers(chunk.ID()) {
+		subject := subj.MkConnTransmit(connID)
+		outLopes[subject] = append(outLopes[subject], blockChangePacket(blockPosI, litBlock))
+	}
+	return nil
+}
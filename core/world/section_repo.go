@@ -2,34 +2,229 @@ package world
 
 import (
 	"database/sql"
+	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"go.uber.org/zap"
 
+	"github.com/alexykot/cncraft/core/db"
+	"github.com/alexykot/cncraft/core/db/orm"
+	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/level"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
-// SectionRepo loads sections from persistence and handles saving world block updates back into persistence
+// SectionRepo loads sections from persistence and handles saving world block updates back into persistence.
+// Sections are stored per world and per dimension, so the repo must be bound to a dimension using forDimension()
+// before being used for loading or saving sections.
 type SectionRepo struct {
 	log *zap.Logger
-	db  *sql.DB
+	db  boil.ContextExecutor
+
+	worldID     uuid.UUID
+	dimensionID uuid.UUID
+}
+
+func newRepo(log *zap.Logger, db boil.ContextExecutor, worldID uuid.UUID) *SectionRepo {
+	return &SectionRepo{log: log, db: db, worldID: worldID}
 }
 
-func newRepo(log *zap.Logger, db *sql.DB) *SectionRepo {
-	return &SectionRepo{log, db}
+// forDimension provides a copy of the repo bound to the given dimension.
+func (r SectionRepo) forDimension(dimensionID uuid.UUID) SectionRepo {
+	r.dimensionID = dimensionID
+	return r
 }
 
-// LoadSection - TODO implement this properly when implementing actual world persistence
+// LoadSection loads the section from persistence. Sections that were never saved are loaded from the
+// default flatworld template.
 func (r SectionRepo) LoadSection(x, z int64, index uint8) (level.Section, error) {
-	return loadDefaultSection(index), nil
+	dbSection, err := orm.FindSection(db.Ctx(), r.db, r.worldID, r.dimensionID, chunkIndex(x), chunkIndex(z), int16(index))
+	if err == sql.ErrNoRows {
+		return DefaultSection(index), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to query section %d of chunk %d.%d: %w", index, x, z, err)
+	}
+
+	return unpackSection(dbSection)
 }
 
-// SaveSection - TODO implement this when implementing actual world persistence
-func (r SectionRepo) SaveSection(section level.Section) error {
+// LoadWorldSections loads all persisted sections of the world across all of its dimensions and passes them to
+// the handler one by one. Meant for tooling, the server itself loads sections per chunk.
+func LoadWorldSections(conn *sql.DB, worldID uuid.UUID,
+	handler func(dimensionID uuid.UUID, x, z int64, section level.Section) error) error {
+	dbSections, err := orm.Sections(orm.SectionWhere.WorldID.EQ(worldID)).All(db.Ctx(), conn)
+	if err != nil {
+		return fmt.Errorf("failed to query sections of world %s: %w", worldID, err)
+	}
+
+	for _, dbSection := range dbSections {
+		section, err := unpackSection(dbSection)
+		if err != nil {
+			return err
+		}
+		if err := handler(dbSection.DimensionID, dbSection.ChunkX*level.ChunkX, dbSection.ChunkZ*level.ChunkZ, section); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func loadDefaultSection(index uint8) level.Section {
+// SaveSection saves the current state of the section, replacing the previously saved state if there is one.
+func (r SectionRepo) SaveSection(x, z int64, section level.Section) error {
+	bpb, palette, blockData, err := section.Compact()
+	if err != nil {
+		return fmt.Errorf("failed to compact section %d of chunk %d.%d: %w", section.Index(), x, z, err)
+	}
+
+	dbSection := &orm.Section{
+		WorldID:      r.worldID,
+		DimensionID:  r.dimensionID,
+		ChunkX:       chunkIndex(x),
+		ChunkZ:       chunkIndex(z),
+		SectionIndex: int16(section.Index()),
+		BitsPerBlock: int16(bpb),
+		Palette:      make(types.Int64Array, len(palette), len(palette)),
+		BlockData:    make(types.Int64Array, len(blockData), len(blockData)),
+	}
+	for i, blockID := range palette {
+		dbSection.Palette[i] = int64(blockID)
+	}
+	for i, long := range blockData {
+		dbSection.BlockData[i] = int64(long)
+	}
+
+	conflictColumns := []string{
+		orm.SectionColumns.WorldID,
+		orm.SectionColumns.DimensionID,
+		orm.SectionColumns.ChunkX,
+		orm.SectionColumns.ChunkZ,
+		orm.SectionColumns.SectionIndex,
+	}
+	updateColumns := boil.Whitelist(
+		orm.SectionColumns.BitsPerBlock,
+		orm.SectionColumns.Palette,
+		orm.SectionColumns.BlockData,
+		orm.SectionColumns.UpdatedAt,
+	)
+
+	if err := dbSection.Upsert(db.Ctx(), r.db, true, conflictColumns, updateColumns, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to upsert section %d of chunk %d.%d: %w", section.Index(), x, z, err)
+	}
+	return nil
+}
+
+// LoadBlockEntities loads all persisted block entities of the chunk.
+func (r SectionRepo) LoadBlockEntities(x, z int64) ([]level.BlockEntity, error) {
+	dbEntities, err := orm.BlockEntities(
+		orm.BlockEntityWhere.WorldID.EQ(r.worldID),
+		orm.BlockEntityWhere.DimensionID.EQ(r.dimensionID),
+		orm.BlockEntityWhere.ChunkX.EQ(chunkIndex(x)),
+		orm.BlockEntityWhere.ChunkZ.EQ(chunkIndex(z)),
+	).All(db.Ctx(), r.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query block entities of chunk %d.%d: %w", x, z, err)
+	}
+
+	entities := make([]level.BlockEntity, 0, len(dbEntities))
+	for _, dbEntity := range dbEntities {
+		pos := data.PositionI{X: dbEntity.X, Y: dbEntity.Y, Z: dbEntity.Z}
+		entity, err := level.UnmarshalBlockEntity(level.BlockEntityType(dbEntity.EntityType), pos, dbEntity.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack block entity %s of chunk %d.%d: %w", pos, x, z, err)
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// SaveBlockEntity saves the current state of the block entity, replacing the previously saved state if there is one.
+// Expects the entity lock to be held.
+func (r SectionRepo) SaveBlockEntity(x, z int64, entity level.BlockEntity) error {
+	entityData, err := level.MarshalBlockEntity(entity)
+	if err != nil {
+		return err
+	}
+
+	pos := entity.Position()
+	dbEntity := &orm.BlockEntity{
+		WorldID:     r.worldID,
+		DimensionID: r.dimensionID,
+		ChunkX:      chunkIndex(x),
+		ChunkZ:      chunkIndex(z),
+		X:           pos.X,
+		Y:           pos.Y,
+		Z:           pos.Z,
+		EntityType:  string(entity.Type()),
+		Data:        entityData,
+	}
+
+	conflictColumns := []string{
+		orm.BlockEntityColumns.WorldID,
+		orm.BlockEntityColumns.DimensionID,
+		orm.BlockEntityColumns.ChunkX,
+		orm.BlockEntityColumns.ChunkZ,
+		orm.BlockEntityColumns.X,
+		orm.BlockEntityColumns.Y,
+		orm.BlockEntityColumns.Z,
+	}
+	updateColumns := boil.Whitelist(
+		orm.BlockEntityColumns.EntityType,
+		orm.BlockEntityColumns.Data,
+		orm.BlockEntityColumns.UpdatedAt,
+	)
+
+	if err := dbEntity.Upsert(db.Ctx(), r.db, true, conflictColumns, updateColumns, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to upsert block entity %s of chunk %d.%d: %w", pos, x, z, err)
+	}
+	return nil
+}
+
+// DeleteBlockEntity deletes the persisted block entity at the given position, if there is one.
+func (r SectionRepo) DeleteBlockEntity(x, z int64, pos data.PositionI) error {
+	dbEntity, err := orm.FindBlockEntity(db.Ctx(), r.db, r.worldID, r.dimensionID, chunkIndex(x), chunkIndex(z), pos.X, pos.Y, pos.Z)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to query block entity %s of chunk %d.%d: %w", pos, x, z, err)
+	}
+
+	if _, err := dbEntity.Delete(db.Ctx(), r.db); err != nil {
+		return fmt.Errorf("failed to delete block entity %s of chunk %d.%d: %w", pos, x, z, err)
+	}
+	return nil
+}
+
+// chunkIndex converts the chunk block coordinate into the chunk coordinate the sections and block entities are
+// persisted with, i.e. the block coordinate divided by 16. Chunks in memory are identified by the block coordinates
+// of the corner, so the division is always exact.
+func chunkIndex(xz int64) int64 {
+	return xz / level.ChunkX
+}
+
+func unpackSection(dbSection *orm.Section) (level.Section, error) {
+	palette := make([]objects.BlockID, len(dbSection.Palette), len(dbSection.Palette))
+	for i, blockID := range dbSection.Palette {
+		palette[i] = objects.BlockID(blockID)
+	}
+
+	blockData := make([]uint64, len(dbSection.BlockData), len(dbSection.BlockData))
+	for i, long := range dbSection.BlockData {
+		blockData[i] = uint64(long)
+	}
+
+	section, err := level.NewCompactSection(uint8(dbSection.SectionIndex), uint8(dbSection.BitsPerBlock), palette, blockData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack section %d of chunk %d.%d: %w",
+			dbSection.SectionIndex, dbSection.ChunkX*level.ChunkX, dbSection.ChunkZ*level.ChunkZ, err)
+	}
+	return section, nil
+}
+
+// DefaultSection provides the section of the default flatworld template, the sections that were never saved
+// are loaded as these.
+func DefaultSection(index uint8) level.Section {
 	var blocks level.BlockArr
 	if index == 0 {
 		for z := 0; z < level.SectionZ; z++ {
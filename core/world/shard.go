@@ -25,17 +25,21 @@ type shard struct {
 	id       ShardID
 	log      *zap.Logger
 	ps       nats.PubSub
+	world    *World
 	dimID    uuid.UUID
 	chunkIDs []level.ChunkID // list of chunks in this shard
 	events   []*envelope.E   // current list of accumulated events waiting to be processed
 
+	saveInterval time.Duration // how often the changed chunks of this shard are saved into persistence
+	idleTimeout  time.Duration // how long the chunks of this shard stay loaded after their last use
+
 	tickHandlers map[string]events.TickHandler // mapping handler names to corresponding handler functions
 	// mapping shard events to corresponding handler names and functions. There can be multiple handlers for each
 	// event, but every individual handler can have only one function per event.
 	eventHandlers map[pb.OneOfEvent]map[string]events.EventHandler
 }
 
-func newShard(log *zap.Logger, ps nats.PubSub, id ShardID, dimID uuid.UUID, chunkIDs []level.ChunkID) (*shard, error) {
+func newShard(log *zap.Logger, ps nats.PubSub, id ShardID, dimID uuid.UUID, chunkIDs []level.ChunkID, saveInterval, idleTimeout time.Duration) (*shard, error) {
 	if len(chunkIDs) < 1 {
 		// not starting a shard if no chunks provided
 		return nil, fmt.Errorf("cannot instantiate shard with zero chunks; shard %s, dim %s", id.String(), dimID.String())
@@ -46,6 +50,8 @@ func newShard(log *zap.Logger, ps nats.PubSub, id ShardID, dimID uuid.UUID, chun
 		ps:            ps,
 		dimID:         dimID,
 		chunkIDs:      chunkIDs,
+		saveInterval:  saveInterval,
+		idleTimeout:   idleTimeout,
 		tickHandlers:  make(map[string]events.TickHandler),
 		eventHandlers: make(map[pb.OneOfEvent]map[string]events.EventHandler),
 	}, nil
@@ -53,8 +59,10 @@ func newShard(log *zap.Logger, ps nats.PubSub, id ShardID, dimID uuid.UUID, chun
 
 // dispatch initiates all handlers, subscribes to shard events channel and starts the event loop in a goroutine.
 // It's expected to be triggered only once for any given shard instance.
-func (s *shard) dispatch(ctx context.Context, roster players.Roster, failSignaller chan startMessage, world *World) error {
-	if err := s.initiateHandlers(s.chunkIDs, world, roster); err != nil {
+func (s *shard) dispatch(ctx context.Context, roster players.Roster, failSignaller chan startMessage, world *World, streamer *Streamer) error {
+	s.world = world
+
+	if err := s.initiateHandlers(s.chunkIDs, world, streamer, roster); err != nil {
 		return fmt.Errorf("failed to instantiate world processors: %w", err)
 	}
 
@@ -82,8 +90,10 @@ func (s *shard) incomingEventHandler(lope *envelope.E) {
 // runEventLoop runs infinite loop that will count and handle every tick. On every tick the events accumulated in the
 // s.events slice will be drained and pushed to all event handlers. Also event-independent tick handlers will be
 // triggered.
+// Changed chunks of the shard are saved into persistence every s.saveInterval, chunks idle for longer than
+// s.idleTimeout are unloaded at the same time.
 // The infinite loop considers the provided context and will stop whenever context is cancelled, i.e. when
-// server shutdown sequence is initiated.
+// server shutdown sequence is initiated. Changed chunks are saved one last time before stopping.
 // If the infinite loop is stopped for any reason (e.g. panic) - it will attempt to unsubscribe from
 // the incoming shard events channel and will dispatch a restart message to signalling channel.
 func (s *shard) runEventLoop(ctx context.Context, failSignaller chan startMessage) {
@@ -105,13 +115,20 @@ func (s *shard) runEventLoop(ctx context.Context, failSignaller chan startMessag
 	}()
 
 	ticker := time.NewTicker(game.TickSpeed)
+	saveTicker := time.NewTicker(s.saveInterval)
+	defer ticker.Stop()
+	defer saveTicker.Stop()
 
 	s.log.Debug("starting event loop")
 	for {
 		select {
 		case <-ctx.Done():
 			s.log.Info("stopping shard")
+			s.saveChunks()
 			return // trigger defer and make it return a message, error should be nil
+		case <-saveTicker.C:
+			s.saveChunks()
+			s.unloadIdleChunks()
 		case tickTime := <-ticker.C:
 			tick := game.Tick(tickTime.UnixNano()) // Round to milliseconds maybe?
 
@@ -123,6 +140,26 @@ func (s *shard) runEventLoop(ctx context.Context, failSignaller chan startMessag
 	}
 }
 
+// saveChunks saves changed chunks of this shard into persistence. Failure to save is not fatal for the shard, changed
+// chunks remain marked as such and saving will be reattempted on the next run.
+func (s *shard) saveChunks() {
+	if err := s.world.saveChunks(s.dimID, s.chunkIDs); err != nil {
+		s.log.Error("failed to save shard chunks", zap.Error(err))
+	}
+}
+
+// unloadIdleChunks unloads chunks of this shard not used for longer than s.idleTimeout. Failure to unload is not
+// fatal for the shard, unloading will be reattempted on the next run.
+func (s *shard) unloadIdleChunks() {
+	unloaded, err := s.world.unloadIdleChunks(s.dimID, s.chunkIDs, time.Now().Add(-s.idleTimeout))
+	if err != nil {
+		s.log.Error("failed to unload idle shard chunks", zap.Error(err))
+	}
+	if unloaded > 0 {
+		s.log.Debug("unloaded idle chunks", zap.Int("count", unloaded), zap.Int64("resident", s.world.ResidentChunks()))
+	}
+}
+
 // cutEvents returns a copy of the current outstanding events ready for handling and nullifies the s.events list.
 func (s *shard) cutEvents() []*envelope.E {
 	if len(s.events) == 0 {
@@ -154,17 +191,30 @@ func (s *shard) handleTick(tick game.Tick, tickEvents []*envelope.E) error {
 
 	for _, event := range tickEvents {
 		// Don't see a simpler better way to enumerate and find actual message inside a one-off type.
+		var eventType pb.OneOfEvent
 		if playerDigging := event.ShardEvent.GetPlayerDigging(); playerDigging != nil {
-			for name, eventHandler := range s.eventHandlers[pb.Event_PlayerDigging] {
-				userOutLopes, err := eventHandler(tick, event)
-				if err != nil {
-					return fmt.Errorf("failed to handle tick event `%s` in handler `%s` of shard `%s`: %w",
-						pb.Event_PlayerDigging, name, s.id, err)
-				}
-				for publishSubject, outLopes := range userOutLopes {
-					if err := s.ps.Publish(publishSubject, outLopes...); err != nil {
-						return fmt.Errorf("failed to publish message for subj `%s`, shard `%s`: %w", publishSubject, s.id, err)
-					}
+			eventType = pb.Event_PlayerDigging
+		} else if playerBlockPlacement := event.ShardEvent.GetPlayerBlockPlacement(); playerBlockPlacement != nil {
+			eventType = pb.Event_PlayerBlockPlacement
+		} else if playerDroppedItem := event.ShardEvent.GetPlayerDroppedItem(); playerDroppedItem != nil {
+			eventType = pb.Event_PlayerDroppedItem
+		} else if playerQueriedBlockNBT := event.ShardEvent.GetPlayerQueriedBlockNbt(); playerQueriedBlockNBT != nil {
+			eventType = pb.Event_PlayerQueriedBlockNbt
+		} else if playerUpdatedSign := event.ShardEvent.GetPlayerUpdatedSign(); playerUpdatedSign != nil {
+			eventType = pb.Event_PlayerUpdatedSign
+		} else {
+			continue
+		}
+
+		for name, eventHandler := range s.eventHandlers[eventType] {
+			userOutLopes, err := eventHandler(tick, event)
+			if err != nil {
+				return fmt.Errorf("failed to handle tick event `%s` in handler `%s` of shard `%s`: %w",
+					eventType, name, s.id, err)
+			}
+			for publishSubject, outLopes := range userOutLopes {
+				if err := s.ps.Publish(publishSubject, outLopes...); err != nil {
+					return fmt.Errorf("failed to publish message for subj `%s`, shard `%s`: %w", publishSubject, s.id, err)
 				}
 			}
 		}
@@ -174,21 +224,38 @@ func (s *shard) handleTick(tick game.Tick, tickEvents []*envelope.E) error {
 
 // initiateHandlers retrieves all available tick and event handlers and saves them with the shard.
 // This is expected to be run only once on shard creation.
-func (s *shard) initiateHandlers(chunkIDs []level.ChunkID, world *World, roster players.Roster) error {
+func (s *shard) initiateHandlers(chunkIDs []level.ChunkID, world *World, streamer *Streamer, roster players.Roster) error {
 	if len(s.tickHandlers) > 0 || len(s.eventHandlers) > 0 {
 		return fmt.Errorf("handlers already initiated for shard %s", s.id.String())
 	}
 
-	var err error
-	chunks := make([]level.Chunk, len(chunkIDs), len(chunkIDs))
-	for index, chunkID := range chunkIDs {
-		chunks[index], err = world.getChunk(s.dimID, chunkID)
-		if err != nil {
+	for _, chunkID := range chunkIDs {
+		if _, err := world.getChunk(s.dimID, chunkID); err != nil {
 			return fmt.Errorf("failed to retrieve chunk %s, dim %s: %w", chunkID, s.dimID, err)
 		}
 	}
 
-	for _, handler := range events.NewHandlers(chunks, roster) {
+	// Chunks are not loaded when the shard starts, handlers load them on first use.
+	loadChunk := func(chunkID level.ChunkID) (level.Chunk, error) {
+		return world.LoadChunk(s.dimID, chunkID)
+	}
+
+	// Ticking the chunks must not keep them loaded, so these are not marked as used.
+	loadedChunks := func() []level.Chunk {
+		var chunks []level.Chunk
+		for _, chunkID := range chunkIDs {
+			if chunk, err := world.getChunk(s.dimID, chunkID); err == nil && chunk.IsLoaded() {
+				chunks = append(chunks, chunk)
+			}
+		}
+		return chunks
+	}
+
+	viewers := func(chunkID level.ChunkID) []uuid.UUID {
+		return streamer.ChunkViewers(s.dimID, chunkID)
+	}
+
+	for _, handler := range events.NewHandlers(chunkIDs, loadChunk, loadedChunks, viewers, roster) {
 		if tickHandler := handler.GetTickHandler(); tickHandler != nil {
 			s.tickHandlers[handler.Name()] = tickHandler
 		}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -25,12 +26,15 @@ type Sharder struct {
 	log          *zap.Logger
 	ps           nats.PubSub
 
-	roster     players.Roster
-	world      *World
-	shardSizeX int64
-	shardSizeZ int64
-	shards     map[ShardID]*shard
-	isStopping bool
+	roster       players.Roster
+	world        *World
+	streamer     *Streamer
+	shardSizeX   int64
+	shardSizeZ   int64
+	saveInterval time.Duration
+	idleTimeout  time.Duration
+	shards       map[ShardID]*shard
+	isStopping   bool
 }
 
 // ShardID is formatted as `shard.<levelName>.<lowestX>.<lowestZ>`, e.g. `shard.Overworld.0.-160`.
@@ -47,7 +51,7 @@ type startMessage struct {
 	err         error
 }
 
-func NewSharder(log *zap.Logger, control chan control.Command, conf control.WorldConf, ps nats.PubSub, world *World, roster players.Roster) *Sharder {
+func NewSharder(log *zap.Logger, control chan control.Command, conf control.WorldConf, ps nats.PubSub, world *World, streamer *Streamer, roster players.Roster) *Sharder {
 	return &Sharder{
 		control:      control,
 		shardControl: make(chan startMessage),
@@ -56,7 +60,10 @@ func NewSharder(log *zap.Logger, control chan control.Command, conf control.Worl
 		roster:       roster,
 		shardSizeX:   int64(conf.ShardSize),
 		shardSizeZ:   int64(conf.ShardSize),
+		saveInterval: time.Duration(conf.SaveInterval) * time.Second,
+		idleTimeout:  time.Duration(conf.ChunkIdleTimeout) * time.Second,
 		world:        world,
+		streamer:     streamer,
 		shards:       make(map[ShardID]*shard),
 	}
 }
@@ -144,7 +151,7 @@ func (sh *Sharder) dispatchSharderLoop(ctx context.Context) {
 			sh.Lock()
 			if _, ok := sh.shards[shardStartMsg.id]; !ok {
 				var err error
-				sh.shards[shardStartMsg.id], err = newShard(sh.log, sh.ps, shardStartMsg.id, shardStartMsg.dimensionID, shardStartMsg.chunkIDs)
+				sh.shards[shardStartMsg.id], err = newShard(sh.log, sh.ps, shardStartMsg.id, shardStartMsg.dimensionID, shardStartMsg.chunkIDs, sh.saveInterval, sh.idleTimeout)
 				if err != nil {
 					sh.log.Error("failed to instantiate shard, signalling shard failure", zap.Error(err))
 					sh.signal(control.FAILED, fmt.Errorf("failed to start shard %s: %w", shardStartMsg.id, err))
@@ -155,7 +162,7 @@ func (sh *Sharder) dispatchSharderLoop(ctx context.Context) {
 
 			sh.log.Debug("starting shard", zap.String("id", string(shardStartMsg.id)), zap.Int("chunks", len(shardStartMsg.chunkIDs)))
 
-			if err := sh.shards[shardStartMsg.id].dispatch(ctx, sh.roster, sh.shardControl, sh.world); err != nil {
+			if err := sh.shards[shardStartMsg.id].dispatch(ctx, sh.roster, sh.shardControl, sh.world, sh.streamer); err != nil {
 				sh.log.Error("failed to restart shard, signalling shard failure", zap.Error(err))
 				sh.signal(control.FAILED, fmt.Errorf("failed to restart shard %s: %w", shardStartMsg.id, err))
 			}
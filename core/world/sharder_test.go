@@ -879,7 +879,7 @@ func mkMocks(t *testing.T) (*gomock.Controller, *psMocks.MockPubSub, *plMocks.Mo
 }
 
 func mkSharder(t *testing.T, world *World, ps nats.PubSub, roster players.Roster) *Sharder {
-	return NewSharder(log.MustGetTestNamed(t.Name()), make(chan control.Command), control.WorldConf{ShardSize: 3}, ps, world, roster)
+	return NewSharder(log.MustGetTestNamed(t.Name()), make(chan control.Command), control.WorldConf{ShardSize: 3, SaveInterval: 60}, ps, world, nil, roster)
 }
 
 // startSharder dispatches shard start routine and blocks until it's finished and Sharder reports READY.
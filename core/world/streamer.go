@@ -0,0 +1,432 @@
+package world
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/control"
+	"github.com/alexykot/cncraft/core/nats"
+	"github.com/alexykot/cncraft/core/nats/subj"
+	"github.com/alexykot/cncraft/core/players"
+	"github.com/alexykot/cncraft/pkg/envelope"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol"
+)
+
+// chunksPerTick - maximum number of chunks streamed to each player per tick, so that players moving fast do not
+// saturate their connections or stall the loading of chunks for everybody else.
+const chunksPerTick = 4
+
+// minViewDistance - view distance below this would leave the client without the chunks it needs to move around.
+const minViewDistance = 2
+
+// Streamer keeps every player supplied with the chunks within their view distance. Chunks coming into view as
+// players move are streamed to them rate limited per tick, and chunks falling out of view are unloaded on the client.
+type Streamer struct {
+	sync.Mutex
+
+	log     *zap.Logger
+	control chan control.Command
+	ps      nats.PubSub
+	roster  players.Roster
+	world   *World
+
+	maxViewDistance int32
+	views           map[uuid.UUID]*playerView // views of all players in the world, by player ID
+}
+
+// playerView holds the chunks sent to a single player.
+type playerView struct {
+	connID       uuid.UUID
+	dimensionID  uuid.UUID
+	centerX      int64 // block coordinates of the chunk the player is in
+	centerZ      int64
+	viewDistance int32
+
+	sent      map[level.ChunkID]bool
+	streaming map[level.ChunkID]bool // chunks taken from pending, being loaded for sending
+	pending   []level.ChunkID        // chunks in view but not sent yet, nearest first
+}
+
+func NewStreamer(log *zap.Logger, control chan control.Command, conf control.WorldConf, ps nats.PubSub, world *World, roster players.Roster) *Streamer {
+	return &Streamer{
+		log:             log,
+		control:         control,
+		ps:              ps,
+		roster:          roster,
+		world:           world,
+		maxViewDistance: conf.MaxViewDistance,
+		views:           make(map[uuid.UUID]*playerView),
+	}
+}
+
+func (s *Streamer) Start(ctx context.Context) {
+	if err := s.ps.Subscribe(subj.MkPlayerSpatialUpdate(), s.spatialHandler); err != nil {
+		s.signal(control.FAILED, fmt.Errorf("failed to subscribe for player spatial updates: %w", err))
+		return
+	}
+	if err := s.ps.Subscribe(subj.MkPlayerLeft(), s.playerLeftHandler); err != nil {
+		s.signal(control.FAILED, fmt.Errorf("failed to subscribe for leaving players: %w", err))
+		return
+	}
+
+	go s.tick(ctx)
+	s.signal(control.READY, nil)
+	s.log.Info("chunk streamer started")
+}
+
+// ViewDistance provides the view distance used for the player, which is the client view distance clamped
+// to the server limits.
+func (s *Streamer) ViewDistance(requested int32) int32 {
+	if requested > s.maxViewDistance {
+		return s.maxViewDistance
+	}
+	if requested < minViewDistance {
+		return minViewDistance
+	}
+	return requested
+}
+
+// ChunkViewers provides connection IDs of the players that have the given chunk loaded.
+func (s *Streamer) ChunkViewers(dimensionID uuid.UUID, chunkID level.ChunkID) []uuid.UUID {
+	s.Lock()
+	defer s.Unlock()
+
+	var connIDs []uuid.UUID
+	for _, view := range s.views {
+		if view.dimensionID == dimensionID && view.sent[chunkID] {
+			connIDs = append(connIDs, view.connID)
+		}
+	}
+	return connIDs
+}
+
+// MoveView moves the view of the player to the given position and provides packets that unload the chunks out of
+// view and send all visible chunks at once, without rate limiting. This is used when the player appears at the new
+// position, i.e. on join and teleport. Set respawned if the client has dropped all chunks, i.e. on join and
+// dimension change.
+func (s *Streamer) MoveView(p *players.Player, dimensionID uuid.UUID, pos data.PositionF, respawned bool) ([]*envelope.E, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	view, ok := s.views[p.ID]
+	if !ok || respawned || view.dimensionID != dimensionID {
+		if ok {
+			s.dropView(view)
+		}
+		view = &playerView{
+			connID:      p.ConnID,
+			dimensionID: dimensionID,
+			sent:        make(map[level.ChunkID]bool),
+			streaming:   make(map[level.ChunkID]bool),
+		}
+		s.views[p.ID] = view
+	}
+
+	outLopes, err := s.recenter(view, pos, p.GetSettings().ViewDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	// chunks failing to load are left pending, so that they are streamed later
+	var failed []level.ChunkID
+	for _, chunkID := range view.pending {
+		chunkLopes, err := s.mkChunkLopes(dimensionID, chunkID)
+		if err != nil {
+			s.log.Error("failed to send chunk", zap.String("chunk", chunkID.String()), zap.Error(err))
+			failed = append(failed, chunkID)
+			continue
+		}
+		outLopes = append(outLopes, chunkLopes...)
+		s.markSent(view, chunkID)
+	}
+	view.pending = failed
+
+	return outLopes, nil
+}
+
+// spatialHandler follows player movements and recenters their views whenever they move into another chunk.
+// Newly visible chunks are queued for streaming, and chunks out of view are unloaded right away.
+func (s *Streamer) spatialHandler(lope *envelope.E) {
+	spatial := lope.GetPlayerSpatial()
+	if spatial == nil {
+		s.log.Error("failed to parse envelope - no PlayerSpatialUpdate inside", zap.Any("envelope", lope))
+		return
+	}
+
+	playerID, err := uuid.Parse(spatial.PlayerId)
+	if err != nil {
+		s.log.Error("failed to parse player ID as UUID", zap.String("id", spatial.PlayerId), zap.Error(err))
+		return
+	}
+	p, ok := s.roster.GetPlayerByID(playerID)
+	if !ok {
+		return
+	}
+
+	s.Lock()
+	view, ok := s.views[playerID]
+	if !ok || view.dimensionID.String() != spatial.DimensionId {
+		// Not joined yet, or the update is from before the dimension change. Views are created and moved
+		// across dimensions only by MoveView.
+		s.Unlock()
+		return
+	}
+
+	outLopes, err := s.recenter(view, data.PositionFFromPb(spatial.Pos), p.GetSettings().ViewDistance)
+	connID := view.connID
+	s.Unlock()
+
+	if err != nil {
+		s.log.Error("failed to recenter player view", zap.String("player", spatial.PlayerId), zap.Error(err))
+		return
+	}
+	if len(outLopes) == 0 {
+		return
+	}
+
+	if err := s.ps.Publish(subj.MkConnTransmit(connID), outLopes...); err != nil {
+		s.log.Error("failed to publish conn.transmit message", zap.Error(err), zap.Any("conn", connID))
+	}
+}
+
+func (s *Streamer) playerLeftHandler(lope *envelope.E) {
+	left := lope.GetPlayerLeft()
+	if left == nil {
+		s.log.Error("failed to parse envelope - no PlayerLeft inside", zap.Any("envelope", lope))
+		return
+	}
+
+	playerID, err := uuid.Parse(left.PlayerId)
+	if err != nil {
+		s.log.Error("failed to parse player ID as UUID", zap.String("id", left.PlayerId), zap.Error(err))
+		return
+	}
+
+	s.Lock()
+	if view, ok := s.views[playerID]; ok {
+		s.dropView(view)
+		delete(s.views, playerID)
+	}
+	s.Unlock()
+}
+
+// recenter moves the view to the chunk of the given position, if the player has moved into another chunk or changed
+// their view distance. Provides the view position update and unloads of the chunks that fell out of view, and
+// replaces the pending chunks with the visible chunks not sent yet. Chunks being streamed that fell out of view are
+// dropped once loaded. Must be called with the streamer locked.
+func (s *Streamer) recenter(view *playerView, pos data.PositionF, requestedDistance int32) ([]*envelope.E, error) {
+	centerX, centerZ := ViewCenter(pos)
+	viewDistance := s.ViewDistance(requestedDistance)
+	if len(view.sent) > 0 && centerX == view.centerX && centerZ == view.centerZ && viewDistance == view.viewDistance {
+		return nil, nil
+	}
+
+	inView, err := s.world.ChunksInView(view.dimensionID, pos, viewDistance)
+	if err != nil {
+		return nil, err
+	}
+
+	var outLopes []*envelope.E
+	if centerX != view.centerX || centerZ != view.centerZ || len(view.sent) == 0 {
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CUpdateViewPosition)
+		viewPosition := cpacket.(*protocol.CPacketUpdateViewPosition)
+		viewPosition.ChunkX = int32(centerX / level.ChunkX)
+		viewPosition.ChunkZ = int32(centerZ / level.ChunkZ)
+		outLopes = append(outLopes, envelope.MkCpacketEnvelope(viewPosition))
+	}
+	view.centerX, view.centerZ, view.viewDistance = centerX, centerZ, viewDistance
+
+	visible := make(map[level.ChunkID]bool, len(inView))
+	view.pending = nil
+	for _, chunkID := range inView {
+		visible[chunkID] = true
+		if !view.sent[chunkID] && !view.streaming[chunkID] {
+			view.pending = append(view.pending, chunkID)
+		}
+	}
+
+	for chunkID := range view.streaming {
+		if !visible[chunkID] {
+			delete(view.streaming, chunkID)
+		}
+	}
+
+	for chunkID := range view.sent {
+		if visible[chunkID] {
+			continue
+		}
+
+		x, z := level.XZFromChunkID(chunkID)
+		cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CUnloadChunk)
+		unload := cpacket.(*protocol.CPacketUnloadChunk)
+		unload.ChunkX = int32(x / level.ChunkX)
+		unload.ChunkZ = int32(z / level.ChunkZ)
+		outLopes = append(outLopes, envelope.MkCpacketEnvelope(unload))
+		s.unmarkSent(view, chunkID)
+	}
+
+	return outLopes, nil
+}
+
+func (s *Streamer) tick(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.signal(control.FAILED, fmt.Errorf("chunk streamer panicked: %v", r))
+		}
+	}()
+
+	ticker := time.NewTicker(game.TickSpeed)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.signal(control.STOPPED, nil)
+			return
+		case <-ticker.C:
+			s.streamPending()
+		}
+	}
+}
+
+// streamPending sends up to chunksPerTick pending chunks to every player. Chunks are marked sent only once their
+// packets are built, and the chunks that failed to load or to be published are queued again.
+func (s *Streamer) streamPending() {
+	type batch struct {
+		playerID uuid.UUID
+		view     *playerView
+		chunkIDs []level.ChunkID
+	}
+
+	var batches []batch
+	s.Lock()
+	for playerID, view := range s.views {
+		if len(view.pending) == 0 {
+			continue
+		}
+
+		count := chunksPerTick
+		if count > len(view.pending) {
+			count = len(view.pending)
+		}
+		chunkIDs := view.pending[:count]
+		view.pending = view.pending[count:]
+		for _, chunkID := range chunkIDs {
+			view.streaming[chunkID] = true
+		}
+		batches = append(batches, batch{playerID: playerID, view: view, chunkIDs: chunkIDs})
+	}
+	s.Unlock()
+
+	// Chunks may need to be loaded from persistence, so this is done outside of the lock.
+	for _, b := range batches {
+		chunkLopes := make(map[level.ChunkID][]*envelope.E, len(b.chunkIDs))
+		for _, chunkID := range b.chunkIDs {
+			lopes, err := s.mkChunkLopes(b.view.dimensionID, chunkID)
+			if err != nil {
+				s.log.Error("failed to stream chunk", zap.String("chunk", chunkID.String()), zap.Error(err))
+				continue
+			}
+			chunkLopes[chunkID] = lopes
+		}
+
+		var outLopes []*envelope.E
+		var sent []level.ChunkID
+		s.Lock()
+		isCurrent := s.views[b.playerID] == b.view // the view is replaced on respawn, or dropped when the player leaves
+		for _, chunkID := range b.chunkIDs {
+			if !b.view.streaming[chunkID] || !isCurrent {
+				continue // fell out of view while loading
+			}
+			delete(b.view.streaming, chunkID)
+
+			lopes, ok := chunkLopes[chunkID]
+			if !ok {
+				b.view.pending = append(b.view.pending, chunkID)
+				continue
+			}
+			outLopes = append(outLopes, lopes...)
+			sent = append(sent, chunkID)
+			s.markSent(b.view, chunkID)
+		}
+		s.Unlock()
+
+		if len(outLopes) == 0 {
+			continue
+		}
+
+		if err := s.ps.Publish(subj.MkConnTransmit(b.view.connID), outLopes...); err != nil {
+			s.log.Error("failed to publish conn.transmit message", zap.Error(err), zap.Any("conn", b.view.connID))
+
+			s.Lock()
+			if s.views[b.playerID] == b.view {
+				for _, chunkID := range sent {
+					if b.view.sent[chunkID] {
+						s.unmarkSent(b.view, chunkID)
+						b.view.pending = append(b.view.pending, chunkID)
+					}
+				}
+			}
+			s.Unlock()
+		}
+	}
+}
+
+// mkChunkLopes provides the chunk light and data packets, light goes first so that the chunk is rendered lit.
+func (s *Streamer) mkChunkLopes(dimensionID uuid.UUID, chunkID level.ChunkID) ([]*envelope.E, error) {
+	chunk, err := s.world.LoadChunk(dimensionID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+
+	cpacket, _ := protocol.GetPacketFactory().MakeCPacket(protocol.CUpdateLight)
+	updateLight := cpacket.(*protocol.CPacketUpdateLight)
+	updateLight.Chunk = chunk
+
+	cpacket, _ = protocol.GetPacketFactory().MakeCPacket(protocol.CChunkData)
+	chunkData := cpacket.(*protocol.CPacketChunkData)
+	chunkData.Chunk = chunk
+	return []*envelope.E{envelope.MkCpacketEnvelope(updateLight), envelope.MkCpacketEnvelope(chunkData)}, nil
+}
+
+// markSent marks the chunk as sent to the player. The world keeps the chunks players have in view loaded.
+// Must be called with the streamer locked.
+func (s *Streamer) markSent(view *playerView, chunkID level.ChunkID) {
+	if view.sent[chunkID] {
+		return
+	}
+	view.sent[chunkID] = true
+	s.world.viewChunk(view.dimensionID, chunkID)
+}
+
+// unmarkSent marks the chunk as no longer in the player view. Must be called with the streamer locked.
+func (s *Streamer) unmarkSent(view *playerView, chunkID level.ChunkID) {
+	if !view.sent[chunkID] {
+		return
+	}
+	delete(view.sent, chunkID)
+	s.world.unviewChunk(view.dimensionID, chunkID)
+}
+
+// dropView marks all chunks of the view as no longer viewed. Must be called with the streamer locked.
+func (s *Streamer) dropView(view *playerView) {
+	for chunkID := range view.sent {
+		s.unmarkSent(view, chunkID)
+	}
+}
+
+func (s *Streamer) signal(state control.ComponentState, err error) {
+	s.control <- control.Command{
+		Signal:    control.COMPONENT,
+		Component: control.STREAMER,
+		State:     state,
+		Err:       err,
+	}
+}
@@ -0,0 +1,83 @@
+package world
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/core/control"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/log"
+)
+
+func TestViewDistance(t *testing.T) {
+	streamer := NewStreamer(log.MustGetTestNamed(t.Name()), nil, control.WorldConf{MaxViewDistance: 10}, nil, getTestWorld(), nil)
+
+	assert.Equal(t, int32(minViewDistance), streamer.ViewDistance(0))
+	assert.Equal(t, int32(7), streamer.ViewDistance(7))
+	assert.Equal(t, int32(10), streamer.ViewDistance(32))
+}
+
+func TestChunksInView(t *testing.T) {
+	world := getTestWorld() // overworld is 7x7 chunks, -48 to 48 on both axes
+
+	t.Run("nearest_first", func(t *testing.T) {
+		chunkIDs, err := world.ChunksInView(DimensionID(game.Overworld), data.PositionF{X: 0.5, Z: 0.5}, 1)
+		require.NoError(t, err)
+		require.Len(t, chunkIDs, 9)
+		assert.Equal(t, level.MkChunkID(0, 0), chunkIDs[0])
+		assert.ElementsMatch(t, []level.ChunkID{
+			ch(-16, -16), ch(-16, 0), ch(-16, 16),
+			ch(0, -16), ch(0, 0), ch(0, 16),
+			ch(16, -16), ch(16, 0), ch(16, 16),
+		}, chunkIDs)
+	})
+
+	t.Run("clipped_by_edges", func(t *testing.T) {
+		chunkIDs, err := world.ChunksInView(DimensionID(game.Overworld), data.PositionF{X: 50, Z: -40}, 1)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []level.ChunkID{ch(32, -48), ch(48, -48), ch(32, -32), ch(48, -32)}, chunkIDs)
+	})
+
+	t.Run("unknown_dimension", func(t *testing.T) {
+		_, err := world.ChunksInView(uuid.New(), data.PositionF{}, 1)
+		assert.Error(t, err)
+	})
+}
+
+func TestRecenter(t *testing.T) {
+	streamer := NewStreamer(log.MustGetTestNamed(t.Name()), nil, control.WorldConf{MaxViewDistance: 10}, nil, getTestWorld(), nil)
+	view := &playerView{dimensionID: DimensionID(game.Overworld), sent: make(map[level.ChunkID]bool)}
+
+	outLopes, err := streamer.recenter(view, data.PositionF{X: 0.5, Z: 0.5}, 2)
+	require.NoError(t, err)
+	assert.Len(t, outLopes, 1, "view position update expected")
+	assert.Len(t, view.pending, 25)
+	for _, chunkID := range view.pending {
+		view.sent[chunkID] = true
+	}
+	view.pending = nil
+
+	t.Run("same_chunk", func(t *testing.T) {
+		outLopes, err := streamer.recenter(view, data.PositionF{X: 15.5, Z: 3}, 2)
+		require.NoError(t, err)
+		assert.Empty(t, outLopes)
+		assert.Empty(t, view.pending)
+	})
+
+	t.Run("next_chunk", func(t *testing.T) {
+		outLopes, err := streamer.recenter(view, data.PositionF{X: 16.5, Z: 0.5}, 2)
+		require.NoError(t, err)
+		assert.Len(t, outLopes, 6, "view position update and 5 chunk unloads expected")
+		assert.Len(t, view.pending, 5)
+		assert.Len(t, view.sent, 20)
+		for _, chunkID := range view.pending {
+			x, _ := level.XZFromChunkID(chunkID)
+			assert.Equal(t, int64(48), x)
+		}
+	})
+}
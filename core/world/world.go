@@ -1,18 +1,20 @@
 package world
 
 import (
-	"context"
 	"crypto/sha256"
 	"database/sql"
-	"encoding/binary"
 	"fmt"
-	"math/rand"
+	"math"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/alexykot/cncraft/core/control"
 	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/level"
 	"github.com/alexykot/cncraft/pkg/protocol/tags"
 )
@@ -23,6 +25,7 @@ import (
 
 // World holds details of the current world.
 type World struct {
+	ID       uuid.UUID
 	Name     string
 	Seed     []byte
 	SeedHash [32]byte
@@ -33,77 +36,171 @@ type World struct {
 	Difficulty         game.Difficulty
 	DifficultyIsLocked bool
 
-	// TODO not clear where this should be saved and come from. And what it does.
-	//  Maybe it should be hardcoded as server defaults and not saved with the world at all.
+	// Codec is chosen per world by name from the codecs known to the server, and the dimension type of each
+	// dimension is looked up in it by the type name persisted with the dimension.
 	NBTDimensionCodec tags.DimensionCodec
-	NBTDimension      tags.Dimension
+	NBTDimension      tags.Dimension // dimension type of the start dimension
 
 	StartDimension uuid.UUID
 	Dimensions     map[uuid.UUID]level.Dimension
+	DimensionTypes map[uuid.UUID]DimensionType
+
+	// Chunks are loaded on demand, resident maps loaded chunks of each dimension to the time they were last used.
+	// Chunks that players have in view are never unloaded, viewers counts the players viewing each chunk.
+	// Chunks are loaded and unloaded without holding the lock, inFlight marks the chunks being loaded or unloaded,
+	// the channel is closed once done.
+	resident      map[uuid.UUID]map[level.ChunkID]time.Time
+	viewers       map[uuid.UUID]map[level.ChunkID]int
+	inFlight      map[chunkKey]chan struct{}
+	residentCount int64
+	residentMu    sync.Mutex
 
 	repo *SectionRepo
 	log  *zap.Logger
 }
 
-// NewWorld - creates world from persisted settigns. Does NOT load world data.
-func NewWorld(log *zap.Logger, _ control.WorldConf, db *sql.DB) (*World, error) {
-	world := GetDefaultWorld() // TODO load world starting settings from persistence.
+// chunkKey identifies the chunk across all dimensions.
+type chunkKey struct {
+	dimensionID uuid.UUID
+	chunkID     level.ChunkID
+}
 
-	world.log = log
-	world.repo = newRepo(log, db)
+// DimensionType is the dimension type of a world dimension as found in the dimension codec.
+type DimensionType struct {
+	Name string // type name, also used as the world name identifying the dimension on the client
+	NBT  tags.Dimension
+}
 
-	return world, nil
+// VanillaCodec is the name of the dimension codec holding vanilla dimension types.
+const VanillaCodec = "vanilla"
+
+var dimensionCodecs = map[string]tags.DimensionCodec{
+	VanillaCodec: vanillaDimentionsCodec,
 }
 
-// TODO replace this with actual world loading from persistence
-var defaultWorld *World
-
-func GetDefaultWorld() *World {
-	if defaultWorld == nil {
-		defaultWorld = &World{
-			Name:               "Default World",
-			Coreness:           game.Softcore,
-			Type:               game.WorldFlat,
-			Gamemode:           game.Survival,
-			Difficulty:         game.Peaceful,
-			DifficultyIsLocked: true,
-			Seed:               make([]byte, 4, 4),
-			StartDimension:     uuid.NewSHA1(uuid.UUID{}, []byte(game.Overworld.String())),
-			Dimensions:         make(map[uuid.UUID]level.Dimension),
-			NBTDimensionCodec:  vanillaDimentionsCodec,
-			NBTDimension:       vanillaDimentionsCodec.Dimensions.RegistryEntries[0].Element,
+// NewWorld - creates world from persisted settings. Does NOT load world data.
+func NewWorld(log *zap.Logger, conf control.WorldConf, db *sql.DB) (*World, error) {
+	settings, err := LoadSettings(db, conf.WorldID)
+	if err == sql.ErrNoRows && conf.CreateMissing {
+		settings = DefaultSettings("World")
+		settings.ID = conf.WorldID
+		if err := CreateWorld(db, settings); err != nil {
+			return nil, fmt.Errorf("failed to create missing world %s: %w", conf.WorldID.String(), err)
 		}
-		binary.LittleEndian.PutUint32(defaultWorld.Seed, rand.Uint32())
-		defaultWorld.SeedHash = sha256.Sum256(defaultWorld.Seed)
+		log.Info("created missing world", zap.String("world", conf.WorldID.String()))
+	} else if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("world %s not found", conf.WorldID.String())
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to load world %s settings: %w", conf.WorldID.String(), err)
+	}
 
-		defaultWorld.Dimensions[defaultWorld.StartDimension] = level.NewDimension(game.Overworld.String())
+	world, err := newWorld(log, settings)
+	if err != nil {
+		return nil, err
 	}
+	world.repo = newRepo(log, db, conf.WorldID)
 
-	return defaultWorld
+	return world, nil
 }
 
-func (w *World) Load(_ context.Context, ctrlChan chan control.Command) {
-	for name, worldDim := range w.Dimensions {
-		w.log.Debug(fmt.Sprintf("loading level %s", name))
+func newWorld(log *zap.Logger, settings Settings) (*World, error) {
+	codec, ok := dimensionCodecs[settings.DimensionCodec]
+	if !ok {
+		return nil, fmt.Errorf("unknown dimension codec `%s`", settings.DimensionCodec)
+	}
+
+	world := &World{
+		ID:                 settings.ID,
+		Name:               settings.Name,
+		Seed:               settings.Seed,
+		SeedHash:           sha256.Sum256(settings.Seed),
+		Coreness:           settings.Coreness,
+		Gamemode:           settings.Gamemode,
+		Type:               settings.Type,
+		Difficulty:         settings.Difficulty,
+		DifficultyIsLocked: settings.DifficultyIsLocked,
+		NBTDimensionCodec:  codec,
+		StartDimension:     settings.StartDimension,
+		Dimensions:         make(map[uuid.UUID]level.Dimension),
+		DimensionTypes:     make(map[uuid.UUID]DimensionType),
+		resident:           make(map[uuid.UUID]map[level.ChunkID]time.Time),
+		viewers:            make(map[uuid.UUID]map[level.ChunkID]int),
+		inFlight:           make(map[chunkKey]chan struct{}),
+		log:                log,
+	}
 
-		chunks := worldDim.Chunks()
-		for _, chunk := range chunks {
-			if err := chunk.Load(w.repo); err != nil {
-				// World does not have any async loops, so does not need to signal readiness, it's ready as soon
-				// as it's loaded, and has no internal components that would need to be stopped.
-				// But it can fail while loading and that needs to be signalled.
-				ctrlChan <- control.Command{
-					Signal:    control.COMPONENT,
-					Component: control.WORLD,
-					State:     control.FAILED,
-					Err:       fmt.Errorf("failed to load world: failed to load chunk %s: %w", chunk.ID(), err),
-				}
-				return
+	for _, dim := range settings.Dimensions {
+		var found bool
+		for _, entry := range codec.Dimensions.RegistryEntries {
+			if entry.Name == dim.Type {
+				world.DimensionTypes[dim.ID] = DimensionType{Name: entry.Name, NBT: entry.Element}
+				found = true
+				break
 			}
 		}
+		if !found {
+			return nil, fmt.Errorf("dimension type `%s` not found in dimension codec `%s`", dim.Type, settings.DimensionCodec)
+		}
+
+		hasSkylight := world.DimensionTypes[dim.ID].NBT.HasSkylight != 0
+		world.Dimensions[dim.ID] = level.NewDimension(dim.Name, dim.Edges, hasSkylight)
+	}
+
+	startType, ok := world.DimensionTypes[settings.StartDimension]
+	if !ok {
+		return nil, fmt.Errorf("start dimension %s not found in world %s", settings.StartDimension.String(), settings.ID.String())
+	}
+	world.NBTDimension = startType.NBT
+
+	return world, nil
+}
+
+// DimensionID provides the ID of the vanilla dimension, these IDs are the same in every world.
+func DimensionID(dimension game.Dimension) uuid.UUID {
+	return uuid.NewSHA1(uuid.UUID{}, []byte(dimension.String()))
+}
+
+// LoadChunk provides the chunk, loading it from persistence first if it is not resident in memory. Every call marks
+// the chunk as used, chunks not used for a while are unloaded by the shard owning them. Chunks are loaded without
+// holding the world lock, concurrent callers wait for the chunk load or unload in flight to finish.
+func (w *World) LoadChunk(dimensionID uuid.UUID, chunkID level.ChunkID) (level.Chunk, error) {
+	chunk, err := w.getChunk(dimensionID, chunkID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := chunkKey{dimensionID: dimensionID, chunkID: chunkID}
+	w.residentMu.Lock()
+	for done, ok := w.inFlight[key]; ok; done, ok = w.inFlight[key] {
+		w.residentMu.Unlock()
+		<-done
+		w.residentMu.Lock()
+	}
+
+	if !chunk.IsLoaded() {
+		done := make(chan struct{})
+		w.inFlight[key] = done
+		w.residentMu.Unlock()
+
+		err := chunk.Load(w.repo.forDimension(dimensionID))
+
+		w.residentMu.Lock()
+		delete(w.inFlight, key)
+		close(done)
+		if err != nil {
+			w.residentMu.Unlock()
+			return nil, fmt.Errorf("failed to load chunk %s: %w", chunkID, err)
+		}
+		w.residentCount++
 	}
+	defer w.residentMu.Unlock()
 
-	w.log.Info(fmt.Sprintf("world `%s` loaded", w.Name))
+	if w.resident[dimensionID] == nil {
+		w.resident[dimensionID] = make(map[level.ChunkID]time.Time)
+	}
+	w.resident[dimensionID][chunkID] = time.Now()
+
+	return chunk, nil
 }
 
 func (w *World) getChunk(dimensionID uuid.UUID, chunkID level.ChunkID) (level.Chunk, error) {
@@ -118,3 +215,142 @@ func (w *World) getChunk(dimensionID uuid.UUID, chunkID level.ChunkID) (level.Ch
 	}
 	return chunk, nil
 }
+
+// GetBlock provides the block at the given global block coordinates of the dimension.
+func (w *World) GetBlock(dimensionID uuid.UUID, p data.PositionI) (level.Block, error) {
+	chunk, err := w.LoadChunk(dimensionID, level.FindChunkID(p))
+	if err != nil {
+		return nil, err
+	}
+	return chunk.GetGlobalBlock(p)
+}
+
+// ChunksInView provides IDs of the dimension chunks within the view distance (in chunks) around the position,
+// nearest first. Chunks outside of the dimension edges are omitted.
+func (w *World) ChunksInView(dimensionID uuid.UUID, pos data.PositionF, viewDistance int32) ([]level.ChunkID, error) {
+	dim, ok := w.Dimensions[dimensionID]
+	if !ok {
+		return nil, fmt.Errorf("dimension %s not found", dimensionID.String())
+	}
+
+	centerX, centerZ := ViewCenter(pos)
+	distance := int64(viewDistance)
+
+	type inView struct {
+		id       level.ChunkID
+		distance int64
+	}
+	var chunks []inView
+	for dx := -distance; dx <= distance; dx++ {
+		for dz := -distance; dz <= distance; dz++ {
+			chunkID := level.MkChunkID(centerX+dx*level.ChunkX, centerZ+dz*level.ChunkZ)
+			if _, ok := dim.GetChunk(chunkID); ok {
+				chunks = append(chunks, inView{id: chunkID, distance: dx*dx + dz*dz})
+			}
+		}
+	}
+	sort.SliceStable(chunks, func(i, j int) bool { return chunks[i].distance < chunks[j].distance })
+
+	chunkIDs := make([]level.ChunkID, len(chunks), len(chunks))
+	for i, chunk := range chunks {
+		chunkIDs[i] = chunk.id
+	}
+	return chunkIDs, nil
+}
+
+// ViewCenter provides block coordinates of the chunk the position is in.
+func ViewCenter(pos data.PositionF) (x, z int64) {
+	return level.XZFromChunkID(level.FindChunkID(data.PositionI{X: int64(math.Floor(pos.X)), Z: int64(math.Floor(pos.Z))}))
+}
+
+// ResidentChunks provides the number of chunks currently loaded in memory across all dimensions.
+func (w *World) ResidentChunks() int64 {
+	w.residentMu.Lock()
+	defer w.residentMu.Unlock()
+
+	return w.residentCount
+}
+
+// viewChunk marks the chunk as viewed by one more player, chunks in view are never unloaded.
+func (w *World) viewChunk(dimensionID uuid.UUID, chunkID level.ChunkID) {
+	w.residentMu.Lock()
+	defer w.residentMu.Unlock()
+
+	if w.viewers[dimensionID] == nil {
+		w.viewers[dimensionID] = make(map[level.ChunkID]int)
+	}
+	w.viewers[dimensionID][chunkID]++
+}
+
+// unviewChunk marks the chunk as viewed by one player less. The chunk nobody views any more is idle from now on.
+func (w *World) unviewChunk(dimensionID uuid.UUID, chunkID level.ChunkID) {
+	w.residentMu.Lock()
+	defer w.residentMu.Unlock()
+
+	viewers := w.viewers[dimensionID]
+	if viewers[chunkID] > 1 {
+		viewers[chunkID]--
+		return
+	}
+	delete(viewers, chunkID)
+	if _, ok := w.resident[dimensionID][chunkID]; ok {
+		w.resident[dimensionID][chunkID] = time.Now()
+	}
+}
+
+// saveChunks saves all changed sections of the given chunks into persistence.
+func (w *World) saveChunks(dimensionID uuid.UUID, chunkIDs []level.ChunkID) error {
+	dimRepo := w.repo.forDimension(dimensionID)
+	for _, chunkID := range chunkIDs {
+		chunk, err := w.getChunk(dimensionID, chunkID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve chunk: %w", err)
+		}
+
+		if err := chunk.Save(dimRepo); err != nil {
+			return fmt.Errorf("failed to save chunk %s: %w", chunkID, err)
+		}
+	}
+	return nil
+}
+
+// unloadIdleChunks saves and unloads those of the given chunks that were not used since the given time.
+// Provides the number of chunks unloaded.
+func (w *World) unloadIdleChunks(dimensionID uuid.UUID, chunkIDs []level.ChunkID, idleSince time.Time) (int, error) {
+	var unloaded int
+	dimRepo := w.repo.forDimension(dimensionID)
+	for _, chunkID := range chunkIDs {
+		chunk, err := w.getChunk(dimensionID, chunkID)
+		if err != nil {
+			return unloaded, fmt.Errorf("failed to retrieve chunk: %w", err)
+		}
+
+		key := chunkKey{dimensionID: dimensionID, chunkID: chunkID}
+		w.residentMu.Lock()
+		lastUsed, ok := w.resident[dimensionID][chunkID]
+		if _, inFlight := w.inFlight[key]; inFlight || !ok || lastUsed.After(idleSince) || w.viewers[dimensionID][chunkID] > 0 {
+			w.residentMu.Unlock()
+			continue
+		}
+		done := make(chan struct{})
+		w.inFlight[key] = done
+		delete(w.resident[dimensionID], chunkID) // the chunk is not resident once the unload starts
+		w.residentMu.Unlock()
+
+		err = chunk.Unload(dimRepo)
+
+		w.residentMu.Lock()
+		delete(w.inFlight, key)
+		close(done)
+		if err != nil {
+			// the chunk is left loaded, it is idle still and the unload is retried the next time
+			w.resident[dimensionID][chunkID] = lastUsed
+			w.residentMu.Unlock()
+			return unloaded, fmt.Errorf("failed to unload chunk %s: %w", chunkID, err)
+		}
+		w.residentCount--
+		w.residentMu.Unlock()
+		unloaded++
+	}
+	return unloaded, nil
+}
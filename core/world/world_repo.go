@@ -0,0 +1,221 @@
+package world
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/core/db"
+	"github.com/alexykot/cncraft/core/db/orm"
+	"github.com/alexykot/cncraft/pkg/game"
+	"github.com/alexykot/cncraft/pkg/game/level"
+)
+
+// Settings holds persisted world settings, i.e. everything needed to set up the world before loading its chunks.
+type Settings struct {
+	ID   uuid.UUID
+	Name string
+	Seed []byte
+
+	Coreness           game.Coreness
+	Gamemode           game.Gamemode
+	Type               game.WorldType
+	Difficulty         game.Difficulty
+	DifficultyIsLocked bool
+
+	DimensionCodec string // name of the dimension codec sent to clients, only `vanilla` is supported
+	StartDimension uuid.UUID
+	Dimensions     []DimensionSettings
+}
+
+// DimensionSettings holds persisted settings of a single world dimension.
+type DimensionSettings struct {
+	ID    uuid.UUID
+	Name  string
+	Type  string // name of the dimension type within the dimension codec, e.g. `minecraft:overworld`
+	Edges level.Edges
+}
+
+// DefaultSettings provides settings for a new flat peaceful world with random seed, an overworld dimension
+// of 7x7 chunks, and nether and end dimensions of 3x3 chunks each.
+func DefaultSettings(name string) Settings {
+	seed := make([]byte, 4, 4)
+	_, _ = rand.Read(seed)
+
+	overworldID := DimensionID(game.Overworld)
+	return Settings{
+		ID:                 uuid.New(),
+		Name:               name,
+		Seed:               seed,
+		Coreness:           game.Softcore,
+		Type:               game.WorldFlat,
+		Gamemode:           game.Survival,
+		Difficulty:         game.Peaceful,
+		DifficultyIsLocked: true,
+		DimensionCodec:     VanillaCodec,
+		StartDimension:     overworldID,
+		Dimensions: []DimensionSettings{
+			{
+				ID:    overworldID,
+				Name:  game.Overworld.String(),
+				Type:  "minecraft:overworld",
+				Edges: level.Edges{NegativeX: -48, NegativeZ: -48, PositiveX: 48, PositiveZ: 48},
+			},
+			{
+				ID:    DimensionID(game.Nether),
+				Name:  game.Nether.String(),
+				Type:  "minecraft:the_nether",
+				Edges: level.Edges{NegativeX: -16, NegativeZ: -16, PositiveX: 16, PositiveZ: 16},
+			},
+			{
+				ID:    DimensionID(game.TheEnd),
+				Name:  game.TheEnd.String(),
+				Type:  "minecraft:the_end",
+				Edges: level.Edges{NegativeX: -16, NegativeZ: -16, PositiveX: 16, PositiveZ: 16},
+			},
+		},
+	}
+}
+
+// CreateWorld persists settings of a new world. World blocks are not created, sections never saved are
+// generated from the default template when loaded.
+func CreateWorld(conn *sql.DB, settings Settings) error {
+	tx, err := conn.BeginTx(db.Ctx(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := insertWorld(tx, settings); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+// ImportWorld persists settings of a new world together with the sections of its start dimension in a single
+// transaction, so a failed import leaves nothing behind. The importer saves the sections using the provided repo,
+// and returns the edges of the start dimension covering all the imported sections.
+func ImportWorld(log *zap.Logger, conn *sql.DB, settings Settings,
+	importer func(repo SectionRepo) (level.Edges, error)) error {
+	tx, err := conn.BeginTx(db.Ctx(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := insertWorld(tx, settings); err != nil {
+		return err
+	}
+
+	edges, err := importer(newRepo(log, tx, settings.ID).forDimension(settings.StartDimension))
+	if err != nil {
+		return err
+	}
+
+	dbDimension, err := orm.FindDimension(db.Ctx(), tx, settings.ID, settings.StartDimension)
+	if err != nil {
+		return fmt.Errorf("failed to query start dimension: %w", err)
+	}
+	dbDimension.EdgeNegativeX = edges.NegativeX
+	dbDimension.EdgeNegativeZ = edges.NegativeZ
+	dbDimension.EdgePositiveX = edges.PositiveX
+	dbDimension.EdgePositiveZ = edges.PositiveZ
+	if _, err := dbDimension.Update(db.Ctx(), tx, boil.Whitelist(
+		orm.DimensionColumns.EdgeNegativeX,
+		orm.DimensionColumns.EdgeNegativeZ,
+		orm.DimensionColumns.EdgePositiveX,
+		orm.DimensionColumns.EdgePositiveZ,
+	)); err != nil {
+		return fmt.Errorf("failed to update start dimension edges: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+// insertWorld inserts the world settings and all of its dimensions.
+func insertWorld(tx boil.ContextExecutor, settings Settings) error {
+	dbWorld := &orm.World{
+		ID:               settings.ID,
+		Name:             settings.Name,
+		Seed:             settings.Seed,
+		WorldType:        int16(settings.Type),
+		Hardcore:         bool(settings.Coreness),
+		Gamemode:         int16(settings.Gamemode),
+		Difficulty:       int16(settings.Difficulty),
+		DifficultyLocked: settings.DifficultyIsLocked,
+		DimensionCodec:   settings.DimensionCodec,
+		StartDimensionID: settings.StartDimension,
+	}
+	if err := dbWorld.Insert(db.Ctx(), tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert world: %w", err)
+	}
+
+	for _, dim := range settings.Dimensions {
+		dbDimension := &orm.Dimension{
+			ID:            dim.ID,
+			WorldID:       settings.ID,
+			Name:          dim.Name,
+			DimensionType: dim.Type,
+			EdgeNegativeX: dim.Edges.NegativeX,
+			EdgeNegativeZ: dim.Edges.NegativeZ,
+			EdgePositiveX: dim.Edges.PositiveX,
+			EdgePositiveZ: dim.Edges.PositiveZ,
+		}
+		if err := dbDimension.Insert(db.Ctx(), tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert dimension %s: %w", dim.Name, err)
+		}
+	}
+	return nil
+}
+
+// LoadSettings loads persisted world settings, returns sql.ErrNoRows if the world does not exist.
+func LoadSettings(conn *sql.DB, worldID uuid.UUID) (Settings, error) {
+	dbWorld, err := orm.FindWorld(db.Ctx(), conn, worldID)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	dbDimensions, err := dbWorld.Dimensions().All(db.Ctx(), conn)
+	if err != nil {
+		return Settings{}, fmt.Errorf("failed to query world dimensions: %w", err)
+	}
+
+	settings := Settings{
+		ID:                 dbWorld.ID,
+		Name:               dbWorld.Name,
+		Seed:               dbWorld.Seed,
+		Coreness:           game.Coreness(dbWorld.Hardcore),
+		Gamemode:           game.Gamemode(dbWorld.Gamemode),
+		Type:               game.WorldType(dbWorld.WorldType),
+		Difficulty:         game.Difficulty(dbWorld.Difficulty),
+		DifficultyIsLocked: dbWorld.DifficultyLocked,
+		DimensionCodec:     dbWorld.DimensionCodec,
+		StartDimension:     dbWorld.StartDimensionID,
+	}
+	for _, dbDimension := range dbDimensions {
+		settings.Dimensions = append(settings.Dimensions, DimensionSettings{
+			ID:   dbDimension.ID,
+			Name: dbDimension.Name,
+			Type: dbDimension.DimensionType,
+			Edges: level.Edges{
+				NegativeX: dbDimension.EdgeNegativeX,
+				NegativeZ: dbDimension.EdgeNegativeZ,
+				PositiveX: dbDimension.EdgePositiveX,
+				PositiveZ: dbDimension.EdgePositiveZ,
+			},
+		})
+	}
+
+	return settings, nil
+}
@@ -1,6 +1,12 @@
 package world
 
+import "go.uber.org/zap"
+
 // getTestWorld provides a test world.
 func getTestWorld() *World {
-	return GetDefaultWorld()
+	world, err := newWorld(zap.NewNop(), DefaultSettings("Test World"))
+	if err != nil {
+		panic(err)
+	}
+	return world
 }
@@ -0,0 +1,90 @@
+package anvil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// BlockState - named block state as stored in Anvil section palettes.
+type BlockState struct {
+	Name       string            `nbt:"Name"`
+	Properties map[string]string `nbt:"Properties"`
+}
+
+// key - provides unique string representation of the block state, e.g. `minecraft:oak_log[axis=x]`.
+func (s BlockState) key() string {
+	if len(s.Properties) == 0 {
+		return s.Name
+	}
+
+	props := make([]string, 0, len(s.Properties))
+	for prop, value := range s.Properties {
+		props = append(props, prop+"="+value)
+	}
+	sort.Strings(props)
+
+	return s.Name + "[" + strings.Join(props, ",") + "]"
+}
+
+// BlockStates maps named block states used in the Anvil format to the global block state IDs and back.
+type BlockStates struct {
+	ids    map[string]objects.BlockID
+	states map[objects.BlockID]BlockState
+}
+
+// LoadBlockStates loads block states mapping from the `blocks.json` report generated by the vanilla server.
+func LoadBlockStates(reportFile string) (*BlockStates, error) {
+	type blockState struct {
+		ID    objects.BlockID   `json:"id"`
+		Props map[string]string `json:"properties"`
+	}
+
+	input, err := ioutil.ReadFile(reportFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blocks report %s: %w", reportFile, err)
+	}
+
+	report := make(map[string]struct {
+		States []blockState `json:"states"`
+	})
+	if err := json.Unmarshal(input, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse blocks report %s: %w", reportFile, err)
+	}
+
+	states := &BlockStates{
+		ids:    make(map[string]objects.BlockID),
+		states: make(map[objects.BlockID]BlockState),
+	}
+	for blockName, block := range report {
+		for _, state := range block.States {
+			blockState := BlockState{Name: blockName, Properties: state.Props}
+			states.ids[blockState.key()] = state.ID
+			states.states[state.ID] = blockState
+		}
+	}
+
+	return states, nil
+}
+
+// ID - provides global block state ID for the named block state.
+func (b *BlockStates) ID(state BlockState) (objects.BlockID, error) {
+	id, ok := b.ids[state.key()]
+	if !ok {
+		return 0, fmt.Errorf("unknown block state %s", state.key())
+	}
+	return id, nil
+}
+
+// State - provides named block state for the global block state ID.
+func (b *BlockStates) State(id objects.BlockID) (BlockState, error) {
+	state, ok := b.states[id]
+	if !ok {
+		return BlockState{}, fmt.Errorf("unknown block state ID %d", id)
+	}
+	return state, nil
+}
@@ -0,0 +1,227 @@
+package anvil
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/nbt"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// maxSectionY - Anvil chunks in 1.16 have sections 0 to 15, plus extra lighting-only sections below and above those.
+const maxSectionY = 15
+
+// dataVersion - data version of the chunks written, matches the vanilla 1.16.5.
+const dataVersion = 2586
+
+// DEBT biomes are not stored in CNCraft worlds yet, so all exported chunks are plains.
+const defaultBiome = 1 // minecraft:plains
+const biomesPerChunk = 4 * 4 * 64
+
+const heightmapBits = 9 // heights 0 to 256 inclusive
+
+// Chunk - Anvil chunk with its block sections.
+type Chunk struct {
+	X int32 // chunk coordinates, i.e. block coordinates divided by 16
+	Z int32
+
+	Sections []level.Section // only sections present in the chunk, in no particular order
+}
+
+type chunkNBT struct {
+	DataVersion int32    `nbt:"DataVersion"`
+	Level       levelNBT `nbt:"Level"`
+}
+
+type levelNBT struct {
+	XPos          int32         `nbt:"xPos"`
+	ZPos          int32         `nbt:"zPos"`
+	LastUpdate    int64         `nbt:"LastUpdate"`
+	InhabitedTime int64         `nbt:"InhabitedTime"`
+	Status        string        `nbt:"Status"`
+	IsLightOn     uint8         `nbt:"isLightOn"` // when not set vanilla recalculates chunk light on load
+	Biomes        []int32       `nbt:"Biomes"`
+	Heightmaps    heightmapsNBT `nbt:"Heightmaps"`
+	Sections      []sectionNBT  `nbt:"Sections"`
+	Entities      []struct{}    `nbt:"Entities"`
+	TileEntities  []struct{}    `nbt:"TileEntities"`
+}
+
+type heightmapsNBT struct {
+	MotionBlocking         []int64 `nbt:"MOTION_BLOCKING"`
+	MotionBlockingNoLeaves []int64 `nbt:"MOTION_BLOCKING_NO_LEAVES"`
+	OceanFloor             []int64 `nbt:"OCEAN_FLOOR"`
+	WorldSurface           []int64 `nbt:"WORLD_SURFACE"`
+}
+
+type sectionNBT struct {
+	Y           int8         `nbt:"Y"`
+	Palette     []BlockState `nbt:"Palette"`
+	BlockStates []int64      `nbt:"BlockStates"`
+}
+
+func decodeChunk(data []byte, states *BlockStates) (Chunk, error) {
+	var raw chunkNBT
+	if err := nbt.Unmarshal(data, &raw); err != nil {
+		return Chunk{}, fmt.Errorf("failed to unmarshal chunk NBT: %w", err)
+	}
+
+	chunk := Chunk{X: raw.Level.XPos, Z: raw.Level.ZPos}
+	for _, rawSection := range raw.Level.Sections {
+		if rawSection.Y < 0 || rawSection.Y > maxSectionY || len(rawSection.Palette) == 0 {
+			continue // lighting-only section
+		}
+
+		palette := make([]objects.BlockID, len(rawSection.Palette), len(rawSection.Palette))
+		for i, state := range rawSection.Palette {
+			id, err := states.ID(state)
+			if err != nil {
+				return Chunk{}, fmt.Errorf("failed to map palette of section %d: %w", rawSection.Y, err)
+			}
+			palette[i] = id
+		}
+
+		blocks, err := unpackBlockStates(palette, rawSection.BlockStates)
+		if err != nil {
+			return Chunk{}, fmt.Errorf("failed to unpack section %d: %w", rawSection.Y, err)
+		}
+		chunk.Sections = append(chunk.Sections, level.NewSection(blocks, uint8(rawSection.Y)))
+	}
+
+	return chunk, nil
+}
+
+func encodeChunk(chunk Chunk, states *BlockStates) ([]byte, error) {
+	raw := chunkNBT{
+		DataVersion: dataVersion,
+		Level: levelNBT{
+			XPos:       chunk.X,
+			ZPos:       chunk.Z,
+			LastUpdate: time.Now().Unix(),
+			Status:     "full",
+			Biomes:     make([]int32, biomesPerChunk, biomesPerChunk),
+		},
+	}
+	for i := range raw.Level.Biomes {
+		raw.Level.Biomes[i] = defaultBiome
+	}
+
+	for _, section := range chunk.Sections {
+		rawSection, err := encodeSection(section, states)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode section %d: %w", section.Index(), err)
+		}
+		raw.Level.Sections = append(raw.Level.Sections, rawSection)
+	}
+
+	// DEBT all heightmaps are the same, as CNCraft does not know yet which blocks are motion blocking or are leaves.
+	heightmap := packBlockStates(heightmapBits, chunkHeights(chunk.Sections))
+	raw.Level.Heightmaps = heightmapsNBT{
+		MotionBlocking:         heightmap,
+		MotionBlockingNoLeaves: heightmap,
+		OceanFloor:             heightmap,
+		WorldSurface:           heightmap,
+	}
+
+	var buf bytes.Buffer
+	if err := nbt.Marshal(&buf, raw); err != nil {
+		return nil, fmt.Errorf("failed to marshal chunk NBT: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func encodeSection(section level.Section, states *BlockStates) (sectionNBT, error) {
+	rawSection := sectionNBT{Y: int8(section.Index())}
+
+	paletteIndexes := make(map[objects.BlockID]uint64)
+	indexes := make([]uint64, level.SectionY*level.SectionZ*level.SectionX)
+	for i := range indexes {
+		blockID := section.GetBlock(int64(i%level.SectionX), int64(i/(level.SectionZ*level.SectionX)),
+			int64(i/level.SectionX%level.SectionZ)).ID()
+
+		paletteIndex, ok := paletteIndexes[blockID]
+		if !ok {
+			state, err := states.State(blockID)
+			if err != nil {
+				return sectionNBT{}, err
+			}
+
+			paletteIndex = uint64(len(rawSection.Palette))
+			paletteIndexes[blockID] = paletteIndex
+			rawSection.Palette = append(rawSection.Palette, state)
+		}
+		indexes[i] = paletteIndex
+	}
+
+	rawSection.BlockStates = packBlockStates(paletteBits(len(rawSection.Palette)), indexes)
+	return rawSection, nil
+}
+
+// chunkHeights - provides the height above the highest non-air block for each block column, indexed by z*16+x.
+func chunkHeights(sections []level.Section) []uint64 {
+	heights := make([]uint64, level.SectionZ*level.SectionX)
+	for _, section := range sections {
+		for y := int64(level.SectionY) - 1; y >= 0; y-- {
+			for z := int64(0); z < level.SectionZ; z++ {
+				for x := int64(0); x < level.SectionX; x++ {
+					switch section.GetBlock(x, y, z).ID() {
+					case objects.BlockAir, objects.BlockCaveAir, objects.BlockVoidAir:
+						continue
+					}
+
+					height := uint64(section.Index()*level.SectionY) + uint64(y) + 1
+					if heights[z*level.SectionX+x] < height {
+						heights[z*level.SectionX+x] = height
+					}
+				}
+			}
+		}
+	}
+	return heights
+}
+
+// paletteBits - provides number of bits used per block for the given palette length, 4 bits minimum.
+func paletteBits(paletteLen int) uint {
+	bits := uint(4)
+	for 1<<bits < paletteLen {
+		bits++
+	}
+	return bits
+}
+
+// unpackBlockStates - unpacks the section block states stored as palette indexes. Since 1.16 the indexes are packed
+// from the lowest bits of each long and do not span across longs, the remaining highest bits are left unused.
+func unpackBlockStates(palette []objects.BlockID, data []int64) (level.BlockArr, error) {
+	var blocks level.BlockArr
+	const blockCount = level.SectionY * level.SectionZ * level.SectionX
+
+	bits := paletteBits(len(palette))
+	perLong := int(64 / bits)
+	if len(data) != (blockCount+perLong-1)/perLong {
+		return blocks, fmt.Errorf("invalid block states length %d for palette length %d", len(data), len(palette))
+	}
+
+	mask := uint64(1)<<bits - 1
+	for i := 0; i < blockCount; i++ {
+		paletteIndex := uint64(data[i/perLong]) >> (uint(i%perLong) * bits) & mask
+		if paletteIndex >= uint64(len(palette)) {
+			return blocks, fmt.Errorf("palette index %d out of palette range %d", paletteIndex, len(palette))
+		}
+		blocks[i/(level.SectionZ*level.SectionX)][i/level.SectionX%level.SectionZ][i%level.SectionX] =
+			level.NewBlock(palette[paletteIndex])
+	}
+
+	return blocks, nil
+}
+
+// packBlockStates - packs values using given number of bits per value, in the same layout as unpackBlockStates expects.
+func packBlockStates(bits uint, values []uint64) []int64 {
+	perLong := int(64 / bits)
+	data := make([]int64, (len(values)+perLong-1)/perLong)
+	for i, value := range values {
+		data[i/perLong] |= int64(value << (uint(i%perLong) * bits))
+	}
+	return data
+}
@@ -0,0 +1,64 @@
+package anvil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestPaletteBits(t *testing.T) {
+	assert.Equal(t, uint(4), paletteBits(1))
+	assert.Equal(t, uint(4), paletteBits(16))
+	assert.Equal(t, uint(5), paletteBits(17))
+	assert.Equal(t, uint(8), paletteBits(256))
+	assert.Equal(t, uint(9), paletteBits(257))
+}
+
+func TestUnpackBlockStates(t *testing.T) {
+	t.Run("4_bits", func(t *testing.T) {
+		palette := []objects.BlockID{objects.BlockAir, objects.BlockStone}
+		data := make([]int64, 256, 256)
+		data[0] = 0x10 // second block of the section is stone, everything else is air
+
+		blocks, err := unpackBlockStates(palette, data)
+		require.NoError(t, err)
+		assert.Equal(t, objects.BlockAir, blocks[0][0][0].ID())
+		assert.Equal(t, objects.BlockStone, blocks[0][0][1].ID())
+		assert.Equal(t, objects.BlockAir, blocks[15][15][15].ID())
+	})
+
+	t.Run("5_bits_not_spanning_longs", func(t *testing.T) {
+		palette := make([]objects.BlockID, 17, 17)
+		for i := range palette {
+			palette[i] = objects.BlockID(i)
+		}
+		data := make([]int64, 342, 342) // 12 blocks per long, top 4 bits unused
+		data[0] = 16 << 55              // 12th block, index 11
+		data[1] = 3                     // 13th block, index 12
+
+		blocks, err := unpackBlockStates(palette, data)
+		require.NoError(t, err)
+		assert.Equal(t, objects.BlockID(16), blocks[0][0][11].ID())
+		assert.Equal(t, objects.BlockID(3), blocks[0][0][12].ID())
+		assert.Equal(t, objects.BlockID(0), blocks[0][0][13].ID())
+	})
+
+	t.Run("invalid_data_length", func(t *testing.T) {
+		_, err := unpackBlockStates([]objects.BlockID{objects.BlockAir}, make([]int64, 255, 255))
+		assert.Error(t, err)
+	})
+}
+
+func TestBlockStateKey(t *testing.T) {
+	state := BlockState{Name: "minecraft:oak_stairs", Properties: map[string]string{
+		"waterlogged": "false",
+		"facing":      "north",
+		"half":        "top",
+		"shape":       "straight",
+	}}
+	assert.Equal(t, "minecraft:oak_stairs[facing=north,half=top,shape=straight,waterlogged=false]", state.key())
+	assert.Equal(t, "minecraft:stone", BlockState{Name: "minecraft:stone"}.key())
+}
@@ -0,0 +1,180 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"time"
+)
+
+// RegionSize - size of the region side in chunks.
+const RegionSize = 32
+
+const sectorSize = 4096
+const headerSize = 2 * sectorSize // chunk locations sector, followed by chunk timestamps sector
+
+const (
+	compressionGzip = 1
+	compressionZlib = 2
+	compressionNone = 3
+)
+
+// Region - Anvil region file, holding up to RegionSize*RegionSize chunks.
+type Region struct {
+	X int32 // region coordinates, i.e. chunk coordinates divided by RegionSize
+	Z int32
+
+	chunks [RegionSize * RegionSize][]byte // uncompressed chunk NBT data, nil for chunks not present in the region
+}
+
+// NewRegion creates empty region with the given region coordinates.
+func NewRegion(x, z int32) *Region {
+	return &Region{X: x, Z: z}
+}
+
+// RegionCoords provides coordinates of the region containing the chunk with given chunk coordinates.
+func RegionCoords(chunkX, chunkZ int32) (x, z int32) {
+	return chunkX >> 5, chunkZ >> 5 // floor division by RegionSize
+}
+
+// ReadRegionFile reads region file with the standard `r.{x}.{z}.mca` name.
+func ReadRegionFile(path string) (*Region, error) {
+	region := &Region{}
+	if _, err := fmt.Sscanf(filepath.Base(path), "r.%d.%d.mca", &region.X, &region.Z); err != nil {
+		return nil, fmt.Errorf("failed to parse region coordinates from file name %s: %w", path, err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read region file %s: %w", path, err)
+	}
+	if len(data) < headerSize {
+		return nil, fmt.Errorf("region file %s is too short: %d bytes", path, len(data))
+	}
+
+	for i := range region.chunks {
+		location := binary.BigEndian.Uint32(data[i*4 : i*4+4])
+		offset := int(location>>8) * sectorSize
+		if offset == 0 {
+			continue // chunk is not present
+		}
+
+		if offset+5 > len(data) {
+			return nil, fmt.Errorf("chunk %d offset %d is beyond the end of region file %s", i, offset, path)
+		}
+		length := int(binary.BigEndian.Uint32(data[offset : offset+4]))
+		if length < 1 || offset+4+length > len(data) {
+			return nil, fmt.Errorf("chunk %d has invalid length %d in region file %s", i, length, path)
+		}
+
+		if region.chunks[i], err = decompress(data[offset+4], data[offset+5:offset+4+length]); err != nil {
+			return nil, fmt.Errorf("failed to decompress chunk %d in region file %s: %w", i, path, err)
+		}
+	}
+
+	return region, nil
+}
+
+// Chunks decodes all chunks present in the region.
+func (r *Region) Chunks(states *BlockStates) ([]Chunk, error) {
+	var chunks []Chunk
+	for i, data := range r.chunks {
+		if data == nil {
+			continue
+		}
+
+		chunk, err := decodeChunk(data, states)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode chunk %d of region %d.%d: %w", i, r.X, r.Z, err)
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks, nil
+}
+
+// SetChunk encodes the chunk and stores it in the region, replacing the chunk with the same coordinates if present.
+func (r *Region) SetChunk(chunk Chunk, states *BlockStates) error {
+	if x, z := RegionCoords(chunk.X, chunk.Z); x != r.X || z != r.Z {
+		return fmt.Errorf("chunk %d.%d does not belong to region %d.%d", chunk.X, chunk.Z, r.X, r.Z)
+	}
+
+	data, err := encodeChunk(chunk, states)
+	if err != nil {
+		return fmt.Errorf("failed to encode chunk %d.%d: %w", chunk.X, chunk.Z, err)
+	}
+
+	r.chunks[(chunk.Z&(RegionSize-1))*RegionSize+(chunk.X&(RegionSize-1))] = data
+	return nil
+}
+
+// WriteFile writes the region into the given directory, into the file with the standard `r.{x}.{z}.mca` name.
+// Chunks are stored zlib compressed.
+func (r *Region) WriteFile(dir string) error {
+	header := make([]byte, headerSize)
+	var body bytes.Buffer
+
+	timestamp := uint32(time.Now().Unix())
+	for i, data := range r.chunks {
+		if data == nil {
+			continue
+		}
+
+		var compressed bytes.Buffer
+		writer := zlib.NewWriter(&compressed)
+		if _, err := writer.Write(data); err != nil {
+			return fmt.Errorf("failed to compress chunk %d: %w", i, err)
+		}
+		if err := writer.Close(); err != nil {
+			return fmt.Errorf("failed to compress chunk %d: %w", i, err)
+		}
+
+		sectors := (compressed.Len() + 5 + sectorSize - 1) / sectorSize
+		if sectors > 0xFF {
+			return fmt.Errorf("chunk %d is too large: %d bytes compressed", i, compressed.Len())
+		}
+		offset := (headerSize + body.Len()) / sectorSize
+
+		binary.BigEndian.PutUint32(header[i*4:], uint32(offset<<8|sectors))
+		binary.BigEndian.PutUint32(header[sectorSize+i*4:], timestamp)
+
+		chunkHeader := make([]byte, 5)
+		binary.BigEndian.PutUint32(chunkHeader, uint32(compressed.Len()+1))
+		chunkHeader[4] = compressionZlib
+		body.Write(chunkHeader)
+		body.Write(compressed.Bytes())
+		body.Write(make([]byte, sectors*sectorSize-compressed.Len()-5)) // pad to the sector boundary
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("r.%d.%d.mca", r.X, r.Z))
+	if err := ioutil.WriteFile(path, append(header, body.Bytes()...), 0644); err != nil {
+		return fmt.Errorf("failed to write region file %s: %w", path, err)
+	}
+	return nil
+}
+
+func decompress(compression byte, data []byte) ([]byte, error) {
+	switch compression {
+	case compressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(reader)
+	case compressionZlib:
+		reader, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.ReadAll(reader)
+	case compressionNone:
+		return data, nil
+	default:
+		// DEBT chunks stored in the external .mcc files are marked by the high bit of the compression type,
+		//  those are not supported.
+		return nil, fmt.Errorf("unsupported compression type %d", compression)
+	}
+}
@@ -0,0 +1,89 @@
+package anvil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/game/level"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func getTestStates() *BlockStates {
+	states := &BlockStates{
+		ids:    make(map[string]objects.BlockID),
+		states: make(map[objects.BlockID]BlockState),
+	}
+	for id, state := range map[objects.BlockID]BlockState{
+		objects.BlockAir:          {Name: "minecraft:air"},
+		objects.BlockBedrock:      {Name: "minecraft:bedrock"},
+		objects.BlockOakLog_AxisY: {Name: "minecraft:oak_log", Properties: map[string]string{"axis": "y"}},
+	} {
+		states.ids[state.key()] = id
+		states.states[id] = state
+	}
+	return states
+}
+
+func TestRegionRoundTrip(t *testing.T) {
+	states := getTestStates()
+
+	var blocks level.BlockArr
+	for y := 0; y < level.SectionY; y++ {
+		for z := 0; z < level.SectionZ; z++ {
+			for x := 0; x < level.SectionX; x++ {
+				blocks[y][z][x] = level.NewBlock(objects.BlockAir)
+			}
+		}
+	}
+	for z := 0; z < level.SectionZ; z++ {
+		for x := 0; x < level.SectionX; x++ {
+			blocks[0][z][x] = level.NewBlock(objects.BlockBedrock)
+		}
+	}
+	blocks[5][3][7] = level.NewBlock(objects.BlockOakLog_AxisY)
+
+	regionX, regionZ := RegionCoords(-1, 33)
+	require.Equal(t, int32(-1), regionX)
+	require.Equal(t, int32(1), regionZ)
+
+	region := NewRegion(regionX, regionZ)
+	require.NoError(t, region.SetChunk(Chunk{X: -1, Z: 33, Sections: []level.Section{level.NewSection(blocks, 2)}}, states))
+	assert.Error(t, region.SetChunk(Chunk{X: 0, Z: 33}, states))
+
+	dir := t.TempDir()
+	require.NoError(t, region.WriteFile(dir))
+
+	readRegion, err := ReadRegionFile(dir + "/r.-1.1.mca")
+	require.NoError(t, err)
+	chunks, err := readRegion.Chunks(states)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+
+	assert.Equal(t, int32(-1), chunks[0].X)
+	assert.Equal(t, int32(33), chunks[0].Z)
+	require.Len(t, chunks[0].Sections, 1)
+
+	section := chunks[0].Sections[0]
+	assert.Equal(t, 2, section.Index())
+	assert.Equal(t, objects.BlockBedrock, section.GetBlock(15, 0, 15).ID())
+	assert.Equal(t, objects.BlockOakLog_AxisY, section.GetBlock(7, 5, 3).ID())
+	assert.Equal(t, objects.BlockAir, section.GetBlock(7, 6, 3).ID())
+}
+
+func TestChunkHeights(t *testing.T) {
+	var blocks level.BlockArr
+	for y := 0; y < level.SectionY; y++ {
+		for z := 0; z < level.SectionZ; z++ {
+			for x := 0; x < level.SectionX; x++ {
+				blocks[y][z][x] = level.NewBlock(objects.BlockAir)
+			}
+		}
+	}
+	blocks[4][1][2] = level.NewBlock(objects.BlockBedrock)
+
+	heights := chunkHeights([]level.Section{level.NewSection(blocks, 1)})
+	assert.Equal(t, uint64(21), heights[1*level.SectionX+2])
+	assert.Equal(t, uint64(0), heights[0])
+}
@@ -3,6 +3,7 @@ package chat
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/fatih/color"
 )
@@ -377,3 +378,35 @@ func TranslateConsole(text string) string {
 
 	return build.String()
 }
+
+// StripFormatting removes the formatting codes from the text written by the player, along with the characters
+// not allowed in chat, same as the Notchian server does.
+func StripFormatting(text string) string {
+	build := strings.Builder{}
+	chars := []rune(text)
+
+	for i := 0; i < len(chars); i++ {
+		r := chars[i]
+
+		if r == ColorCChar {
+			if i+1 < len(chars) {
+				if _, ok := charToCode[unicode.ToLower(chars[i+1])]; ok {
+					i++
+				}
+			}
+			continue
+		}
+
+		if IsAllowedChar(r) {
+			build.WriteRune(r)
+		}
+	}
+
+	return build.String()
+}
+
+// IsAllowedChar tells if the character can be written by the player, control characters and the formatting code
+// character are not allowed.
+func IsAllowedChar(r rune) bool {
+	return r != ColorCChar && r >= ' ' && r != 127
+}
@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAllowedChar(t *testing.T) {
+	tests := []struct {
+		name     string
+		char     rune
+		expected bool
+	}{
+		{name: "letter", char: 'a', expected: true},
+		{name: "space", char: ' ', expected: true},
+		{name: "slash", char: '/', expected: true},
+		{name: "non_ascii", char: 'ж', expected: true},
+		{name: "formatting_code", char: ColorCChar, expected: false},
+		{name: "newline", char: '\n', expected: false},
+		{name: "null", char: 0, expected: false},
+		{name: "delete", char: 127, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, IsAllowedChar(test.char))
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Failures of the command input not matching the command tree, same as reported by the Notchian server.
+var (
+	ErrUnknownCommand    = Fail("Unknown command")
+	ErrIncompleteCommand = Fail("Unknown or incomplete command")
+	ErrIncorrectArgument = Fail("Incorrect argument for command")
+)
+
+// Dispatcher holds the tree of all registered commands, parses the command input against it and executes
+// the commands. Nodes the sender is not permitted to use are treated as not existing.
+type Dispatcher struct {
+	mu   sync.RWMutex
+	root *Node
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{root: &Node{Type: RootNode}}
+}
+
+// Register adds the command, replacing the previously registered command of the same name, if there was one.
+func (d *Dispatcher) Register(command *Node) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, existing := range d.root.Children {
+		if existing.Name == command.Name {
+			d.root.Children[i] = command
+			return
+		}
+	}
+	d.root.Then(command)
+}
+
+// Execute parses the command input, without the leading slash, and executes the command.
+func (d *Dispatcher) Execute(sender Sender, input string) error {
+	d.mu.RLock()
+	ctx := &Context{Sender: sender, Input: input, args: make(map[string]interface{})}
+	node, err := d.parse(ctx, d.root, NewReader(input))
+	d.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+	if !node.IsExecutable() {
+		return ErrIncompleteCommand
+	}
+	return node.Executor(ctx)
+}
+
+// Suggest provides the completions of the last argument of the command input, without the leading slash, along with
+// the position in the input the last argument starts at.
+func (d *Dispatcher) Suggest(sender Sender, input string) (int, []string) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	ctx := &Context{Sender: sender, Input: input, args: make(map[string]interface{})}
+
+	node, start := d.root, 0
+	if lastSeparator := strings.LastIndexByte(input, argumentSeparator); lastSeparator >= 0 {
+		var err error
+		if node, err = d.parse(ctx, d.root, NewReader(input[:lastSeparator])); err != nil {
+			return 0, nil
+		}
+		start = lastSeparator + 1
+	}
+
+	partial := input[start:]
+	var suggestions []string
+	for _, child := range d.permittedChildren(sender, node) {
+		switch {
+		case child.Type == LiteralNode:
+			suggestions = append(suggestions, child.Name)
+		case child.Suggester != nil:
+			suggestions = append(suggestions, child.Suggester(ctx, partial)...)
+		}
+	}
+
+	var matching []string
+	for _, suggestion := range suggestions {
+		if strings.HasPrefix(strings.ToLower(suggestion), strings.ToLower(partial)) {
+			matching = append(matching, suggestion)
+		}
+	}
+	sort.Strings(matching)
+	return start, matching
+}
+
+// Tree provides the copy of the command tree with only the nodes the sender is permitted to use, to declare
+// the commands to the client.
+func (d *Dispatcher) Tree(sender Sender) *Node {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.copyPermitted(sender, d.root)
+}
+
+// Usage provides the usage line of every command the sender is permitted to use, e.g. "/me <action>".
+func (d *Dispatcher) Usage(sender Sender) []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var lines []string
+	for _, command := range d.permittedChildren(sender, d.root) {
+		lines = append(lines, "/"+d.usage(sender, command))
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+// parse matches the input left in the reader against the children of the node, depth first, literals before
+// arguments, and provides the node the input ends at. Values of the arguments matched are set in the context.
+func (d *Dispatcher) parse(ctx *Context, node *Node, reader *Reader) (*Node, error) {
+	end, err, _ := d.parseFrom(ctx, node, reader)
+	return end, err
+}
+
+// parseFrom parses same as parse does. Failing, it provides the input position the failure was found at, failures
+// found further into the input explain more than the ones found early on.
+func (d *Dispatcher) parseFrom(ctx *Context, node *Node, reader *Reader) (*Node, error, int) {
+	if !reader.CanRead() {
+		return node, nil, reader.Cursor()
+	}
+
+	var failure error
+	failedAt := -1
+	var isMatched bool
+	for _, child := range d.permittedChildren(ctx.Sender, node) {
+		branch := *reader
+		value, err := d.match(child, &branch)
+		if err == nil && branch.CanRead() {
+			if branch.Peek() != argumentSeparator {
+				err = ErrIncorrectArgument // the argument must be followed by the separator, if anything
+			} else {
+				branch.Skip()
+			}
+		}
+		if err != nil {
+			// failures of the arguments explain more than the literal mismatches
+			if reader.Cursor() > failedAt || (reader.Cursor() == failedAt && child.Type == ArgumentNode) {
+				failure, failedAt = err, reader.Cursor()
+			}
+			continue
+		}
+
+		isMatched = true
+		if child.Type == ArgumentNode {
+			ctx.args[child.Name] = value
+		}
+		end, err, at := d.parseFrom(ctx, child, &branch)
+		if err != nil {
+			delete(ctx.args, child.Name)
+			if at >= failedAt {
+				failure, failedAt = err, at
+			}
+			continue
+		}
+
+		*reader = branch
+		return end, nil, branch.Cursor()
+	}
+
+	if node.Type == RootNode && !isMatched {
+		return nil, ErrUnknownCommand, reader.Cursor()
+	}
+	if failure == nil {
+		failure, failedAt = ErrIncorrectArgument, reader.Cursor()
+	}
+	return nil, failure, failedAt
+}
+
+func (d *Dispatcher) match(node *Node, reader *Reader) (interface{}, error) {
+	if node.Type == LiteralNode {
+		if reader.ReadWord() != node.Name {
+			return nil, ErrIncorrectArgument
+		}
+		return nil, nil
+	}
+	return node.Parser.Parse(reader)
+}
+
+// permittedChildren provides the children of the node the sender is permitted to use, literals first.
+func (d *Dispatcher) permittedChildren(sender Sender, node *Node) []*Node {
+	var literals, arguments []*Node
+	for _, child := range node.Children {
+		if child.Permission != "" && !sender.HasPermission(child.Permission) {
+			continue
+		}
+		if child.Type == LiteralNode {
+			literals = append(literals, child)
+		} else {
+			arguments = append(arguments, child)
+		}
+	}
+	return append(literals, arguments...)
+}
+
+func (d *Dispatcher) copyPermitted(sender Sender, node *Node) *Node {
+	nodeCopy := *node
+	nodeCopy.Children = nil
+	for _, child := range d.permittedChildren(sender, node) {
+		nodeCopy.Children = append(nodeCopy.Children, d.copyPermitted(sender, child))
+	}
+	return &nodeCopy
+}
+
+// usage describes the node along with its children, optional children are bracketed, alternatives are listed
+// in parentheses.
+func (d *Dispatcher) usage(sender Sender, node *Node) string {
+	name := node.Name
+	if node.Type == ArgumentNode {
+		name = "<" + node.Name + ">"
+	}
+
+	children := d.permittedChildren(sender, node)
+	if len(children) == 0 {
+		return name
+	}
+
+	var rest string
+	if len(children) == 1 {
+		rest = d.usage(sender, children[0])
+	} else {
+		alternatives := make([]string, len(children))
+		for i, child := range children {
+			alternatives[i] = child.Name
+			if child.Type == ArgumentNode {
+				alternatives[i] = "<" + child.Name + ">"
+			}
+		}
+		rest = "(" + strings.Join(alternatives, "|") + ")"
+	}
+
+	if node.IsExecutable() {
+		return name + " [" + rest + "]"
+	}
+	return name + " " + rest
+}
@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/chat"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+type testSender struct {
+	permissions map[string]bool
+	messages    []string
+}
+
+func (s *testSender) Name() string                         { return "tester" }
+func (s *testSender) HasPermission(permission string) bool { return s.permissions[permission] }
+func (s *testSender) SendMessage(message *chat.Message)    { s.messages = append(s.messages, message.AsText()) }
+
+func newTestDispatcher(executed *map[string]interface{}) *Dispatcher {
+	record := func(ctx *Context) error {
+		*executed = ctx.args
+		return nil
+	}
+
+	d := NewDispatcher()
+	d.Register(Literal("give").Requires("test.give").
+		Then(Argument("targets", EntityParser{PlayersOnly: true}).
+			Then(Argument("item", ItemStackParser{}).
+				Executes(record).
+				Then(Argument("count", IntegerParser{Min: 1, Max: 64}).
+					Executes(record)))))
+	d.Register(Literal("time").
+		Then(Literal("set").
+			Then(Literal("day").Executes(record)).
+			Then(Argument("time", TimeParser{}).Executes(record))))
+	d.Register(Literal("tp").
+		Then(Argument("location", Vec3Parser{}).Executes(record)).
+		Then(Argument("destination", EntityParser{Single: true}).Executes(record)))
+	d.Register(Literal("say").
+		Then(Argument("message", MessageParser{}).Executes(record)))
+	return d
+}
+
+func TestDispatcher_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		args    map[string]interface{}
+		failure error
+	}{
+		{name: "literals", input: "time set day", args: map[string]interface{}{}},
+		{name: "argument", input: "time set 2d", args: map[string]interface{}{"time": int32(48000)}},
+		{
+			name:  "optional argument omitted",
+			input: "give @a minecraft:stone",
+			args:  map[string]interface{}{"targets": Entities{Selector: SelectAllPlayers}, "item": objects.ItemStone},
+		},
+		{
+			name:  "optional argument",
+			input: "give Notch stone 5",
+			args:  map[string]interface{}{"targets": Entities{Name: "Notch"}, "item": objects.ItemStone, "count": int32(5)},
+		},
+		{
+			name:  "coordinates",
+			input: "tp 10 ~ ~-1.5",
+			args: map[string]interface{}{"location": Coordinates{
+				{Value: 10.5},
+				{IsRelative: true},
+				{Value: -1.5, IsRelative: true},
+			}},
+		},
+		{name: "alternative argument", input: "tp Notch", args: map[string]interface{}{"destination": Entities{Name: "Notch"}}},
+		{name: "greedy", input: "say hello there", args: map[string]interface{}{"message": "hello there"}},
+		{name: "unknown command", input: "fly", failure: ErrUnknownCommand},
+		{name: "no permission", input: "give @a stone", failure: ErrUnknownCommand},
+		{name: "incomplete", input: "time set", failure: ErrIncompleteCommand},
+		{name: "unknown literal", input: "time add 5", failure: ErrIncorrectArgument},
+		{name: "trailing data", input: "time set day now", failure: ErrIncorrectArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var executed map[string]interface{}
+			d := newTestDispatcher(&executed)
+			sender := &testSender{permissions: map[string]bool{"test.give": tt.name != "no permission"}}
+
+			err := d.Execute(sender, tt.input)
+			if tt.failure != nil {
+				assert.Equal(t, tt.failure, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.args, executed)
+		})
+	}
+}
+
+func TestDispatcher_ExecuteArgumentFailure(t *testing.T) {
+	var executed map[string]interface{}
+	d := newTestDispatcher(&executed)
+	sender := &testSender{permissions: map[string]bool{"test.give": true}}
+
+	err := d.Execute(sender, "give @e stone")
+	var failure *Failure
+	require.ErrorAs(t, err, &failure)
+	assert.Contains(t, failure.Error(), "Only players may be affected")
+
+	err = d.Execute(sender, "give @a stone 65")
+	require.ErrorAs(t, err, &failure)
+	assert.Contains(t, failure.Error(), "Integer must not be more than 64")
+	assert.Nil(t, executed)
+}
+
+func TestDispatcher_Suggest(t *testing.T) {
+	var executed map[string]interface{}
+	d := newTestDispatcher(&executed)
+	d.Register(Literal("kick").
+		Then(Argument("target", EntityParser{Single: true, PlayersOnly: true}).
+			Suggests(func(_ *Context, _ string) []string { return []string{"Notch", "Dinnerbone"} })))
+	sender := &testSender{}
+
+	start, matches := d.Suggest(sender, "t")
+	assert.Equal(t, 0, start)
+	assert.Equal(t, []string{"time", "tp"}, matches)
+
+	start, matches = d.Suggest(sender, "time s")
+	assert.Equal(t, 5, start)
+	assert.Equal(t, []string{"set"}, matches)
+
+	start, matches = d.Suggest(sender, "kick n")
+	assert.Equal(t, 5, start)
+	assert.Equal(t, []string{"Notch"}, matches)
+
+	_, matches = d.Suggest(sender, "g")
+	assert.Empty(t, matches, "commands the sender is not permitted to use are not suggested")
+}
+
+func TestDispatcher_TreeAndUsage(t *testing.T) {
+	var executed map[string]interface{}
+	d := newTestDispatcher(&executed)
+
+	tree := d.Tree(&testSender{})
+	require.Len(t, tree.Children, 3)
+	for _, command := range tree.Children {
+		assert.NotEqual(t, "give", command.Name)
+	}
+
+	assert.Equal(t, []string{
+		"/give <targets> <item> [<count>]",
+		"/say <message>",
+		"/time set (day|<time>)",
+		"/tp (<location>|<destination>)",
+	}, d.Usage(&testSender{permissions: map[string]bool{"test.give": true}}))
+}
@@ -0,0 +1,100 @@
+// Package commands contains the command tree in the Brigadier model the Notchian client understands, the parsers
+// of the command arguments and the dispatcher executing the commands and suggesting their completions.
+package commands
+
+import (
+	"fmt"
+
+	"github.com/alexykot/cncraft/pkg/chat"
+)
+
+// NodeType - type of the command tree node, as per https://wiki.vg/Command_Data
+type NodeType uint8
+
+const (
+	RootNode NodeType = iota
+	LiteralNode
+	ArgumentNode
+)
+
+// Sender - whoever sends the command, e.g. a player.
+type Sender interface {
+	Name() string
+	HasPermission(permission string) bool
+	// SendMessage sends the command feedback to the sender.
+	SendMessage(message *chat.Message)
+}
+
+// Context - the command being executed, with the values of the arguments parsed from the input, by argument name.
+type Context struct {
+	Sender Sender
+	Input  string
+
+	args map[string]interface{}
+}
+
+// Arg provides the parsed value of the argument, nil if the argument is not present in the input.
+func (c *Context) Arg(name string) interface{} { return c.args[name] }
+
+// Executor executes the command once the input is parsed. Failures are explained to the sender, other errors are
+// reported to the sender as unexpected.
+type Executor func(ctx *Context) error
+
+// Suggester provides the suggestions for the argument value the sender has typed so far. The client asks the server
+// for the suggestions of the arguments that have one, and suggests the rest itself.
+type Suggester func(ctx *Context, partial string) []string
+
+// Node - node of the command tree. Literals match their name, arguments parse their value with their parser.
+// The input ending at the node is executed by the node executor, if it has one.
+type Node struct {
+	Type       NodeType
+	Name       string
+	Parser     Parser // argument nodes only
+	Permission string // required from the sender to use the node and its children, none if empty
+	Executor   Executor
+	Suggester  Suggester
+	Children   []*Node
+}
+
+func Literal(name string) *Node {
+	return &Node{Type: LiteralNode, Name: name}
+}
+
+func Argument(name string, parser Parser) *Node {
+	return &Node{Type: ArgumentNode, Name: name, Parser: parser}
+}
+
+// Then adds the child nodes. Literal children are matched before the argument children, in the order added.
+func (n *Node) Then(children ...*Node) *Node {
+	n.Children = append(n.Children, children...)
+	return n
+}
+
+func (n *Node) Executes(executor Executor) *Node {
+	n.Executor = executor
+	return n
+}
+
+func (n *Node) Requires(permission string) *Node {
+	n.Permission = permission
+	return n
+}
+
+func (n *Node) Suggests(suggester Suggester) *Node {
+	n.Suggester = suggester
+	return n
+}
+
+// IsExecutable tells if the input ending at the node can be executed.
+func (n *Node) IsExecutable() bool { return n.Executor != nil }
+
+// Failure - command failure explained to the sender, e.g. an invalid argument value.
+type Failure struct {
+	message string
+}
+
+func Fail(format string, args ...interface{}) *Failure {
+	return &Failure{message: fmt.Sprintf(format, args...)}
+}
+
+func (f *Failure) Error() string { return f.message }
@@ -0,0 +1,315 @@
+package commands
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// Parser parses the value of the argument from the command input. The client parses the arguments as well, knowing
+// the parser by its identifier and properties, as per https://wiki.vg/Command_Data#Parsers
+type Parser interface {
+	ID() string
+	PushProperties(writer *buffer.Buffer)
+	Parse(reader *Reader) (interface{}, error)
+}
+
+type BoolParser struct{}
+
+func (p BoolParser) ID() string                      { return "brigadier:bool" }
+func (p BoolParser) PushProperties(_ *buffer.Buffer) {}
+func (p BoolParser) Parse(reader *Reader) (interface{}, error) {
+	switch word := reader.ReadWord(); word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return nil, Fail("Invalid boolean, expected 'true' or 'false' but found '%s'", word)
+	}
+}
+
+// IntegerParser parses an int32 within the given bounds.
+type IntegerParser struct {
+	Min, Max int32
+}
+
+// Integer provides the parser of any int32.
+func Integer() IntegerParser { return IntegerParser{Min: math.MinInt32, Max: math.MaxInt32} }
+
+func (p IntegerParser) ID() string { return "brigadier:integer" }
+func (p IntegerParser) PushProperties(writer *buffer.Buffer) {
+	var flags byte
+	if p.Min != math.MinInt32 {
+		flags |= 0x01
+	}
+	if p.Max != math.MaxInt32 {
+		flags |= 0x02
+	}
+
+	writer.PushByte(flags)
+	if flags&0x01 != 0 {
+		writer.PushInt32(p.Min)
+	}
+	if flags&0x02 != 0 {
+		writer.PushInt32(p.Max)
+	}
+}
+func (p IntegerParser) Parse(reader *Reader) (interface{}, error) {
+	word := reader.ReadWord()
+	value, err := strconv.ParseInt(word, 10, 32)
+	if err != nil {
+		return nil, Fail("Invalid integer '%s'", word)
+	}
+	if int32(value) < p.Min {
+		return nil, Fail("Integer must not be less than %d, found %d", p.Min, value)
+	}
+	if int32(value) > p.Max {
+		return nil, Fail("Integer must not be more than %d, found %d", p.Max, value)
+	}
+	return int32(value), nil
+}
+
+// StringMode - how much of the input the string argument takes.
+type StringMode int32
+
+const (
+	SingleWord StringMode = iota
+	QuotablePhrase
+	GreedyPhrase // everything left of the input
+)
+
+type StringParser struct {
+	Mode StringMode
+}
+
+func (p StringParser) ID() string                           { return "brigadier:string" }
+func (p StringParser) PushProperties(writer *buffer.Buffer) { writer.PushVarInt(int32(p.Mode)) }
+func (p StringParser) Parse(reader *Reader) (interface{}, error) {
+	switch p.Mode {
+	case QuotablePhrase:
+		return reader.ReadQuotable()
+	case GreedyPhrase:
+		return reader.ReadRemaining(), nil
+	}
+	return reader.ReadWord(), nil
+}
+
+// Entities - entities selected by the entity argument, either with the target selector or by the player name.
+// DEBT target selector arguments, e.g. @e[type=cow], are not supported.
+type Entities struct {
+	Selector string // e.g. "@p", empty if selected by name
+	Name     string
+}
+
+// Target selectors, as per https://minecraft.fandom.com/wiki/Target_selectors
+const (
+	SelectNearestPlayer = "@p"
+	SelectRandomPlayer  = "@r"
+	SelectAllPlayers    = "@a"
+	SelectAllEntities   = "@e"
+	SelectSelf          = "@s"
+)
+
+// maxPlayerNameLength - longest player name allowed, as per https://wiki.vg/Protocol#Login_Start
+const maxPlayerNameLength = 16
+
+type EntityParser struct {
+	Single      bool
+	PlayersOnly bool
+}
+
+func (p EntityParser) ID() string { return "minecraft:entity" }
+func (p EntityParser) PushProperties(writer *buffer.Buffer) {
+	var flags byte
+	if p.Single {
+		flags |= 0x01
+	}
+	if p.PlayersOnly {
+		flags |= 0x02
+	}
+	writer.PushByte(flags)
+}
+func (p EntityParser) Parse(reader *Reader) (interface{}, error) {
+	word := reader.ReadWord()
+	if !strings.HasPrefix(word, "@") {
+		if word == "" || len(word) > maxPlayerNameLength {
+			return nil, Fail("Invalid name or UUID")
+		}
+		return Entities{Name: word}, nil
+	}
+
+	switch word {
+	case SelectNearestPlayer, SelectRandomPlayer, SelectSelf:
+	case SelectAllPlayers:
+		if p.Single {
+			return nil, Fail("Only one entity is allowed, but the provided selector allows more than one")
+		}
+	case SelectAllEntities:
+		if p.Single {
+			return nil, Fail("Only one entity is allowed, but the provided selector allows more than one")
+		}
+		if p.PlayersOnly {
+			return nil, Fail("Only players may be affected by this command, but the provided selector includes entities")
+		}
+	default:
+		return nil, Fail("Unknown selector type '%s'", word)
+	}
+	return Entities{Selector: word}, nil
+}
+
+// Coordinate - coordinate of the position argument, either absolute or relative to the position of the sender.
+type Coordinate struct {
+	Value      float64
+	IsRelative bool
+}
+
+func (c Coordinate) resolve(origin float64) float64 {
+	if c.IsRelative {
+		return origin + c.Value
+	}
+	return c.Value
+}
+
+// Coordinates - X, Y and Z of the position argument.
+// DEBT local coordinates, e.g. ^ ^ ^1, are not supported.
+type Coordinates [3]Coordinate
+
+// Resolve provides the position, relative coordinates are resolved against the given origin.
+func (c Coordinates) Resolve(origin data.PositionF) data.PositionF {
+	return data.PositionF{X: c[0].resolve(origin.X), Y: c[1].resolve(origin.Y), Z: c[2].resolve(origin.Z)}
+}
+
+// BlockPosParser parses the block position, provided as Coordinates.
+type BlockPosParser struct{}
+
+func (p BlockPosParser) ID() string                      { return "minecraft:block_pos" }
+func (p BlockPosParser) PushProperties(_ *buffer.Buffer) {}
+func (p BlockPosParser) Parse(reader *Reader) (interface{}, error) {
+	return parseCoordinates(reader, true)
+}
+
+// Vec3Parser parses the precise position, provided as Coordinates. Absolute whole X and Z point to the block centre,
+// same as in the Notchian server.
+type Vec3Parser struct{}
+
+func (p Vec3Parser) ID() string                      { return "minecraft:vec3" }
+func (p Vec3Parser) PushProperties(_ *buffer.Buffer) {}
+func (p Vec3Parser) Parse(reader *Reader) (interface{}, error) {
+	return parseCoordinates(reader, false)
+}
+
+func parseCoordinates(reader *Reader, isBlock bool) (Coordinates, error) {
+	var coords Coordinates
+	for i := range coords {
+		if i > 0 {
+			if !reader.CanRead() || reader.Peek() != argumentSeparator {
+				return coords, Fail("Incomplete (expected 3 coordinates)")
+			}
+			reader.Skip()
+		}
+
+		word := reader.ReadWord()
+		if strings.HasPrefix(word, "^") {
+			return coords, Fail("Local coordinates are not supported")
+		}
+		if strings.HasPrefix(word, "~") {
+			coords[i].IsRelative = true
+			word = word[1:]
+			if word == "" {
+				continue
+			}
+		}
+
+		if isBlock && !coords[i].IsRelative {
+			value, err := strconv.ParseInt(word, 10, 32)
+			if err != nil {
+				return coords, Fail("Invalid integer '%s'", word)
+			}
+			coords[i].Value = float64(value)
+			continue
+		}
+
+		value, err := strconv.ParseFloat(word, 64)
+		if err != nil {
+			return coords, Fail("Invalid double '%s'", word)
+		}
+		coords[i].Value = value
+		if !isBlock && !coords[i].IsRelative && i != 1 && !strings.Contains(word, ".") {
+			coords[i].Value += 0.5
+		}
+	}
+	return coords, nil
+}
+
+// ItemStackParser parses the item, provided as objects.ItemID.
+// DEBT item NBT, e.g. diamond_sword{Damage:10}, is not supported.
+type ItemStackParser struct{}
+
+func (p ItemStackParser) ID() string                      { return "minecraft:item_stack" }
+func (p ItemStackParser) PushProperties(_ *buffer.Buffer) {}
+func (p ItemStackParser) Parse(reader *Reader) (interface{}, error) {
+	word := reader.ReadWord()
+	name := word
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+
+	itemID, ok := objects.ItemByName(name)
+	if !ok || itemID == objects.ItemAir {
+		return nil, Fail("Unknown item '%s'", word)
+	}
+	return itemID, nil
+}
+
+// MessageParser parses everything left of the input as a message.
+type MessageParser struct{}
+
+func (p MessageParser) ID() string                      { return "minecraft:message" }
+func (p MessageParser) PushProperties(_ *buffer.Buffer) {}
+func (p MessageParser) Parse(reader *Reader) (interface{}, error) {
+	return reader.ReadRemaining(), nil
+}
+
+// TimeParser parses the duration, provided in game ticks. Durations in days and seconds are suffixed with
+// 'd' and 's' respectively, ticks may be suffixed with 't'.
+type TimeParser struct{}
+
+// Durations of the time units, in game ticks.
+const (
+	ticksPerSecond = 20
+	ticksPerDay    = 24000
+)
+
+func (p TimeParser) ID() string                      { return "minecraft:time" }
+func (p TimeParser) PushProperties(_ *buffer.Buffer) {}
+func (p TimeParser) Parse(reader *Reader) (interface{}, error) {
+	word := reader.ReadWord()
+
+	number, unit := word, 1.0
+	switch {
+	case strings.HasSuffix(word, "d"):
+		number, unit = word[:len(word)-1], ticksPerDay
+	case strings.HasSuffix(word, "s"):
+		number, unit = word[:len(word)-1], ticksPerSecond
+	case strings.HasSuffix(word, "t"):
+		number = word[:len(word)-1]
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return nil, Fail("Invalid time '%s'", word)
+	}
+	ticks := math.Round(value * unit)
+	if ticks < 0 {
+		return nil, Fail("Tick count must be non-negative")
+	}
+	if ticks > math.MaxInt32 {
+		return nil, Fail("Invalid time '%s'", word)
+	}
+	return int32(ticks), nil
+}
@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"strings"
+)
+
+const (
+	argumentSeparator = ' '
+	quote             = '"'
+	escape            = '\\'
+)
+
+// Reader reads the command input argument by argument, keeping track of the position it has read up to.
+type Reader struct {
+	input  string
+	cursor int
+}
+
+func NewReader(input string) *Reader {
+	return &Reader{input: input}
+}
+
+// Cursor provides the position of the next character to read.
+func (r *Reader) Cursor() int { return r.cursor }
+
+func (r *Reader) CanRead() bool { return r.cursor < len(r.input) }
+
+func (r *Reader) Peek() byte { return r.input[r.cursor] }
+
+func (r *Reader) Skip() { r.cursor++ }
+
+// ReadWord reads up to the next argument separator, or up to the end of input.
+func (r *Reader) ReadWord() string {
+	start := r.cursor
+	for r.CanRead() && r.Peek() != argumentSeparator {
+		r.cursor++
+	}
+	return r.input[start:r.cursor]
+}
+
+// ReadQuotable reads the quoted string with the quotes and escapes removed, or a word if it is not quoted.
+func (r *Reader) ReadQuotable() (string, error) {
+	if !r.CanRead() || r.Peek() != quote {
+		return r.ReadWord(), nil
+	}
+	r.Skip()
+
+	var read strings.Builder
+	var isEscaped bool
+	for r.CanRead() {
+		c := r.Peek()
+		r.Skip()
+		switch {
+		case isEscaped:
+			if c != quote && c != escape {
+				return "", Fail("Invalid escape sequence '%c' in quoted string", c)
+			}
+			read.WriteByte(c)
+			isEscaped = false
+		case c == escape:
+			isEscaped = true
+		case c == quote:
+			return read.String(), nil
+		default:
+			read.WriteByte(c)
+		}
+	}
+	return "", Fail("Unclosed quoted string")
+}
+
+// ReadRemaining reads everything left of the input.
+func (r *Reader) ReadRemaining() string {
+	remaining := r.input[r.cursor:]
+	r.cursor = len(r.input)
+	return remaining
+}
@@ -81,6 +81,30 @@ func PlayerInventoryUpdate(inventoryUpdate *pb.PlayerInventoryUpdate) *E {
 	}
 }
 
+func PlayerTeleport(teleport *pb.PlayerTeleport) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			Message: &pb.Envelope_PlayerTeleport{PlayerTeleport: teleport},
+		},
+	}
+}
+
+func ChatMessage(message *pb.ChatMessage) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			Message: &pb.Envelope_ChatMessage{ChatMessage: message},
+		},
+	}
+}
+
+func PlayerKick(kick *pb.PlayerKick) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			Message: &pb.Envelope_PlayerKick{PlayerKick: kick},
+		},
+	}
+}
+
 func PlayerDigging(digging *pb.PlayerDigging) *E {
 	return &E{
 		Envelope: pb.Envelope{
@@ -90,3 +114,43 @@ func PlayerDigging(digging *pb.PlayerDigging) *E {
 		},
 	}
 }
+
+func PlayerBlockPlacement(placement *pb.PlayerBlockPlacement) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			ShardEvent: &pb.ShardEvent{
+				Event: &pb.ShardEvent_PlayerBlockPlacement{PlayerBlockPlacement: placement},
+			},
+		},
+	}
+}
+
+func PlayerDroppedItem(dropped *pb.PlayerDroppedItem) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			ShardEvent: &pb.ShardEvent{
+				Event: &pb.ShardEvent_PlayerDroppedItem{PlayerDroppedItem: dropped},
+			},
+		},
+	}
+}
+
+func PlayerQueriedBlockNBT(query *pb.PlayerQueriedBlockNBT) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			ShardEvent: &pb.ShardEvent{
+				Event: &pb.ShardEvent_PlayerQueriedBlockNbt{PlayerQueriedBlockNbt: query},
+			},
+		},
+	}
+}
+
+func PlayerUpdatedSign(update *pb.PlayerUpdatedSign) *E {
+	return &E{
+		Envelope: pb.Envelope{
+			ShardEvent: &pb.ShardEvent{
+				Event: &pb.ShardEvent_PlayerUpdatedSign{PlayerUpdatedSign: update},
+			},
+		},
+	}
+}
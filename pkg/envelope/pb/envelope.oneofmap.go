@@ -14,4 +14,7 @@ const (
 	Message_PlayerLeft      OneOfMessage = "PlayerLeft"
 	Message_PlayerSpatial   OneOfMessage = "PlayerSpatial"
 	Message_PlayerInventory OneOfMessage = "PlayerInventory"
+	Message_PlayerTeleport  OneOfMessage = "PlayerTeleport"
+	Message_ChatMessage     OneOfMessage = "ChatMessage"
+	Message_PlayerKick      OneOfMessage = "PlayerKick"
 )
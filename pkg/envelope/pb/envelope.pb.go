@@ -42,6 +42,9 @@ type Envelope struct {
 	//	*Envelope_PlayerLeft
 	//	*Envelope_PlayerSpatial
 	//	*Envelope_PlayerInventory
+	//	*Envelope_PlayerTeleport
+	//	*Envelope_ChatMessage
+	//	*Envelope_PlayerKick
 	Message isEnvelope_Message `protobuf_oneof:"message"`
 }
 
@@ -154,6 +157,27 @@ func (x *Envelope) GetPlayerInventory() *PlayerInventoryUpdate {
 	return nil
 }
 
+func (x *Envelope) GetPlayerTeleport() *PlayerTeleport {
+	if x, ok := x.GetMessage().(*Envelope_PlayerTeleport); ok {
+		return x.PlayerTeleport
+	}
+	return nil
+}
+
+func (x *Envelope) GetChatMessage() *ChatMessage {
+	if x, ok := x.GetMessage().(*Envelope_ChatMessage); ok {
+		return x.ChatMessage
+	}
+	return nil
+}
+
+func (x *Envelope) GetPlayerKick() *PlayerKick {
+	if x, ok := x.GetMessage().(*Envelope_PlayerKick); ok {
+		return x.PlayerKick
+	}
+	return nil
+}
+
 type isEnvelope_Message interface {
 	isEnvelope_Message()
 }
@@ -190,6 +214,18 @@ type Envelope_PlayerInventory struct {
 	PlayerInventory *PlayerInventoryUpdate `protobuf:"bytes,10,opt,name=player_inventory,json=playerInventory,proto3,oneof"`
 }
 
+type Envelope_PlayerTeleport struct {
+	PlayerTeleport *PlayerTeleport `protobuf:"bytes,11,opt,name=player_teleport,json=playerTeleport,proto3,oneof"`
+}
+
+type Envelope_ChatMessage struct {
+	ChatMessage *ChatMessage `protobuf:"bytes,12,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
+type Envelope_PlayerKick struct {
+	PlayerKick *PlayerKick `protobuf:"bytes,13,opt,name=player_kick,json=playerKick,proto3,oneof"`
+}
+
 func (*Envelope_Cpacket) isEnvelope_Message() {}
 
 func (*Envelope_Spacket) isEnvelope_Message() {}
@@ -206,13 +242,19 @@ func (*Envelope_PlayerSpatial) isEnvelope_Message() {}
 
 func (*Envelope_PlayerInventory) isEnvelope_Message() {}
 
+func (*Envelope_PlayerTeleport) isEnvelope_Message() {}
+
+func (*Envelope_ChatMessage) isEnvelope_Message() {}
+
+func (*Envelope_PlayerKick) isEnvelope_Message() {}
+
 var File_envelope_proto protoreflect.FileDescriptor
 
 var file_envelope_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x07, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x64,
 	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x91, 0x05,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x06,
 	0x0a, 0x08, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x65,
 	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61,
 	0x66, 0x74, 0x2e, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61,
@@ -249,15 +291,26 @@ var file_envelope_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6e, 0x63,
 	0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e,
 	0x74, 0x6f, 0x72, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x1a, 0x37, 0x0a,
-	0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x6c, 0x65, 0x78, 0x79, 0x6b, 0x6f, 0x74, 0x2f, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x79, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a,
+	0x0f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x54, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x63, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6b, 0x69, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x4b, 0x69, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4b, 0x69, 0x63, 0x6b, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x78, 0x79, 0x6b, 0x6f, 0x74, 0x2f,
+	0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -285,6 +338,9 @@ var file_envelope_proto_goTypes = []interface{}{
 	(*PlayerLeft)(nil),            // 8: cncraft.PlayerLeft
 	(*PlayerSpatialUpdate)(nil),   // 9: cncraft.PlayerSpatialUpdate
 	(*PlayerInventoryUpdate)(nil), // 10: cncraft.PlayerInventoryUpdate
+	(*PlayerTeleport)(nil),        // 11: cncraft.PlayerTeleport
+	(*ChatMessage)(nil),           // 12: cncraft.ChatMessage
+	(*PlayerKick)(nil),            // 13: cncraft.PlayerKick
 }
 var file_envelope_proto_depIdxs = []int32{
 	1,  // 0: cncraft.Envelope.meta:type_name -> cncraft.Envelope.MetaEntry
@@ -297,11 +353,14 @@ var file_envelope_proto_depIdxs = []int32{
 	8,  // 7: cncraft.Envelope.player_left:type_name -> cncraft.PlayerLeft
 	9,  // 8: cncraft.Envelope.player_spatial:type_name -> cncraft.PlayerSpatialUpdate
 	10, // 9: cncraft.Envelope.player_inventory:type_name -> cncraft.PlayerInventoryUpdate
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	11, // 10: cncraft.Envelope.player_teleport:type_name -> cncraft.PlayerTeleport
+	12, // 11: cncraft.Envelope.chat_message:type_name -> cncraft.ChatMessage
+	13, // 12: cncraft.Envelope.player_kick:type_name -> cncraft.PlayerKick
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_envelope_proto_init() }
@@ -334,6 +393,9 @@ func file_envelope_proto_init() {
 		(*Envelope_PlayerLeft)(nil),
 		(*Envelope_PlayerSpatial)(nil),
 		(*Envelope_PlayerInventory)(nil),
+		(*Envelope_PlayerTeleport)(nil),
+		(*Envelope_ChatMessage)(nil),
+		(*Envelope_PlayerKick)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
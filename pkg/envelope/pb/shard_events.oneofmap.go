@@ -6,5 +6,9 @@ package pb
 type OneOfEvent string
 
 const (
-	Event_PlayerDigging OneOfEvent = "PlayerDigging"
+	Event_PlayerDigging         OneOfEvent = "PlayerDigging"
+	Event_PlayerBlockPlacement  OneOfEvent = "PlayerBlockPlacement"
+	Event_PlayerDroppedItem     OneOfEvent = "PlayerDroppedItem"
+	Event_PlayerQueriedBlockNbt OneOfEvent = "PlayerQueriedBlockNbt"
+	Event_PlayerUpdatedSign     OneOfEvent = "PlayerUpdatedSign"
 )
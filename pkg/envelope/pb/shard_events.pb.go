@@ -145,6 +145,52 @@ func (PlayerDigging_Action) EnumDescriptor() ([]byte, []int) {
 	return file_shard_events_proto_rawDescGZIP(), []int{1, 0}
 }
 
+type PlayerBlockPlacement_Hand int32
+
+const (
+	PlayerBlockPlacement_MAIN_HAND PlayerBlockPlacement_Hand = 0
+	PlayerBlockPlacement_OFF_HAND  PlayerBlockPlacement_Hand = 1
+)
+
+// Enum value maps for PlayerBlockPlacement_Hand.
+var (
+	PlayerBlockPlacement_Hand_name = map[int32]string{
+		0: "MAIN_HAND",
+		1: "OFF_HAND",
+	}
+	PlayerBlockPlacement_Hand_value = map[string]int32{
+		"MAIN_HAND": 0,
+		"OFF_HAND":  1,
+	}
+)
+
+func (x PlayerBlockPlacement_Hand) Enum() *PlayerBlockPlacement_Hand {
+	p := new(PlayerBlockPlacement_Hand)
+	*p = x
+	return p
+}
+
+func (x PlayerBlockPlacement_Hand) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerBlockPlacement_Hand) Descriptor() protoreflect.EnumDescriptor {
+	return file_shard_events_proto_enumTypes[2].Descriptor()
+}
+
+func (PlayerBlockPlacement_Hand) Type() protoreflect.EnumType {
+	return &file_shard_events_proto_enumTypes[2]
+}
+
+func (x PlayerBlockPlacement_Hand) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerBlockPlacement_Hand.Descriptor instead.
+func (PlayerBlockPlacement_Hand) EnumDescriptor() ([]byte, []int) {
+	return file_shard_events_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type ShardEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -152,6 +198,10 @@ type ShardEvent struct {
 
 	// Types that are assignable to Event:
 	//	*ShardEvent_PlayerDigging
+	//	*ShardEvent_PlayerBlockPlacement
+	//	*ShardEvent_PlayerDroppedItem
+	//	*ShardEvent_PlayerQueriedBlockNbt
+	//	*ShardEvent_PlayerUpdatedSign
 	Event isShardEvent_Event `protobuf_oneof:"event"`
 }
 
@@ -201,6 +251,34 @@ func (x *ShardEvent) GetPlayerDigging() *PlayerDigging {
 	return nil
 }
 
+func (x *ShardEvent) GetPlayerBlockPlacement() *PlayerBlockPlacement {
+	if x, ok := x.GetEvent().(*ShardEvent_PlayerBlockPlacement); ok {
+		return x.PlayerBlockPlacement
+	}
+	return nil
+}
+
+func (x *ShardEvent) GetPlayerDroppedItem() *PlayerDroppedItem {
+	if x, ok := x.GetEvent().(*ShardEvent_PlayerDroppedItem); ok {
+		return x.PlayerDroppedItem
+	}
+	return nil
+}
+
+func (x *ShardEvent) GetPlayerQueriedBlockNbt() *PlayerQueriedBlockNBT {
+	if x, ok := x.GetEvent().(*ShardEvent_PlayerQueriedBlockNbt); ok {
+		return x.PlayerQueriedBlockNbt
+	}
+	return nil
+}
+
+func (x *ShardEvent) GetPlayerUpdatedSign() *PlayerUpdatedSign {
+	if x, ok := x.GetEvent().(*ShardEvent_PlayerUpdatedSign); ok {
+		return x.PlayerUpdatedSign
+	}
+	return nil
+}
+
 type isShardEvent_Event interface {
 	isShardEvent_Event()
 }
@@ -209,8 +287,32 @@ type ShardEvent_PlayerDigging struct {
 	PlayerDigging *PlayerDigging `protobuf:"bytes,1,opt,name=player_digging,json=playerDigging,proto3,oneof"`
 }
 
+type ShardEvent_PlayerBlockPlacement struct {
+	PlayerBlockPlacement *PlayerBlockPlacement `protobuf:"bytes,2,opt,name=player_block_placement,json=playerBlockPlacement,proto3,oneof"`
+}
+
+type ShardEvent_PlayerDroppedItem struct {
+	PlayerDroppedItem *PlayerDroppedItem `protobuf:"bytes,3,opt,name=player_dropped_item,json=playerDroppedItem,proto3,oneof"`
+}
+
+type ShardEvent_PlayerQueriedBlockNbt struct {
+	PlayerQueriedBlockNbt *PlayerQueriedBlockNBT `protobuf:"bytes,4,opt,name=player_queried_block_nbt,json=playerQueriedBlockNbt,proto3,oneof"`
+}
+
+type ShardEvent_PlayerUpdatedSign struct {
+	PlayerUpdatedSign *PlayerUpdatedSign `protobuf:"bytes,5,opt,name=player_updated_sign,json=playerUpdatedSign,proto3,oneof"`
+}
+
 func (*ShardEvent_PlayerDigging) isShardEvent_Event() {}
 
+func (*ShardEvent_PlayerBlockPlacement) isShardEvent_Event() {}
+
+func (*ShardEvent_PlayerDroppedItem) isShardEvent_Event() {}
+
+func (*ShardEvent_PlayerQueriedBlockNbt) isShardEvent_Event() {}
+
+func (*ShardEvent_PlayerUpdatedSign) isShardEvent_Event() {}
+
 // Updates position of the player
 type PlayerDigging struct {
 	state         protoimpl.MessageState
@@ -283,48 +385,380 @@ func (x *PlayerDigging) GetBlockFace() BlockFace {
 	return BlockFace_BOTTOM
 }
 
+// Player placing a block from the held item
+type PlayerBlockPlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId     string                    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Hand         PlayerBlockPlacement_Hand `protobuf:"varint,2,opt,name=hand,proto3,enum=cncraft.PlayerBlockPlacement_Hand" json:"hand,omitempty"`
+	Pos          *Position                 `protobuf:"bytes,3,opt,name=pos,proto3" json:"pos,omitempty"` // position of the block clicked, not of the block being placed
+	BlockFace    BlockFace                 `protobuf:"varint,4,opt,name=block_face,json=blockFace,proto3,enum=cncraft.BlockFace" json:"block_face,omitempty"`
+	PlaceShardId string                    `protobuf:"bytes,5,opt,name=place_shard_id,json=placeShardId,proto3" json:"place_shard_id,omitempty"` // shard of the block being placed, if it is not placed into the clicked block
+}
+
+func (x *PlayerBlockPlacement) Reset() {
+	*x = PlayerBlockPlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shard_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerBlockPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerBlockPlacement) ProtoMessage() {}
+
+func (x *PlayerBlockPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerBlockPlacement.ProtoReflect.Descriptor instead.
+func (*PlayerBlockPlacement) Descriptor() ([]byte, []int) {
+	return file_shard_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlayerBlockPlacement) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerBlockPlacement) GetHand() PlayerBlockPlacement_Hand {
+	if x != nil {
+		return x.Hand
+	}
+	return PlayerBlockPlacement_MAIN_HAND
+}
+
+func (x *PlayerBlockPlacement) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *PlayerBlockPlacement) GetBlockFace() BlockFace {
+	if x != nil {
+		return x.BlockFace
+	}
+	return BlockFace_BOTTOM
+}
+
+func (x *PlayerBlockPlacement) GetPlaceShardId() string {
+	if x != nil {
+		return x.PlaceShardId
+	}
+	return ""
+}
+
+// Player throwing an item out of the inventory
+type PlayerDroppedItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId  string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ItemId    int32  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemCount int32  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+}
+
+func (x *PlayerDroppedItem) Reset() {
+	*x = PlayerDroppedItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shard_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerDroppedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerDroppedItem) ProtoMessage() {}
+
+func (x *PlayerDroppedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerDroppedItem.ProtoReflect.Descriptor instead.
+func (*PlayerDroppedItem) Descriptor() ([]byte, []int) {
+	return file_shard_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlayerDroppedItem) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerDroppedItem) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *PlayerDroppedItem) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+// Player querying the block entity NBT of the block, for the debug screen
+type PlayerQueriedBlockNBT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId      string    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	TransactionId int32     `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Pos           *Position `protobuf:"bytes,3,opt,name=pos,proto3" json:"pos,omitempty"`
+}
+
+func (x *PlayerQueriedBlockNBT) Reset() {
+	*x = PlayerQueriedBlockNBT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shard_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerQueriedBlockNBT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerQueriedBlockNBT) ProtoMessage() {}
+
+func (x *PlayerQueriedBlockNBT) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerQueriedBlockNBT.ProtoReflect.Descriptor instead.
+func (*PlayerQueriedBlockNBT) Descriptor() ([]byte, []int) {
+	return file_shard_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PlayerQueriedBlockNBT) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerQueriedBlockNBT) GetTransactionId() int32 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *PlayerQueriedBlockNBT) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+// Player writing the text on the sign they placed
+type PlayerUpdatedSign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string    `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Pos      *Position `protobuf:"bytes,2,opt,name=pos,proto3" json:"pos,omitempty"`
+	Lines    []string  `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"` // plain text lines, stripped of the formatting codes
+}
+
+func (x *PlayerUpdatedSign) Reset() {
+	*x = PlayerUpdatedSign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shard_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerUpdatedSign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerUpdatedSign) ProtoMessage() {}
+
+func (x *PlayerUpdatedSign) ProtoReflect() protoreflect.Message {
+	mi := &file_shard_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerUpdatedSign.ProtoReflect.Descriptor instead.
+func (*PlayerUpdatedSign) Descriptor() ([]byte, []int) {
+	return file_shard_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlayerUpdatedSign) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PlayerUpdatedSign) GetPos() *Position {
+	if x != nil {
+		return x.Pos
+	}
+	return nil
+}
+
+func (x *PlayerUpdatedSign) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
 var File_shard_events_proto protoreflect.FileDescriptor
 
 var file_shard_events_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x0c, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x0a, 0x53,
-	0x68, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69,
-	0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x70, 0x6f, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x31,
-	0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x46, 0x61, 0x63, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x61, 0x63,
-	0x65, 0x22, 0xa4, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10,
-	0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x44,
-	0x49, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x4e, 0x49,
-	0x53, 0x48, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13,
-	0x0a, 0x0f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x43,
-	0x4b, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d,
-	0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x4f, 0x54, 0x5f, 0x41, 0x52, 0x52, 0x4f,
-	0x57, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x5f, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10,
-	0x05, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x49,
-	0x4e, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x10, 0x06, 0x2a, 0x4a, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x46, 0x61, 0x63, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f,
-	0x52, 0x54, 0x48, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x4f, 0x55, 0x54, 0x48, 0x10, 0x03,
-	0x12, 0x08, 0x0a, 0x04, 0x57, 0x45, 0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41,
-	0x53, 0x54, 0x10, 0x05, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x78, 0x79, 0x6b, 0x6f, 0x74, 0x2f, 0x63, 0x6e, 0x63, 0x72,
-	0x61, 0x66, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65,
-	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x03, 0x0a, 0x0a,
+	0x53, 0x68, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x16, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6e,
+	0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x14, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x48, 0x00, 0x52, 0x11, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x59, 0x0a, 0x18, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x62, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x42, 0x54, 0x48, 0x00, 0x52, 0x15, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x62, 0x74, 0x12, 0x4c, 0x0a, 0x13, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61,
+	0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x44, 0x69, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x44, 0x69, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x31, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x46, 0x61, 0x63, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x61, 0x63, 0x65,
+	0x22, 0xa4, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x44, 0x49,
+	0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x4e, 0x49, 0x53,
+	0x48, 0x45, 0x44, 0x5f, 0x44, 0x49, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x43, 0x4b,
+	0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10,
+	0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x4f, 0x4f, 0x54, 0x5f, 0x41, 0x52, 0x52, 0x4f, 0x57,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x5f, 0x45, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05,
+	0x12, 0x15, 0x0a, 0x11, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x49, 0x4e,
+	0x5f, 0x48, 0x41, 0x4e, 0x44, 0x10, 0x06, 0x22, 0x8e, 0x02, 0x0a, 0x14, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6e,
+	0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x61,
+	0x63, 0x65, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x61, 0x63, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x64, 0x49, 0x64, 0x22, 0x23, 0x0a, 0x04, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4d,
+	0x41, 0x49, 0x4e, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x46,
+	0x46, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x68, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x51, 0x75, 0x65,
+	0x72, 0x69, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x42, 0x54, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x03, 0x70, 0x6f, 0x73, 0x22, 0x6b, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x2a, 0x4a, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x61, 0x63, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x54,
+	0x4f, 0x50, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f, 0x52, 0x54, 0x48, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x53, 0x4f, 0x55, 0x54, 0x48, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x45,
+	0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x53, 0x54, 0x10, 0x05, 0x42, 0x2d,
+	0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65,
+	0x78, 0x79, 0x6b, 0x6f, 0x74, 0x2f, 0x63, 0x6e, 0x63, 0x72, 0x61, 0x66, 0x74, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -339,25 +773,39 @@ func file_shard_events_proto_rawDescGZIP() []byte {
 	return file_shard_events_proto_rawDescData
 }
 
-var file_shard_events_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_shard_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_shard_events_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_shard_events_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_shard_events_proto_goTypes = []interface{}{
-	(BlockFace)(0),            // 0: cncraft.BlockFace
-	(PlayerDigging_Action)(0), // 1: cncraft.PlayerDigging.Action
-	(*ShardEvent)(nil),        // 2: cncraft.ShardEvent
-	(*PlayerDigging)(nil),     // 3: cncraft.PlayerDigging
-	(*Position)(nil),          // 4: cncraft.Position
+	(BlockFace)(0),                 // 0: cncraft.BlockFace
+	(PlayerDigging_Action)(0),      // 1: cncraft.PlayerDigging.Action
+	(PlayerBlockPlacement_Hand)(0), // 2: cncraft.PlayerBlockPlacement.Hand
+	(*ShardEvent)(nil),             // 3: cncraft.ShardEvent
+	(*PlayerDigging)(nil),          // 4: cncraft.PlayerDigging
+	(*PlayerBlockPlacement)(nil),   // 5: cncraft.PlayerBlockPlacement
+	(*PlayerDroppedItem)(nil),      // 6: cncraft.PlayerDroppedItem
+	(*PlayerQueriedBlockNBT)(nil),  // 7: cncraft.PlayerQueriedBlockNBT
+	(*PlayerUpdatedSign)(nil),      // 8: cncraft.PlayerUpdatedSign
+	(*Position)(nil),               // 9: cncraft.Position
 }
 var file_shard_events_proto_depIdxs = []int32{
-	3, // 0: cncraft.ShardEvent.player_digging:type_name -> cncraft.PlayerDigging
-	1, // 1: cncraft.PlayerDigging.action:type_name -> cncraft.PlayerDigging.Action
-	4, // 2: cncraft.PlayerDigging.pos:type_name -> cncraft.Position
-	0, // 3: cncraft.PlayerDigging.block_face:type_name -> cncraft.BlockFace
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4,  // 0: cncraft.ShardEvent.player_digging:type_name -> cncraft.PlayerDigging
+	5,  // 1: cncraft.ShardEvent.player_block_placement:type_name -> cncraft.PlayerBlockPlacement
+	6,  // 2: cncraft.ShardEvent.player_dropped_item:type_name -> cncraft.PlayerDroppedItem
+	7,  // 3: cncraft.ShardEvent.player_queried_block_nbt:type_name -> cncraft.PlayerQueriedBlockNBT
+	8,  // 4: cncraft.ShardEvent.player_updated_sign:type_name -> cncraft.PlayerUpdatedSign
+	1,  // 5: cncraft.PlayerDigging.action:type_name -> cncraft.PlayerDigging.Action
+	9,  // 6: cncraft.PlayerDigging.pos:type_name -> cncraft.Position
+	0,  // 7: cncraft.PlayerDigging.block_face:type_name -> cncraft.BlockFace
+	2,  // 8: cncraft.PlayerBlockPlacement.hand:type_name -> cncraft.PlayerBlockPlacement.Hand
+	9,  // 9: cncraft.PlayerBlockPlacement.pos:type_name -> cncraft.Position
+	0,  // 10: cncraft.PlayerBlockPlacement.block_face:type_name -> cncraft.BlockFace
+	9,  // 11: cncraft.PlayerQueriedBlockNBT.pos:type_name -> cncraft.Position
+	9,  // 12: cncraft.PlayerUpdatedSign.pos:type_name -> cncraft.Position
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_shard_events_proto_init() }
@@ -391,17 +839,69 @@ func file_shard_events_proto_init() {
 				return nil
 			}
 		}
+		file_shard_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerBlockPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shard_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerDroppedItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shard_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerQueriedBlockNBT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shard_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerUpdatedSign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_shard_events_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*ShardEvent_PlayerDigging)(nil),
+		(*ShardEvent_PlayerBlockPlacement)(nil),
+		(*ShardEvent_PlayerDroppedItem)(nil),
+		(*ShardEvent_PlayerQueriedBlockNbt)(nil),
+		(*ShardEvent_PlayerUpdatedSign)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shard_events_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,13 @@ type PositionF struct {
 	Z float64
 }
 
+// VelocityF - velocity in blocks per tick.
+type VelocityF struct {
+	X float64
+	Y float64
+	Z float64
+}
+
 type RotationF struct {
 	Yaw   float32
 	Pitch float32
@@ -63,6 +70,15 @@ func (p PositionF) ToInt() PositionI {
 	}
 }
 
+// ToBlock provides the position of the block containing this position.
+func (p PositionF) ToBlock() PositionI {
+	return PositionI{
+		X: int64(math.Floor(p.X)),
+		Y: int64(math.Floor(p.Y)),
+		Z: int64(math.Floor(p.Z)),
+	}
+}
+
 func (p PositionF) String() string {
 	return fmt.Sprintf("%f:%f:%f", p.X, p.Y, p.Z)
 }
@@ -75,6 +91,25 @@ func (p PositionI) ToFloat() PositionF {
 	}
 }
 
+// Facing provides the position of the neighbouring block on the given face of this block.
+func (p PositionI) Facing(face pb.BlockFace) PositionI {
+	switch face {
+	case pb.BlockFace_BOTTOM:
+		p.Y--
+	case pb.BlockFace_TOP:
+		p.Y++
+	case pb.BlockFace_NORTH:
+		p.Z--
+	case pb.BlockFace_SOUTH:
+		p.Z++
+	case pb.BlockFace_WEST:
+		p.X--
+	case pb.BlockFace_EAST:
+		p.X++
+	}
+	return p
+}
+
 func (p PositionI) String() string {
 	return fmt.Sprintf("%d:%d:%d", p.X, p.Y, p.Z)
 }
@@ -63,13 +63,16 @@ func (pc *playerCharacter) SetGameMode(mode game.Gamemode) {
 	}
 }
 
-func NewPC(name string, maxHealth float32) PlayerCharacter {
-	// DEBT no idea how entity IDs are to be generated
-	atomic.AddInt32(&entityCounter, 1)
+// nextEntityID provides a new entity ID, unique within this server.
+// DEBT entity IDs will need to be unique across the cluster.
+func nextEntityID() int32 {
+	return atomic.AddInt32(&entityCounter, 1)
+}
 
+func NewPC(name string, maxHealth float32) PlayerCharacter {
 	return &playerCharacter{
 		entity: entity{
-			id:   entityCounter,
+			id:   nextEntityID(),
 			name: name,
 		},
 		living: living{
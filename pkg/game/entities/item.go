@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"math"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+)
+
+// Notchian item entity physics constants, per tick.
+const (
+	itemGravity        = 0.04
+	itemDrag           = 0.98
+	itemGroundFriction = 0.6 // slipperiness of most blocks, DEBT ice and slime blocks are more slippery
+	itemHeight         = 0.25
+	itemMergeDistance  = 0.5
+	itemRestVelocity   = 0.001 // velocity below this is considered to be zero, i.e. the item comes to rest
+)
+
+// ItemEntityType - protocol ID of the item entity type.
+const ItemEntityType = 37
+
+// ItemDespawnTicks - item entities disappear after lying in the world for this many ticks, i.e. 5 minutes.
+const ItemDespawnTicks = 6000
+
+// Item pickup delays, in ticks.
+const (
+	BlockDropPickupDelay  = 10
+	PlayerDropPickupDelay = 40
+)
+
+// BlockCheck tells if the block at the given position is solid, i.e. stops entities moving into it.
+type BlockCheck func(blockPos data.PositionI) bool
+
+// Item - item stack lying in the world.
+type Item interface {
+	Entity
+
+	UUID() uuid.UUID
+	GetSlot() items.Slot
+	SetSlot(slot items.Slot)
+	GetPosition() data.PositionF
+	GetVelocity() data.VelocityF
+	IsOnGround() bool
+	Age() int64
+
+	// CanBePickedUp tells if the pickup delay has passed.
+	CanBePickedUp() bool
+	// IsExpired tells if the item has been lying in the world long enough to despawn.
+	IsExpired() bool
+	// Tick advances item age and physics by one tick, tells if the item has moved.
+	Tick(isSolid BlockCheck) bool
+	// MergeWith adds the other stack to this one if they are of the same item, close enough, and fit into one stack.
+	MergeWith(other Item) bool
+}
+
+type item struct {
+	entity
+
+	uuid        uuid.UUID
+	slot        items.Slot
+	position    data.PositionF
+	velocity    data.VelocityF
+	onGround    bool
+	age         int64
+	pickupDelay int64
+}
+
+func NewItem(slot items.Slot, position data.PositionF, velocity data.VelocityF, pickupDelay int64) Item {
+	return &item{
+		entity: entity{
+			id:   nextEntityID(),
+			name: slot.ItemID.String(),
+		},
+		uuid:        uuid.New(),
+		slot:        slot,
+		position:    position,
+		velocity:    velocity,
+		pickupDelay: pickupDelay,
+	}
+}
+
+func (i *item) UUID() uuid.UUID             { return i.uuid }
+func (i *item) GetSlot() items.Slot         { return i.slot }
+func (i *item) SetSlot(slot items.Slot)     { i.slot = slot }
+func (i *item) GetPosition() data.PositionF { return i.position }
+func (i *item) GetVelocity() data.VelocityF { return i.velocity }
+func (i *item) IsOnGround() bool            { return i.onGround }
+func (i *item) Age() int64                  { return i.age }
+func (i *item) CanBePickedUp() bool         { return i.age >= i.pickupDelay }
+func (i *item) IsExpired() bool             { return i.age >= ItemDespawnTicks }
+
+// Tick applies gravity and drag to the item and moves it, stopping it at solid blocks.
+// DEBT this is a crude approximation of the Notchian physics, the item is treated as a point horizontally,
+//  and it does not float in water or get pushed out of blocks.
+func (i *item) Tick(isSolid BlockCheck) bool {
+	i.age++
+
+	i.velocity.Y -= itemGravity
+	start := i.position
+
+	next := i.position
+	next.Y += i.velocity.Y
+	if i.velocity.Y < 0 && isSolid(next.ToBlock()) {
+		next.Y = math.Floor(i.position.Y)
+		i.velocity.Y = 0
+		i.onGround = true
+	} else if i.velocity.Y > 0 && isSolid(data.PositionF{X: next.X, Y: next.Y + itemHeight, Z: next.Z}.ToBlock()) {
+		next.Y = i.position.Y
+		i.velocity.Y = 0
+	} else {
+		i.onGround = false
+	}
+
+	next.X += i.velocity.X
+	if isSolid(next.ToBlock()) {
+		next.X = i.position.X
+		i.velocity.X = 0
+	}
+
+	next.Z += i.velocity.Z
+	if isSolid(next.ToBlock()) {
+		next.Z = i.position.Z
+		i.velocity.Z = 0
+	}
+	i.position = next
+
+	friction := itemDrag
+	if i.onGround {
+		friction *= itemGroundFriction
+	}
+	i.velocity.X = settle(i.velocity.X * friction)
+	i.velocity.Y = settle(i.velocity.Y * itemDrag)
+	i.velocity.Z = settle(i.velocity.Z * friction)
+
+	return i.position != start
+}
+
+func (i *item) MergeWith(other Item) bool {
+	otherSlot := other.GetSlot()
+	if otherSlot.ItemID != i.slot.ItemID || i.slot.ItemCount+otherSlot.ItemCount > i.slot.ItemID.MaxStack() {
+		return false
+	}
+
+	otherPos := other.GetPosition()
+	if math.Abs(otherPos.X-i.position.X) > itemMergeDistance ||
+		math.Abs(otherPos.Y-i.position.Y) > itemMergeDistance ||
+		math.Abs(otherPos.Z-i.position.Z) > itemMergeDistance {
+		return false
+	}
+
+	i.slot.ItemCount += otherSlot.ItemCount
+	if otherItem, ok := other.(*item); ok && otherItem.age < i.age {
+		i.age = otherItem.age // merged stack lives as long as the younger of the two
+	}
+	return true
+}
+
+// settle stops very slow movement, so resting items do not need to be updated every tick.
+func settle(velocity float64) float64 {
+	if math.Abs(velocity) < itemRestVelocity {
+		return 0
+	}
+	return velocity
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// flatGround - solid blocks up to y.4, air above.
+func flatGround(blockPos data.PositionI) bool { return blockPos.Y <= 4 }
+
+func dirt(count int16) items.Slot {
+	return items.Slot{IsPresent: true, ItemID: objects.ItemDirt, ItemCount: count}
+}
+
+func TestItemTick(t *testing.T) {
+	it := NewItem(dirt(1), data.PositionF{X: 0.5, Y: 10, Z: 0.5}, data.VelocityF{X: 0.1}, BlockDropPickupDelay)
+
+	var ticks int
+	for ; ticks < 100 && !it.IsOnGround(); ticks++ {
+		require.True(t, it.Tick(flatGround))
+	}
+
+	assert.True(t, it.IsOnGround())
+	assert.Equal(t, float64(5), it.GetPosition().Y)
+	assert.Greater(t, it.GetPosition().X, 0.5)
+	assert.True(t, it.CanBePickedUp())
+
+	for ; ticks < 200; ticks++ {
+		it.Tick(flatGround)
+	}
+	assert.False(t, it.Tick(flatGround), "item expected to come to rest")
+	assert.Equal(t, data.VelocityF{}, it.GetVelocity())
+	assert.False(t, it.IsExpired())
+}
+
+func TestItemMergeWith(t *testing.T) {
+	it := NewItem(dirt(10), data.PositionF{X: 0.5, Y: 5, Z: 0.5}, data.VelocityF{}, BlockDropPickupDelay)
+
+	near := NewItem(dirt(20), data.PositionF{X: 0.8, Y: 5, Z: 0.5}, data.VelocityF{}, BlockDropPickupDelay)
+	far := NewItem(dirt(20), data.PositionF{X: 2.5, Y: 5, Z: 0.5}, data.VelocityF{}, BlockDropPickupDelay)
+	other := NewItem(items.Slot{IsPresent: true, ItemID: objects.ItemBedrock, ItemCount: 1},
+		data.PositionF{X: 0.5, Y: 5, Z: 0.5}, data.VelocityF{}, BlockDropPickupDelay)
+	tooMany := NewItem(dirt(50), data.PositionF{X: 0.5, Y: 5, Z: 0.5}, data.VelocityF{}, BlockDropPickupDelay)
+
+	assert.False(t, it.MergeWith(far))
+	assert.False(t, it.MergeWith(other))
+	assert.True(t, it.MergeWith(near))
+	assert.Equal(t, int16(30), it.GetSlot().ItemCount)
+	assert.False(t, it.MergeWith(tooMany))
+}
@@ -0,0 +1,105 @@
+package items
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// WindowType - type of the window opened on the client, as per https://wiki.vg/Inventory
+type WindowType int32
+
+const (
+	WindowGeneric9x3 WindowType = 2
+	WindowGeneric9x6 WindowType = 5
+	WindowCrafting   WindowType = 11
+	WindowFurnace    WindowType = 13
+)
+
+// Container sizes, in slots.
+const (
+	ChestSize         = 27
+	craftingTableSize = 10 // result slot and the 3x3 crafting grid
+	furnaceSize       = 3
+)
+
+// craftingTableWidth - crafting table grid is 3x3.
+const craftingTableWidth = 3
+
+// Container - item slots of a block, e.g. a chest or a furnace. Container is shared by all players viewing it and
+// is also ticked by the shard it is in, so the container methods must only be called holding the container lock.
+type Container struct {
+	sync.Mutex
+
+	slots   []Slot
+	isDirty bool
+
+	viewers map[uuid.UUID]WindowID // window IDs of the players viewing the container, by player conn ID
+}
+
+func NewContainer(size int) *Container {
+	return &Container{
+		slots:   make([]Slot, size),
+		viewers: make(map[uuid.UUID]WindowID),
+	}
+}
+
+func (c *Container) Size() int16 { return int16(len(c.slots)) }
+
+func (c *Container) GetSlot(slotID int16) Slot {
+	if slotID < 0 || slotID >= c.Size() {
+		return Slot{}
+	}
+	return c.slots[slotID]
+}
+
+func (c *Container) SetSlot(slotID int16, item Slot) {
+	if slotID < 0 || slotID >= c.Size() {
+		return
+	}
+
+	item.IsPresent = item.ItemID != objects.ItemAir && item.ItemCount > 0
+	if !item.IsPresent {
+		item = Slot{}
+	}
+	c.slots[slotID] = item
+	c.isDirty = true
+}
+
+// Slots provides a copy of the container slots.
+func (c *Container) Slots() []Slot {
+	slots := make([]Slot, len(c.slots))
+	copy(slots, c.slots)
+	return slots
+}
+
+// TakeAll empties the container, providing everything that was in it, e.g. to be dropped when the block is broken.
+func (c *Container) TakeAll() []Slot {
+	var taken []Slot
+	for slotID, item := range c.slots {
+		if item.IsPresent {
+			taken = append(taken, item)
+		}
+		c.slots[slotID] = Slot{}
+	}
+	c.isDirty = true
+	return taken
+}
+
+// IsDirty tells if the container contents changed since it was last saved.
+func (c *Container) IsDirty() bool { return c.isDirty }
+func (c *Container) MarkSaved()    { c.isDirty = false }
+
+func (c *Container) AddViewer(connID uuid.UUID, windowID WindowID) { c.viewers[connID] = windowID }
+func (c *Container) RemoveViewer(connID uuid.UUID)                 { delete(c.viewers, connID) }
+
+// Viewers provides the window IDs of the players viewing the container, by player conn ID.
+func (c *Container) Viewers() map[uuid.UUID]WindowID {
+	viewers := make(map[uuid.UUID]WindowID, len(c.viewers))
+	for connID, windowID := range c.viewers {
+		viewers[connID] = windowID
+	}
+	return viewers
+}
@@ -0,0 +1,132 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestChestWindow(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar1, bedrock(10))
+
+	chest := NewContainer(ChestSize)
+	window := NewChestWindow(1, inv, chest)
+	assert.Equal(t, WindowGeneric9x3, window.Type)
+	require.Len(t, window.ToArray(), ChestSize+36)
+
+	hotbarSlotID := int16(ChestSize + 27)
+	assert.Equal(t, bedrock(10), window.GetSlot(hotbarSlotID), "inventory slots follow the chest slots")
+
+	_, isUpdated, err := window.HandleClick(1, hotbarSlotID, int16(shftClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.True(t, isUpdated)
+	assert.Equal(t, bedrock(10), chest.GetSlot(0), "shift+click moves from the inventory into the chest")
+	assert.Equal(t, empty(), inv.GetSlot(hotbar1))
+	assert.True(t, chest.IsDirty())
+
+	_, isUpdated, err = window.HandleClick(2, 0, int16(shftClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.True(t, isUpdated)
+	assert.Equal(t, bedrock(10), inv.GetSlot(rowTop1), "shift+click moves from the chest into the inventory")
+}
+
+func TestDoubleChestWindow(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	first, second := NewContainer(ChestSize), NewContainer(ChestSize)
+	second.SetSlot(0, bedrock(1))
+
+	window := NewChestWindow(1, inv, first, second)
+	assert.Equal(t, WindowGeneric9x6, window.Type)
+	assert.Equal(t, bedrock(1), window.GetSlot(ChestSize))
+
+	connID := uuid.New()
+	window.Open(connID)
+	assert.Equal(t, map[uuid.UUID]WindowID{connID: 1}, first.Viewers())
+	assert.Equal(t, map[uuid.UUID]WindowID{connID: 1}, second.Viewers())
+
+	assert.Empty(t, window.Close(connID))
+	assert.Empty(t, first.Viewers())
+	assert.Empty(t, second.Viewers())
+}
+
+func TestCraftingWindow(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	window := NewCraftingWindow(1, inv)
+
+	cobblestone := Slot{IsPresent: true, ItemID: objects.ItemCobblestone, ItemCount: 1}
+	for slotID := int16(1); slotID <= 9; slotID++ {
+		if slotID != 5 {
+			window.SetSlot(slotID, cobblestone)
+		}
+	}
+	furnace := Slot{IsPresent: true, ItemID: objects.ItemFurnace, ItemCount: 1}
+	require.Equal(t, furnace, window.GetSlot(resultSlot), "3x3 recipes are crafted in the crafting table")
+
+	_, isUpdated, err := window.HandleClick(1, resultSlot, int16(shftClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.True(t, isUpdated)
+	assert.Equal(t, furnace, inv.GetSlot(hotbar1))
+	assert.Equal(t, empty(), window.GetSlot(1))
+
+	window.SetSlot(1, cobblestone)
+	assert.Empty(t, window.Close(uuid.New()))
+	assert.Equal(t, cobblestone, inv.GetSlot(hotbar2), "crafting grid contents go back into the inventory on close")
+	assert.Equal(t, empty(), window.GetSlot(1))
+}
+
+func TestFurnace(t *testing.T) {
+	furnace := NewFurnace()
+	furnace.SetSlot(FurnaceInput, Slot{IsPresent: true, ItemID: objects.ItemIronOre, ItemCount: 1})
+
+	isUpdated, _ := furnace.Tick()
+	assert.False(t, isUpdated, "nothing happens without fuel")
+
+	furnace.SetSlot(FurnaceFuel, Slot{IsPresent: true, ItemID: objects.ItemCoal, ItemCount: 1})
+	isUpdated, isSlotsUpdated := furnace.Tick()
+	assert.True(t, isUpdated)
+	assert.True(t, isSlotsUpdated, "fuel is consumed")
+	assert.True(t, furnace.IsLit())
+	assert.Equal(t, empty(), furnace.GetSlot(FurnaceFuel))
+	assert.Equal(t, []int16{1600, 1600, 1, 200}, furnace.Properties())
+
+	for tick := 1; tick < 200; tick++ {
+		furnace.Tick()
+	}
+	assert.Equal(t, Slot{IsPresent: true, ItemID: objects.ItemIronIngot, ItemCount: 1}, furnace.GetSlot(FurnaceOutput))
+	assert.Equal(t, empty(), furnace.GetSlot(FurnaceInput))
+	assert.True(t, furnace.IsLit(), "fuel keeps burning once lit")
+	assert.Equal(t, int16(0), furnace.CookTime)
+}
+
+func TestFurnaceWindowOutput(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	furnace := NewFurnace()
+	ingot := Slot{IsPresent: true, ItemID: objects.ItemIronIngot, ItemCount: 1}
+	furnace.SetSlot(FurnaceOutput, ingot)
+
+	window := NewFurnaceWindow(1, inv, furnace)
+	assert.Equal(t, []int16{0, 0, 0, 200}, window.Properties())
+
+	inv.SetSlot(hotbar1, bedrock(1))
+	_, isUpdated, err := window.HandleClick(1, 3+27, int16(simpleClick), uint8(leftMouseButton), bedrock(1))
+	require.NoError(t, err)
+	require.True(t, isUpdated)
+	require.Equal(t, bedrock(1), window.GetCursor())
+
+	_, isUpdated, err = window.HandleClick(2, FurnaceOutput, int16(simpleClick), uint8(leftMouseButton), ingot)
+	require.NoError(t, err)
+	assert.False(t, isUpdated, "nothing can be put into the output slot")
+	assert.Equal(t, ingot, furnace.GetSlot(FurnaceOutput))
+
+	_, isUpdated, err = window.HandleClick(3, FurnaceOutput, int16(shftClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.True(t, isUpdated)
+	assert.Equal(t, empty(), furnace.GetSlot(FurnaceOutput))
+	assert.Equal(t, ingot, inv.GetSlot(hotbar1))
+}
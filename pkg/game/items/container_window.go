@@ -0,0 +1,306 @@
+package items
+
+import (
+	"github.com/google/uuid"
+)
+
+// inventoryFirstSlot - first slot of the player inventory main rows, the main rows and the hotbar follow the container
+// slots in every container window in the same order.
+const inventoryFirstSlot = 9
+
+// inventorySlotCount - number of the player inventory main rows and hotbar slots shown in the container windows.
+const inventorySlotCount = 36
+
+// ContainerWindow - window of a container block opened by the player, e.g. a chest, a furnace or a crafting table.
+// The window shows the container slots first, followed by the player inventory main rows and the hotbar.
+type ContainerWindow struct {
+	windowMgr
+
+	Type  WindowType
+	Title string
+
+	inventory *Inventory
+	// double chests are made of two containers, slots of the first one go first
+	containers []*Container
+	furnace    *Furnace
+}
+
+// NewChestWindow provides the window of a single chest, or of a double chest if two containers are given.
+func NewChestWindow(windowID WindowID, inventory *Inventory, containers ...*Container) *ContainerWindow {
+	window := newContainerWindow(windowID, inventory, containers...)
+	window.Type = WindowGeneric9x3
+	window.Title = "Chest"
+	if len(containers) > 1 {
+		window.Type = WindowGeneric9x6
+		window.Title = "Large Chest"
+	}
+	return window
+}
+
+func NewFurnaceWindow(windowID WindowID, inventory *Inventory, furnace *Furnace) *ContainerWindow {
+	window := newContainerWindow(windowID, inventory, furnace.Container)
+	window.Type = WindowFurnace
+	window.Title = "Furnace"
+	window.furnace = furnace
+	return window
+}
+
+// NewCraftingWindow provides the crafting table window. Crafting table keeps nothing in it, so the crafting grid
+// belongs to the window itself and is emptied back into the inventory when the window is closed.
+func NewCraftingWindow(windowID WindowID, inventory *Inventory) *ContainerWindow {
+	window := newContainerWindow(windowID, inventory, NewContainer(craftingTableSize))
+	window.Type = WindowCrafting
+	window.Title = "Crafting"
+	return window
+}
+
+func newContainerWindow(windowID WindowID, inventory *Inventory, containers ...*Container) *ContainerWindow {
+	window := &ContainerWindow{
+		windowMgr: windowMgr{
+			WindowID: windowID,
+			log:      inventory.log, // container windows log the same way the inventory of the player does
+			isOpen:   true,
+		},
+		inventory:  inventory,
+		containers: containers,
+	}
+	window.clickable = window
+	return window
+}
+
+// Open registers the player as the viewer of the window containers.
+func (w *ContainerWindow) Open(connID uuid.UUID) {
+	w.lockContainers()
+	defer w.unlockContainers()
+
+	for _, container := range w.containers {
+		container.AddViewer(connID, w.WindowID)
+	}
+}
+
+// Close closes the window and unregisters the player as the viewer of the window containers. Items left on the cursor
+// and in the crafting grid go back into the inventory. Provides whatever did not fit, to be dropped.
+func (w *ContainerWindow) Close(connID uuid.UUID) []Slot {
+	w.lockContainers()
+	defer w.unlockContainers()
+
+	for _, container := range w.containers {
+		container.RemoveViewer(connID)
+	}
+
+	var leftovers []Slot
+	if cursor := w.CloseWindow(); cursor.IsPresent {
+		leftovers = append(leftovers, cursor)
+	}
+
+	if w.Type == WindowCrafting {
+		grid := w.containers[0]
+		for slotID := resultSlot + 1; slotID < craftingTableSize; slotID++ {
+			if item := grid.GetSlot(int16(slotID)); item.IsPresent {
+				leftovers = append(leftovers, item)
+			}
+		}
+		grid.TakeAll()
+	}
+
+	var dropped []Slot
+	for _, item := range leftovers {
+		if remainder, _ := w.inventory.PickUp(item); remainder.IsPresent {
+			dropped = append(dropped, remainder)
+		}
+	}
+	return dropped
+}
+
+// Viewers provides the window IDs of all players viewing the same containers, by player conn ID.
+func (w *ContainerWindow) Viewers() map[uuid.UUID]WindowID {
+	w.lockContainers()
+	defer w.unlockContainers()
+
+	return w.containers[0].Viewers() // containers of a double chest are always opened together
+}
+
+// HandleClick handles the click same as any other window does, holding the container locks and the inventory lock
+// for the whole click.
+func (w *ContainerWindow) HandleClick(actionID, slotID, mode int16, keyPress uint8, clickedItem Slot) (*Slot, bool, error) {
+	w.lockContainers()
+	defer w.unlockContainers()
+	w.inventory.mu.Lock()
+	defer w.inventory.mu.Unlock()
+
+	return w.windowMgr.HandleClick(actionID, slotID, mode, keyPress, clickedItem)
+}
+
+// ContainerSlots provides the contents of the container slots of the window, without the player inventory.
+func (w *ContainerWindow) ContainerSlots() []Slot {
+	w.lockContainers()
+	defer w.unlockContainers()
+
+	var slots []Slot
+	for _, container := range w.containers {
+		slots = append(slots, container.Slots()...)
+	}
+	return slots
+}
+
+// ToArray converts the window into correctly numbered array of slots for marshalling into a packet.
+func (w *ContainerWindow) ToArray() []Slot {
+	slots := w.ContainerSlots()
+	for slotID := int16(inventoryFirstSlot); slotID < inventoryFirstSlot+inventorySlotCount; slotID++ {
+		slots = append(slots, w.inventory.GetSlot(slotID))
+	}
+	return slots
+}
+
+// Properties provides the window properties, only furnace windows have any.
+func (w *ContainerWindow) Properties() []int16 {
+	if w.furnace == nil {
+		return nil
+	}
+
+	w.lockContainers()
+	defer w.unlockContainers()
+	return w.furnace.Properties()
+}
+
+// GetSlot provides the item in the window slot. Expects the container and inventory locks to be held, as they are
+// for the whole click.
+func (w *ContainerWindow) GetSlot(slotID int16) Slot {
+	if slotID < 0 {
+		return Slot{}
+	}
+
+	for _, container := range w.containers {
+		if slotID < container.Size() {
+			return container.GetSlot(slotID)
+		}
+		slotID -= container.Size()
+	}
+
+	if slotID >= inventorySlotCount {
+		return Slot{}
+	}
+	return w.inventory.getSlot(slotID + inventoryFirstSlot)
+}
+
+func (w *ContainerWindow) SetSlot(slotID int16, item Slot) {
+	if slotID < 0 {
+		return
+	}
+
+	for _, container := range w.containers {
+		if slotID < container.Size() {
+			container.SetSlot(slotID, item)
+			if w.Type == WindowCrafting && slotID != resultSlot {
+				w.updateResult()
+			}
+			return
+		}
+		slotID -= container.Size()
+	}
+
+	if slotID < inventorySlotCount {
+		w.inventory.setSlot(slotID+inventoryFirstSlot, item)
+	}
+}
+
+func (w *ContainerWindow) GetRange(rangeType rangeType) slotRange {
+	size := w.containerSize()
+
+	var slots slotRange
+	switch rangeType {
+	case top:
+		switch w.Type {
+		case WindowCrafting:
+			slots = slotRange{resultSlot + 1, size - 1}
+		case WindowFurnace:
+			// DEBT Notchian server moves fuels into the fuel slot, and everything else into the input slot
+			slots = slotRange{FurnaceInput, FurnaceFuel}
+		default:
+			slots = slotRange{0, size - 1}
+		}
+	case bottom:
+		slots = slotRange{size, size + inventorySlotCount - 1}
+	case hotbar:
+		slots = slotRange{size + inventorySlotCount - 9, size + inventorySlotCount - 1}
+	}
+	return slots
+}
+
+// IsResultSlot tells if the slot can only be taken out of: the crafting table result or the furnace output.
+func (w *ContainerWindow) IsResultSlot(slotID int16) bool {
+	switch w.Type {
+	case WindowCrafting:
+		return slotID == resultSlot
+	case WindowFurnace:
+		return slotID == FurnaceOutput
+	}
+	return false
+}
+
+func (w *ContainerWindow) TakeResult() Slot {
+	switch w.Type {
+	case WindowCrafting:
+		grid := w.containers[0]
+		crafted := grid.GetSlot(resultSlot)
+		if !crafted.IsPresent {
+			return Slot{}
+		}
+
+		consumeIngredients(grid.slots[resultSlot+1:])
+		w.updateResult()
+		return crafted
+	case WindowFurnace:
+		output := w.furnace.GetSlot(FurnaceOutput)
+		w.furnace.SetSlot(FurnaceOutput, Slot{})
+		return output
+	}
+	return Slot{}
+}
+
+func (w *ContainerWindow) CraftAll() bool {
+	var hasCrafted bool
+	for n := 0; n < maxCraftAll; n++ {
+		result := w.GetSlot(w.resultSlotID())
+		if !result.IsPresent || !w.inventory.canPickUp(result) {
+			break
+		}
+
+		w.inventory.pickUp(w.TakeResult())
+		hasCrafted = true
+	}
+	return hasCrafted
+}
+
+func (w *ContainerWindow) resultSlotID() int16 {
+	if w.Type == WindowFurnace {
+		return FurnaceOutput
+	}
+	return resultSlot
+}
+
+// updateResult sets the crafting table result to whatever the crafting grid makes, if anything.
+func (w *ContainerWindow) updateResult() {
+	grid := w.containers[0]
+	grid.slots[resultSlot] = craftingResult(grid.slots[resultSlot+1:], craftingTableWidth)
+}
+
+func (w *ContainerWindow) containerSize() int16 {
+	var size int16
+	for _, container := range w.containers {
+		size += container.Size()
+	}
+	return size
+}
+
+func (w *ContainerWindow) lockContainers() {
+	for _, container := range w.containers {
+		container.Lock()
+	}
+}
+
+func (w *ContainerWindow) unlockContainers() {
+	for i := len(w.containers) - 1; i >= 0; i-- {
+		w.containers[i].Unlock()
+	}
+}
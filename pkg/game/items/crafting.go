@@ -0,0 +1,334 @@
+package items
+
+import (
+	"fmt"
+
+	"github.com/alexykot/cncraft/pkg/game/recipes"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// craftingGridWidth - player inventory crafting grid is 2x2.
+const craftingGridWidth = 2
+
+// resultSlot - crafting result slot of the player inventory.
+const resultSlot = 0
+
+// maxCraftAll - upper limit of crafts done at once, e.g. by the shift+click on the result.
+const maxCraftAll = 64
+
+// crafter - window with the crafting grid. Crafting result slot cannot be put into, only taken out of, and taking
+// the result out consumes the ingredients.
+type crafter interface {
+	IsResultSlot(slotID int16) bool
+	// TakeResult takes the result out once, consuming one of every ingredient.
+	TakeResult() Slot
+	// CraftAll crafts as many results as there are ingredients and room in the inventory for.
+	CraftAll() bool
+}
+
+func (i *Inventory) IsResultSlot(slotID int16) bool { return slotID == resultSlot }
+
+// IsCraftingSlot tells if the slot is the crafting result or one of the crafting grid slots.
+func (i *Inventory) IsCraftingSlot(slotID int16) bool {
+	return slotID >= resultSlot && slotID <= int16(len(i.Craft))
+}
+
+func (i *Inventory) TakeResult() Slot {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.takeResult()
+}
+
+func (i *Inventory) takeResult() Slot {
+	crafted := i.Result
+	if !crafted.IsPresent {
+		return Slot{}
+	}
+
+	consumeIngredients(i.Craft[:])
+	i.updateResult()
+
+	return crafted
+}
+
+func (i *Inventory) CraftAll() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.craftAll()
+}
+
+func (i *Inventory) craftAll() bool {
+	var hasCrafted bool
+	for n := 0; n < maxCraftAll && i.Result.IsPresent && i.canPickUp(i.Result); n++ {
+		i.pickUp(i.takeResult())
+		hasCrafted = true
+	}
+	return hasCrafted
+}
+
+// PlaceRecipe moves the recipe ingredients from the inventory into the crafting grid, once or as many times
+// as possible, as requested from the recipe book. Whatever is in the grid already goes back into the inventory.
+// Provides false if the recipe cannot be placed, e.g. the ingredients are missing.
+func (i *Inventory) PlaceRecipe(recipe *recipes.Recipe, makeAll bool) (bool, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !recipe.Type.IsCrafting() || !recipe.FitsGrid(craftingGridWidth, craftingGridWidth) {
+		return false, fmt.Errorf("recipe %s cannot be crafted in the inventory", recipe.ID)
+	}
+
+	for slot, item := range i.Craft {
+		if !item.IsPresent {
+			continue
+		}
+		remainder, _ := i.pickUp(item)
+		i.Craft[slot] = remainder
+	}
+	i.updateResult()
+	for _, item := range i.Craft {
+		if item.IsPresent {
+			return false, nil // no room to clear the grid
+		}
+	}
+
+	gridSlots := recipeGridSlots(recipe)
+
+	times := 1
+	if makeAll {
+		times = maxCraftAll
+	}
+	for ; times > 0; times-- {
+		placed, ok := i.planRecipe(recipe, gridSlots, times)
+		if !ok {
+			continue
+		}
+
+		for slot, item := range placed {
+			i.takeItems(item, int16(times))
+			i.Craft[slot] = Slot{IsPresent: true, ItemID: item, ItemCount: int16(times)}
+		}
+		i.updateResult()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// recipeGridSlots provides the crafting grid slots for the recipe ingredients, in the ingredients order.
+func recipeGridSlots(recipe *recipes.Recipe) []int {
+	var slots []int
+	if recipe.Type == recipes.CraftingShaped {
+		for y := 0; y < recipe.Height; y++ {
+			for x := 0; x < recipe.Width; x++ {
+				slots = append(slots, y*craftingGridWidth+x)
+			}
+		}
+		return slots
+	}
+
+	for slot := range recipe.Ingredients {
+		slots = append(slots, slot)
+	}
+	return slots
+}
+
+// planRecipe picks the inventory items to satisfy every recipe ingredient the given number of times.
+// Provides the items picked, by the crafting grid slot.
+func (i *Inventory) planRecipe(recipe *recipes.Recipe, gridSlots []int, times int) (map[int]objects.ItemID, bool) {
+	available := make(map[objects.ItemID]int)
+	for _, slotID := range append(i.GetRange(hotbar).GetSlots(), i.GetRange(top).GetSlots()...) {
+		if item := i.getSlot(slotID); item.IsPresent {
+			available[item.ItemID] += int(item.ItemCount)
+		}
+	}
+
+	placed := make(map[int]objects.ItemID)
+	for n, ingredient := range recipe.Ingredients {
+		if len(ingredient) == 0 {
+			continue // empty slot of the shaped recipe
+		}
+
+		var found bool
+		for _, item := range ingredient {
+			if available[item] >= times && int(item.MaxStack()) >= times {
+				available[item] -= times
+				placed[gridSlots[n]] = item
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return placed, true
+}
+
+// takeItems removes the given number of items from the inventory, hotbar before the main inventory.
+func (i *Inventory) takeItems(itemID objects.ItemID, count int16) {
+	for _, slotID := range append(i.GetRange(hotbar).GetSlots(), i.GetRange(top).GetSlots()...) {
+		if count <= 0 {
+			return
+		}
+
+		item := i.getSlot(slotID)
+		if !item.IsPresent || item.ItemID != itemID {
+			continue
+		}
+
+		taken := item.ItemCount
+		if taken > count {
+			taken = count
+		}
+		item.ItemCount -= taken
+		count -= taken
+		if item.ItemCount <= 0 {
+			item = Slot{}
+		}
+		i.setSlot(slotID, item)
+	}
+}
+
+// ClearCraftingGrid moves the crafting grid contents back into the inventory, as is done on the inventory close.
+// Provides whatever did not fit, to be dropped.
+func (i *Inventory) ClearCraftingGrid() []Slot {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var leftovers []Slot
+	for slot, item := range i.Craft {
+		if !item.IsPresent {
+			continue
+		}
+
+		if remainder, _ := i.pickUp(item); remainder.IsPresent {
+			leftovers = append(leftovers, remainder)
+		}
+		i.Craft[slot] = Slot{}
+	}
+	i.updateResult()
+
+	return leftovers
+}
+
+// updateResult sets the crafting result to whatever the crafting grid makes, if anything.
+func (i *Inventory) updateResult() {
+	i.Result = craftingResult(i.Craft[:], craftingGridWidth)
+}
+
+// craftingResult provides whatever the crafting grid of the given width makes, if anything.
+func craftingResult(craftingGrid []Slot, width int) Slot {
+	grid := make([]objects.ItemID, len(craftingGrid))
+	for slot, item := range craftingGrid {
+		if item.IsPresent {
+			grid[slot] = item.ItemID
+		}
+	}
+
+	recipe, ok := recipes.MatchCrafting(grid, width)
+	if !ok {
+		return Slot{}
+	}
+	return Slot{IsPresent: true, ItemID: recipe.Result, ItemCount: recipe.ResultCount}
+}
+
+// consumeIngredients takes one of every item in the crafting grid, as is done when the result is taken out.
+// DEBT items leaving a container behind when crafted, e.g. milk buckets in the cake, are consumed entirely.
+func consumeIngredients(craftingGrid []Slot) {
+	for slot, item := range craftingGrid {
+		if !item.IsPresent {
+			continue
+		}
+
+		item.ItemCount--
+		if item.ItemCount <= 0 {
+			item = Slot{}
+		}
+		craftingGrid[slot] = item
+	}
+}
+
+// canPickUp tells if the whole item stack fits into the inventory.
+func (i *Inventory) canPickUp(item Slot) bool {
+	maxStack := item.ItemID.MaxStack()
+
+	var room int16
+	for _, slotID := range append(i.GetRange(hotbar).GetSlots(), i.GetRange(top).GetSlots()...) {
+		slotItem := i.getSlot(slotID)
+		if !slotItem.IsPresent {
+			room += maxStack
+		} else if slotItem.ItemID == item.ItemID && slotItem.ItemCount < maxStack {
+			room += maxStack - slotItem.ItemCount
+		}
+
+		if room >= item.ItemCount {
+			return true
+		}
+	}
+	return false
+}
+
+// handleResultClick takes the crafting result out according to the click mode. Nothing can be put into the result
+// slot, so the clicks that would do that are ignored.
+func (m *windowMgr) handleResultClick(crafter crafter, slotID int16, mode clickMode, button button, clickedItem Slot) (Slot, bool, error) {
+	result := m.clickable.GetSlot(slotID)
+
+	switch mode {
+	case simpleClick:
+		if !slotEqual(result, clickedItem) {
+			return Slot{}, false, fmt.Errorf("slot contents not equal to clickedItem supplied")
+		}
+		if button != leftMouseButton && button != rightMouseButton {
+			return Slot{}, false, fmt.Errorf("button %d is invalid for mode 0", button)
+		}
+
+		if !result.IsPresent {
+			return Slot{}, false, nil
+		}
+
+		if !m.cursor.IsPresent {
+			m.cursor = crafter.TakeResult()
+			return Slot{}, true, nil
+		}
+
+		if m.cursor.ItemID == result.ItemID && m.cursor.ItemCount+result.ItemCount <= result.ItemID.MaxStack() {
+			m.cursor.ItemCount += crafter.TakeResult().ItemCount
+			return Slot{}, true, nil
+		}
+		return Slot{}, false, nil // result does not fit on the cursor
+	case shftClick:
+		if clickedItem.IsPresent {
+			return Slot{}, false, fmt.Errorf("clickedItem should not be present in mode 1")
+		}
+		return Slot{}, crafter.CraftAll(), nil
+	case numberKey:
+		if button > kbdKey9 {
+			return Slot{}, false, fmt.Errorf("button %d not supported for mode 2", button)
+		}
+
+		hotbarSlotID := m.clickable.GetRange(hotbar).GetSlots()[button]
+		if !result.IsPresent || m.clickable.GetSlot(hotbarSlotID).IsPresent {
+			return Slot{}, false, nil
+		}
+		m.clickable.SetSlot(hotbarSlotID, crafter.TakeResult())
+		return Slot{}, true, nil
+	case drop:
+		if button != kbdKeyQ {
+			return Slot{}, false, fmt.Errorf("button %d not supported for mode 4", button)
+		}
+
+		if !result.IsPresent {
+			return Slot{}, false, nil
+		}
+		return crafter.TakeResult(), true, nil
+	case drag:
+		m.dragSlots = nil
+		m.dragPlaced = nil
+		m.dragged = Slot{}
+		return Slot{}, false, fmt.Errorf("cannot drag into the crafting result slot")
+	default:
+		return Slot{}, false, fmt.Errorf("mode %s not supported for the crafting result slot", mode.String())
+	}
+}
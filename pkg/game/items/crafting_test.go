@@ -0,0 +1,106 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/alexykot/cncraft/pkg/game/recipes"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func planks() Slot { return Slot{IsPresent: true, ItemID: objects.ItemOakPlanks, ItemCount: 1} }
+
+func TestInventoryCraftingResult(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+
+	inv.SetSlot(2, Slot{IsPresent: true, ItemID: objects.ItemOakLog, ItemCount: 1})
+	assert.Equal(t, Slot{IsPresent: true, ItemID: objects.ItemOakPlanks, ItemCount: 4}, inv.GetSlot(resultSlot))
+
+	inv.SetSlot(2, empty())
+	assert.Equal(t, empty(), inv.GetSlot(resultSlot))
+
+	for slotID := int16(1); slotID <= 4; slotID++ {
+		inv.SetSlot(slotID, planks())
+	}
+	craftingTable := Slot{IsPresent: true, ItemID: objects.ItemCraftingTable, ItemCount: 1}
+	require.Equal(t, craftingTable, inv.GetSlot(resultSlot))
+
+	dropped, isUpdated, err := inv.HandleClick(1, resultSlot, int16(simpleClick), uint8(leftMouseButton), craftingTable)
+	require.NoError(t, err)
+	assert.Nil(t, dropped)
+	assert.True(t, isUpdated)
+	assert.Equal(t, craftingTable, inv.GetCursor())
+	for slotID := int16(0); slotID <= 4; slotID++ {
+		assert.Equal(t, empty(), inv.GetSlot(slotID))
+	}
+
+	_, isUpdated, err = inv.HandleClick(2, resultSlot, int16(simpleClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.False(t, isUpdated, "nothing can be put into the result slot")
+	assert.Equal(t, craftingTable, inv.GetCursor())
+}
+
+func TestInventoryCraftAll(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(1, planks())
+	inv.SetSlot(3, planks())
+
+	_, isUpdated, err := inv.HandleClick(1, resultSlot, int16(shftClick), uint8(leftMouseButton), empty())
+	require.NoError(t, err)
+	assert.True(t, isUpdated)
+
+	sticks := Slot{IsPresent: true, ItemID: objects.ItemStick, ItemCount: 1}
+	for slotID := int16(hotbar1); slotID <= hotbar4; slotID++ {
+		assert.Equal(t, sticks, inv.GetSlot(slotID))
+	}
+	assert.Equal(t, empty(), inv.GetSlot(1))
+	assert.Equal(t, empty(), inv.GetSlot(3))
+	assert.Equal(t, empty(), inv.GetSlot(resultSlot))
+}
+
+func TestInventoryPlaceRecipe(t *testing.T) {
+	recipe, ok := recipes.Get("minecraft:crafting_table")
+	require.True(t, ok)
+
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar1, planks())
+	inv.SetSlot(hotbar2, planks())
+	inv.SetSlot(rowTop1, planks())
+
+	isPlaced, err := inv.PlaceRecipe(recipe, false)
+	require.NoError(t, err)
+	assert.False(t, isPlaced, "not enough planks")
+
+	inv.SetSlot(rowTop2, planks())
+	inv.SetSlot(1, bedrock(1))
+
+	isPlaced, err = inv.PlaceRecipe(recipe, false)
+	require.NoError(t, err)
+	assert.True(t, isPlaced)
+	for slotID := int16(1); slotID <= 4; slotID++ {
+		assert.Equal(t, planks(), inv.GetSlot(slotID))
+	}
+	assert.Equal(t, objects.ItemCraftingTable, inv.GetSlot(resultSlot).ItemID)
+	assert.Equal(t, bedrock(1), inv.GetSlot(hotbar3), "grid contents are returned into the inventory")
+
+	furnace, ok := recipes.Get("minecraft:furnace")
+	require.True(t, ok)
+	_, err = inv.PlaceRecipe(furnace, false)
+	assert.Error(t, err, "3x3 recipe does not fit the inventory grid")
+}
+
+func TestInventoryClearCraftingGrid(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(1, planks())
+	inv.SetSlot(4, bedrock(10))
+
+	assert.Empty(t, inv.ClearCraftingGrid())
+	assert.Equal(t, planks(), inv.GetSlot(hotbar1))
+	assert.Equal(t, bedrock(10), inv.GetSlot(hotbar2))
+	for slotID := int16(0); slotID <= 4; slotID++ {
+		assert.Equal(t, empty(), inv.GetSlot(slotID))
+	}
+}
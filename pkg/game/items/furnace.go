@@ -0,0 +1,162 @@
+package items
+
+import (
+	"strings"
+
+	"github.com/alexykot/cncraft/pkg/game/recipes"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// Furnace window slots.
+const (
+	FurnaceInput  int16 = 0
+	FurnaceFuel   int16 = 1
+	FurnaceOutput int16 = 2
+)
+
+// defaultCookTime - ticks it takes to cook an item until the actual recipe is known.
+const defaultCookTime = 200
+
+// DEBT fuels are maintained by hand until the fuel list is generated from the Notchian data export. Only the common
+//  fuels are listed, planks, logs and wood go by the name suffix.
+var fuelTicks = map[objects.ItemID]int16{
+	objects.ItemLavaBucket:    20000,
+	objects.ItemCoalBlock:     16000,
+	objects.ItemBlazeRod:      2400,
+	objects.ItemCoal:          1600,
+	objects.ItemCharcoal:      1600,
+	objects.ItemCraftingTable: 300,
+	objects.ItemChest:         300,
+	objects.ItemStick:         100,
+}
+
+// FuelTicks provides the number of ticks the item burns for in the furnace, zero if the item is not a fuel.
+func FuelTicks(item objects.ItemID) int16 {
+	if ticks, ok := fuelTicks[item]; ok {
+		return ticks
+	}
+
+	name := item.String()
+	if strings.HasSuffix(name, "_planks") || strings.HasSuffix(name, "_log") || strings.HasSuffix(name, "_wood") {
+		return 300
+	}
+	return 0
+}
+
+// Furnace - container smelting the input item into the output, burning the fuel. Same as the Container itself,
+// the furnace methods must only be called holding the container lock.
+type Furnace struct {
+	*Container
+
+	BurnTime  int16 // ticks left for the current fuel to burn
+	BurnTotal int16 // ticks the current fuel burns for in total
+	CookTime  int16 // ticks the current input item has been cooking for
+	CookTotal int16 // ticks it takes to cook the current input item
+
+	cooking objects.ItemID // input item being cooked, cooking restarts when the input item changes
+}
+
+func NewFurnace() *Furnace {
+	return &Furnace{
+		Container: NewContainer(furnaceSize),
+		CookTotal: defaultCookTime,
+	}
+}
+
+func (f *Furnace) IsLit() bool { return f.BurnTime > 0 }
+
+// Properties provides the furnace window properties, as per https://wiki.vg/Protocol#Window_Property
+func (f *Furnace) Properties() []int16 {
+	return []int16{f.BurnTime, f.BurnTotal, f.CookTime, f.CookTotal}
+}
+
+// Tick progresses the fuel burning and the input cooking by one tick. Provides true if the window properties
+// changed, and true if the slots changed.
+// DEBT experience for the smelted items is not awarded.
+func (f *Furnace) Tick() (bool, bool) {
+	wasLit := f.IsLit()
+	wasCooking := f.CookTime
+	var isSlotsUpdated bool
+
+	if f.IsLit() {
+		f.BurnTime--
+	}
+
+	input := f.GetSlot(FurnaceInput)
+	recipe, canCook := f.matchInput(input)
+	if input.ItemID != f.cooking {
+		if f.cooking != objects.ItemAir {
+			f.CookTime = 0 // furnaces just loaded from persistence keep the progress
+		}
+		f.cooking = input.ItemID
+		f.CookTotal = defaultCookTime
+		if canCook {
+			f.CookTotal = int16(recipe.CookingTime)
+		}
+	}
+
+	fuel := f.GetSlot(FurnaceFuel)
+	if !f.IsLit() && canCook && fuel.IsPresent {
+		if burnTicks := FuelTicks(fuel.ItemID); burnTicks > 0 {
+			f.BurnTotal = burnTicks
+			f.BurnTime = burnTicks
+
+			fuel.ItemCount--
+			if fuel.ItemID == objects.ItemLavaBucket {
+				fuel = Slot{IsPresent: true, ItemID: objects.ItemBucket, ItemCount: 1}
+			}
+			f.SetSlot(FurnaceFuel, fuel)
+			isSlotsUpdated = true
+		}
+	}
+
+	if f.IsLit() && canCook {
+		f.CookTime++
+		if f.CookTime >= f.CookTotal {
+			f.CookTime = 0
+			f.smelt(input, recipe)
+			isSlotsUpdated = true
+		}
+	} else if f.CookTime > 0 {
+		f.CookTime -= 2 // cooking progress goes back while the furnace is out
+		if !canCook || f.CookTime < 0 {
+			f.CookTime = 0
+		}
+	}
+
+	isUpdated := wasLit != f.IsLit() || wasCooking != f.CookTime || f.IsLit()
+	if isUpdated {
+		f.isDirty = true // burning and cooking progress is saved along with the slots
+	}
+	return isUpdated, isSlotsUpdated
+}
+
+// matchInput finds the recipe for the input item, if there is one and its result fits into the output slot.
+func (f *Furnace) matchInput(input Slot) (*recipes.Recipe, bool) {
+	if !input.IsPresent {
+		return nil, false
+	}
+
+	recipe, ok := recipes.MatchCooking(recipes.Smelting, input.ItemID)
+	if !ok {
+		return nil, false
+	}
+
+	output := f.GetSlot(FurnaceOutput)
+	if !output.IsPresent {
+		return recipe, true
+	}
+	return recipe, output.ItemID == recipe.Result && output.ItemCount+recipe.ResultCount <= output.ItemID.MaxStack()
+}
+
+func (f *Furnace) smelt(input Slot, recipe *recipes.Recipe) {
+	output := f.GetSlot(FurnaceOutput)
+	if !output.IsPresent {
+		output = Slot{IsPresent: true, ItemID: recipe.Result}
+	}
+	output.ItemCount += recipe.ResultCount
+	f.SetSlot(FurnaceOutput, output)
+
+	input.ItemCount--
+	f.SetSlot(FurnaceInput, input)
+}
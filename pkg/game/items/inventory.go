@@ -6,6 +6,12 @@ import (
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
+// HotbarSize - number of the hotbar slots the player can hold items from.
+const HotbarSize = 9
+
+// Inventory - items of the player. Inventory is changed by the window clicks, by the shards and by the commands, all
+// in different goroutines, so the slots are guarded by the window lock. Exported methods hold the lock by themselves,
+// the clicks hold it for the whole click.
 type Inventory struct {
 	windowMgr
 
@@ -14,7 +20,7 @@ type Inventory struct {
 	RowTop    [9]Slot
 	RowMiddle [9]Slot
 	RowBottom [9]Slot
-	RowHotbar [9]Slot
+	RowHotbar [HotbarSize]Slot
 
 	Armor   [4]Slot
 	Offhand Slot
@@ -30,12 +36,29 @@ func NewInventory(windowLog *zap.Logger) *Inventory {
 			log:      windowLog,
 		},
 	}
-	inv.clickable = inv // TODO don't like this Ouroboros wiring
+	inv.clickable = inventorySlots{inv} // TODO don't like this Ouroboros wiring
 	return inv
 }
 
+// inventorySlots - the inventory as seen by the window clicks, these hold the window lock already.
+type inventorySlots struct {
+	*Inventory
+}
+
+func (s inventorySlots) GetSlot(slotID int16) Slot       { return s.getSlot(slotID) }
+func (s inventorySlots) SetSlot(slotID int16, item Slot) { s.setSlot(slotID, item) }
+func (s inventorySlots) TakeResult() Slot                { return s.takeResult() }
+func (s inventorySlots) CraftAll() bool                  { return s.craftAll() }
+
 // ToArray converts Inventory into correctly numbered array of slots for marshalling into a packet.
 func (i *Inventory) ToArray() []Slot {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.toArray()
+}
+
+func (i *Inventory) toArray() []Slot {
 	result := make([]Slot, 46, 46)
 	result[0] = i.Result
 	result[1] = i.Craft[0]
@@ -71,11 +94,18 @@ func (i *Inventory) ToArray() []Slot {
 }
 
 func (i *Inventory) GetSlot(slotID int16) Slot {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.getSlot(slotID)
+}
+
+func (i *Inventory) getSlot(slotID int16) Slot {
 	if slotID < 0 {
 		return Slot{}
 	}
 
-	items := i.ToArray()
+	items := i.toArray()
 	if slotID > int16(len(items)-1) {
 		return Slot{}
 	}
@@ -84,12 +114,20 @@ func (i *Inventory) GetSlot(slotID int16) Slot {
 }
 
 func (i *Inventory) SetSlot(slotID int16, item Slot) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.setSlot(slotID, item)
+}
+
+func (i *Inventory) setSlot(slotID int16, item Slot) {
 	item.IsPresent = item.ItemID != objects.ItemAir
 
 	if slotID == 0 {
 		i.Result = item
 	} else if slotID > 0 && slotID < 5 {
 		i.Craft[slotID-1] = item
+		i.updateResult()
 	} else if slotID > 4 && slotID < 9 {
 		i.Armor[slotID-5] = item
 	} else if slotID > 8 && slotID < 18 {
@@ -106,12 +144,30 @@ func (i *Inventory) SetSlot(slotID int16, item Slot) {
 }
 
 func (i *Inventory) GetCurrentTool() Slot {
-	if i.CurrentHotbarSlot > 9 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if int(i.CurrentHotbarSlot) >= len(i.RowHotbar) {
 		return Slot{}
 	}
 	return i.RowHotbar[i.CurrentHotbarSlot]
 }
 
+// GetCurrentHotbarSlot provides the index of the hotbar slot currently held.
+func (i *Inventory) GetCurrentHotbarSlot() uint8 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.CurrentHotbarSlot
+}
+
+func (i *Inventory) SetCurrentHotbarSlot(hotbarSlot uint8) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.CurrentHotbarSlot = hotbarSlot
+}
+
 func (i *Inventory) GetRange(rangeType rangeType) slotRange {
 	var slots slotRange
 	switch rangeType {
@@ -124,3 +180,101 @@ func (i *Inventory) GetRange(rangeType rangeType) slotRange {
 	}
 	return slots
 }
+
+// PickUp puts as much of the given item into the inventory as fits, topping up the stacks of the same item first
+// and then filling the empty slots, hotbar before the main inventory. Provides the remainder that did not fit and
+// the IDs of the slots updated.
+func (i *Inventory) PickUp(item Slot) (Slot, []int16) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return i.pickUp(item)
+}
+
+func (i *Inventory) pickUp(item Slot) (Slot, []int16) {
+	slotIDs := append(i.GetRange(hotbar).GetSlots(), i.GetRange(top).GetSlots()...)
+	maxStack := item.ItemID.MaxStack()
+
+	var updated []int16
+	for _, fillEmpty := range []bool{false, true} {
+		for _, slotID := range slotIDs {
+			if item.ItemCount <= 0 {
+				return Slot{}, updated
+			}
+
+			slotItem := i.getSlot(slotID)
+			if fillEmpty == slotItem.IsPresent {
+				continue
+			} else if slotItem.IsPresent && (slotItem.ItemID != item.ItemID || slotItem.ItemCount >= maxStack) {
+				continue
+			}
+
+			if !slotItem.IsPresent {
+				slotItem = item
+				slotItem.ItemCount = 0
+			}
+
+			added := maxStack - slotItem.ItemCount
+			if added > item.ItemCount {
+				added = item.ItemCount
+			}
+			slotItem.ItemCount += added
+			item.ItemCount -= added
+
+			i.setSlot(slotID, slotItem)
+			updated = append(updated, slotID)
+		}
+	}
+
+	if item.ItemCount <= 0 {
+		return Slot{}, updated
+	}
+	return item, updated
+}
+
+// Consume takes the given number of items out of the slot, provided the slot still holds enough of the given item.
+// Provides the slot contents left, and tells if the items were taken.
+func (i *Inventory) Consume(slotID int16, itemID objects.ItemID, count int16) (Slot, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	slot := i.getSlot(slotID)
+	if !slot.IsPresent || slot.ItemID != itemID || slot.ItemCount < count {
+		return slot, false
+	}
+
+	slot.ItemCount -= count
+	if slot.ItemCount <= 0 {
+		slot = Slot{}
+	}
+	i.setSlot(slotID, slot)
+	return slot, true
+}
+
+// DropHeld takes one item, or the whole stack, out of the currently held hotbar slot.
+func (i *Inventory) DropHeld(wholeStack bool) Slot {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.CurrentHotbarSlot > 8 {
+		return Slot{}
+	}
+
+	held := i.RowHotbar[i.CurrentHotbarSlot]
+	if !held.IsPresent {
+		return Slot{}
+	}
+
+	dropped := held
+	if !wholeStack {
+		dropped.ItemCount = 1
+	}
+
+	held.ItemCount -= dropped.ItemCount
+	if held.ItemCount <= 0 {
+		held = Slot{}
+	}
+	i.RowHotbar[i.CurrentHotbarSlot] = held
+
+	return dropped
+}
@@ -0,0 +1,79 @@
+package items
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestInventoryPickUp(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar1, pickaxe())
+	inv.SetSlot(rowTop1, bedrock(60))
+
+	left, updated := inv.PickUp(bedrock(10))
+	assert.Equal(t, empty(), left)
+	assert.Equal(t, []int16{rowTop1, hotbar2}, updated)
+	assert.Equal(t, bedrock(64), inv.GetSlot(rowTop1))
+	assert.Equal(t, bedrock(6), inv.GetSlot(hotbar2))
+	assert.Equal(t, pickaxe(), inv.GetSlot(hotbar1))
+
+	for slotID := int16(rowTop1); slotID <= hotbar9; slotID++ {
+		if !inv.GetSlot(slotID).IsPresent {
+			inv.SetSlot(slotID, showel())
+		}
+	}
+
+	left, updated = inv.PickUp(bedrock(64))
+	assert.Equal(t, bedrock(6), left)
+	assert.Equal(t, []int16{hotbar2}, updated)
+}
+
+func TestInventoryDropHeld(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar2, bedrock(10))
+	inv.CurrentHotbarSlot = 1
+
+	assert.Equal(t, bedrock(1), inv.DropHeld(false))
+	assert.Equal(t, bedrock(9), inv.GetSlot(hotbar2))
+	assert.Equal(t, bedrock(9), inv.DropHeld(true))
+	assert.Equal(t, empty(), inv.GetSlot(hotbar2))
+	assert.Equal(t, empty(), inv.DropHeld(true))
+}
+
+func TestInventoryGetCurrentTool(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar9, pickaxe())
+
+	inv.CurrentHotbarSlot = 8
+	assert.Equal(t, pickaxe(), inv.GetCurrentTool())
+
+	inv.CurrentHotbarSlot = HotbarSize
+	assert.Equal(t, Slot{}, inv.GetCurrentTool())
+}
+
+func TestInventoryConsume(t *testing.T) {
+	inv := NewInventory(zap.NewNop())
+	inv.SetSlot(hotbar1, bedrock(2))
+
+	left, ok := inv.Consume(hotbar1, bedrock(1).ItemID, 1)
+	assert.True(t, ok)
+	assert.Equal(t, bedrock(1), left)
+
+	left, ok = inv.Consume(hotbar1, pickaxe().ItemID, 1)
+	assert.False(t, ok, "slot holds another item")
+	assert.Equal(t, bedrock(1), left)
+
+	left, ok = inv.Consume(hotbar1, bedrock(1).ItemID, 2)
+	assert.False(t, ok, "not enough items in the slot")
+	assert.Equal(t, bedrock(1), inv.GetSlot(hotbar1))
+
+	left, ok = inv.Consume(hotbar1, bedrock(1).ItemID, 1)
+	assert.True(t, ok)
+	assert.Equal(t, empty(), left)
+	assert.Equal(t, empty(), inv.GetSlot(hotbar1))
+
+	_, ok = inv.Consume(hotbar1, bedrock(1).ItemID, 1)
+	assert.False(t, ok, "slot is empty")
+}
@@ -121,21 +121,25 @@ func (m *windowMgr) HandleClick(actionID, slotID, mode int16, keyPress uint8, cl
 	var err error
 	var inventoryUpdated bool
 	var droppedItem Slot
-	switch clickMode(mode) {
-	case simpleClick:
-		droppedItem, inventoryUpdated, err = m.handleMode0(slotID, button(keyPress), clickedItem)
-	case shftClick:
-		inventoryUpdated, err = m.handleMode1(slotID, button(keyPress), clickedItem)
-	case numberKey:
-		inventoryUpdated, err = m.handleMode2(slotID, button(keyPress), clickedItem)
-	case drop:
-		droppedItem, inventoryUpdated, err = m.handleMode4(slotID, button(keyPress), clickedItem)
-	case drag:
-		inventoryUpdated, err = m.handleMode5(slotID, button(keyPress), clickedItem)
-	case middleClick, doubleClick:
-		return nil, false, fmt.Errorf("mode %s not supported", clickMode(mode).String())
-	default:
-		return nil, false, fmt.Errorf("invalid mode %d received", mode)
+	if crafter, ok := m.clickable.(crafter); ok && crafter.IsResultSlot(slotID) {
+		droppedItem, inventoryUpdated, err = m.handleResultClick(crafter, slotID, clickMode(mode), button(keyPress), clickedItem)
+	} else {
+		switch clickMode(mode) {
+		case simpleClick:
+			droppedItem, inventoryUpdated, err = m.handleMode0(slotID, button(keyPress), clickedItem)
+		case shftClick:
+			inventoryUpdated, err = m.handleMode1(slotID, button(keyPress), clickedItem)
+		case numberKey:
+			inventoryUpdated, err = m.handleMode2(slotID, button(keyPress), clickedItem)
+		case drop:
+			droppedItem, inventoryUpdated, err = m.handleMode4(slotID, button(keyPress), clickedItem)
+		case drag:
+			inventoryUpdated, err = m.handleMode5(slotID, button(keyPress), clickedItem)
+		case middleClick, doubleClick:
+			return nil, false, fmt.Errorf("mode %s not supported", clickMode(mode).String())
+		default:
+			return nil, false, fmt.Errorf("invalid mode %d received", mode)
+		}
 	}
 
 	if err == nil {
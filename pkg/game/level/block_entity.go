@@ -0,0 +1,396 @@
+package level
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/chat"
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/nbt"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// BlockEntityType - type of the block entity, named by the Notchian block entity type identifier.
+type BlockEntityType string
+
+const (
+	BlockEntityChest   BlockEntityType = "minecraft:chest"
+	BlockEntityFurnace BlockEntityType = "minecraft:furnace"
+	BlockEntitySign    BlockEntityType = "minecraft:sign"
+	BlockEntityBanner  BlockEntityType = "minecraft:banner"
+	BlockEntityBed     BlockEntityType = "minecraft:bed"
+	BlockEntitySkull   BlockEntityType = "minecraft:skull"
+)
+
+// blockEntityActions - block entity types sent to the client as they change, by the action ID of the block entity
+// data update, as per https://wiki.vg/Protocol#Block_Entity_Data. Other types are only sent along with the chunk.
+var blockEntityActions = map[BlockEntityType]uint8{
+	BlockEntitySkull:  4,
+	BlockEntityBanner: 6,
+	BlockEntitySign:   9,
+	BlockEntityBed:    11,
+}
+
+// UpdateAction provides the action ID of the block entity data update for the block entity type, if the entity
+// of this type is sent to the client as it changes.
+func (t BlockEntityType) UpdateAction() (uint8, bool) {
+	action, ok := blockEntityActions[t]
+	return action, ok
+}
+
+// FindBlockEntityType provides the type of the block entity the block has, if it has one.
+// DEBT only the block entities of the common decorative blocks are known along with the containers, the rest of
+//  the Notchian block entities are not created for their blocks.
+func FindBlockEntityType(block objects.BlockID) (BlockEntityType, bool) {
+	switch name := block.String(); {
+	case block.IsChest():
+		return BlockEntityChest, true
+	case block.IsFurnace():
+		return BlockEntityFurnace, true
+	case strings.HasSuffix(name, "_sign"):
+		return BlockEntitySign, true
+	case strings.HasSuffix(name, "_banner"):
+		return BlockEntityBanner, true
+	case strings.HasSuffix(name, "_bed"):
+		return BlockEntityBed, true
+	case strings.HasSuffix(name, "_head") || strings.HasSuffix(name, "_skull"):
+		return BlockEntitySkull, true
+	}
+	return "", false
+}
+
+// BlockEntity - state of the block that does not fit into the block state, e.g. the items in a chest. Block entities
+// are shared with the windows the players have open, so must only be accessed holding the entity lock.
+type BlockEntity interface {
+	sync.Locker
+
+	Type() BlockEntityType
+	Position() data.PositionI // global block coordinates
+
+	// IsDirty tells if the entity changed since it was loaded or last saved.
+	IsDirty() bool
+	MarkSaved()
+}
+
+type ChestEntity struct {
+	*items.Container
+	pos data.PositionI
+}
+
+func (e *ChestEntity) Type() BlockEntityType    { return BlockEntityChest }
+func (e *ChestEntity) Position() data.PositionI { return e.pos }
+
+type FurnaceEntity struct {
+	*items.Furnace
+	pos data.PositionI
+}
+
+func (e *FurnaceEntity) Type() BlockEntityType    { return BlockEntityFurnace }
+func (e *FurnaceEntity) Position() data.PositionI { return e.pos }
+
+// SignLineCount - number of text lines on a sign.
+const SignLineCount = 4
+
+// SignEntity - text written on the sign. Only the player who placed the sign may write on it, and only once,
+// same as in the Notchian server.
+type SignEntity struct {
+	sync.Mutex
+
+	pos     data.PositionI
+	isDirty bool
+
+	Lines [SignLineCount]*chat.Message
+	Color string // dye colour of the text
+
+	editor uuid.UUID // player allowed to write on the sign, if any
+}
+
+func (e *SignEntity) Type() BlockEntityType    { return BlockEntitySign }
+func (e *SignEntity) Position() data.PositionI { return e.pos }
+func (e *SignEntity) IsDirty() bool            { return e.isDirty }
+func (e *SignEntity) MarkSaved()               { e.isDirty = false }
+
+// SetEditor allows the player to write on the sign.
+func (e *SignEntity) SetEditor(connID uuid.UUID) { e.editor = connID }
+
+// Write sets the text of the sign, if the player is allowed to write on it. Tells if the text was written.
+func (e *SignEntity) Write(connID uuid.UUID, lines [SignLineCount]string) bool {
+	if e.editor == uuid.Nil || e.editor != connID {
+		return false
+	}
+
+	for i, line := range lines {
+		e.Lines[i] = chat.New(line)
+	}
+	e.editor = uuid.Nil
+	e.isDirty = true
+	return true
+}
+
+// DataEntity - block entity the server has no behaviour for, e.g. a banner or a bed. Its data is kept as is,
+// so the entities loaded from persistence keep their Notchian NBT tags.
+type DataEntity struct {
+	sync.Mutex
+
+	entityType BlockEntityType
+	pos        data.PositionI
+	isDirty    bool
+
+	data map[string]interface{} // NBT tags of the entity, besides the ID and the coords
+}
+
+func (e *DataEntity) Type() BlockEntityType    { return e.entityType }
+func (e *DataEntity) Position() data.PositionI { return e.pos }
+func (e *DataEntity) IsDirty() bool            { return e.isDirty }
+func (e *DataEntity) MarkSaved()               { e.isDirty = false }
+
+// NewBlockEntity provides a new empty block entity for the block placed at the given position, if the block has one.
+func NewBlockEntity(block objects.BlockID, pos data.PositionI) (BlockEntity, bool) {
+	entityType, ok := FindBlockEntityType(block)
+	if !ok {
+		return nil, false
+	}
+
+	switch entityType {
+	case BlockEntityChest:
+		return &ChestEntity{Container: items.NewContainer(items.ChestSize), pos: pos}, true
+	case BlockEntityFurnace:
+		return &FurnaceEntity{Furnace: items.NewFurnace(), pos: pos}, true
+	case BlockEntitySign:
+		return newSignEntity(pos), true
+	}
+	return &DataEntity{entityType: entityType, pos: pos, isDirty: true, data: map[string]interface{}{}}, true
+}
+
+func newSignEntity(pos data.PositionI) *SignEntity {
+	entity := &SignEntity{pos: pos, isDirty: true, Color: signDefaultColor}
+	for i := range entity.Lines {
+		entity.Lines[i] = chat.New("")
+	}
+	return entity
+}
+
+// signDefaultColor - colour of the text on the signs that were not dyed.
+const signDefaultColor = "black"
+
+// Block entity NBT tags common for all block entities, as per https://minecraft.fandom.com/wiki/Chunk_format
+const (
+	tagID = "id"
+	tagX  = "x"
+	tagY  = "y"
+	tagZ  = "z"
+)
+
+// itemNBT - item in the container slot, in the Notchian format.
+type itemNBT struct {
+	Slot  int8   `nbt:"Slot"`
+	ID    string `nbt:"id"`
+	Count int8   `nbt:"Count"`
+}
+
+type chestNBT struct {
+	ID    string    `nbt:"id"`
+	X     int32     `nbt:"x"`
+	Y     int32     `nbt:"y"`
+	Z     int32     `nbt:"z"`
+	Items []itemNBT `nbt:"Items"`
+}
+
+type furnaceNBT struct {
+	ID            string    `nbt:"id"`
+	X             int32     `nbt:"x"`
+	Y             int32     `nbt:"y"`
+	Z             int32     `nbt:"z"`
+	Items         []itemNBT `nbt:"Items"`
+	BurnTime      int16     `nbt:"BurnTime"`
+	BurnTimeTotal int16     `nbt:"BurnTimeTotal"`
+	CookTime      int16     `nbt:"CookTime"`
+	CookTimeTotal int16     `nbt:"CookTimeTotal"`
+}
+
+// clientNBT - container block entity as the clients see it, the contents are only sent in the open windows.
+type clientNBT struct {
+	ID string `nbt:"id"`
+	X  int32  `nbt:"x"`
+	Y  int32  `nbt:"y"`
+	Z  int32  `nbt:"z"`
+}
+
+// signNBT - sign text lines are kept as JSON chat messages.
+type signNBT struct {
+	ID    string `nbt:"id"`
+	X     int32  `nbt:"x"`
+	Y     int32  `nbt:"y"`
+	Z     int32  `nbt:"z"`
+	Text1 string `nbt:"Text1"`
+	Text2 string `nbt:"Text2"`
+	Text3 string `nbt:"Text3"`
+	Text4 string `nbt:"Text4"`
+	Color string `nbt:"Color"`
+}
+
+// MarshalBlockEntity encodes the block entity into the Notchian NBT compound, the same one is persisted and sent
+// to the clients. Expects the entity lock to be held.
+func MarshalBlockEntity(entity BlockEntity) ([]byte, error) {
+	id, pos := string(entity.Type()), entity.Position()
+
+	var entityNBT interface{}
+	switch e := entity.(type) {
+	case *ChestEntity:
+		entityNBT = chestNBT{
+			ID:    id,
+			X:     int32(pos.X),
+			Y:     int32(pos.Y),
+			Z:     int32(pos.Z),
+			Items: marshalItems(e.Container),
+		}
+	case *FurnaceEntity:
+		entityNBT = furnaceNBT{
+			ID:            id,
+			X:             int32(pos.X),
+			Y:             int32(pos.Y),
+			Z:             int32(pos.Z),
+			Items:         marshalItems(e.Container),
+			BurnTime:      e.BurnTime,
+			BurnTimeTotal: e.BurnTotal,
+			CookTime:      e.CookTime,
+			CookTimeTotal: e.CookTotal,
+		}
+	case *SignEntity:
+		entityNBT = signNBT{
+			ID:    id,
+			X:     int32(pos.X),
+			Y:     int32(pos.Y),
+			Z:     int32(pos.Z),
+			Text1: e.Lines[0].AsJson(),
+			Text2: e.Lines[1].AsJson(),
+			Text3: e.Lines[2].AsJson(),
+			Text4: e.Lines[3].AsJson(),
+			Color: e.Color,
+		}
+	case *DataEntity:
+		dataNBT := make(map[string]interface{}, len(e.data)+4)
+		for tag, value := range e.data {
+			dataNBT[tag] = value
+		}
+		dataNBT[tagID] = id
+		dataNBT[tagX] = int32(pos.X)
+		dataNBT[tagY] = int32(pos.Y)
+		dataNBT[tagZ] = int32(pos.Z)
+		entityNBT = dataNBT
+	default:
+		return nil, fmt.Errorf("block entity type %s not supported", entity.Type())
+	}
+
+	buf := &bytes.Buffer{}
+	if err := nbt.Marshal(buf, entityNBT); err != nil {
+		return nil, fmt.Errorf("failed to marshal %s block entity: %w", entity.Type(), err)
+	}
+	return buf.Bytes(), nil
+}
+
+// MarshalClientBlockEntity encodes the block entity into the NBT compound sent to the clients with the chunk data and
+// in the block entity data updates. Same as the Notchian server, contents of the containers are left out, the rest
+// is the same as MarshalBlockEntity provides. Expects the entity lock to be held.
+func MarshalClientBlockEntity(entity BlockEntity) ([]byte, error) {
+	switch entity.(type) {
+	case *ChestEntity, *FurnaceEntity:
+	default:
+		return MarshalBlockEntity(entity)
+	}
+
+	pos := entity.Position()
+	entityNBT := clientNBT{ID: string(entity.Type()), X: int32(pos.X), Y: int32(pos.Y), Z: int32(pos.Z)}
+
+	buf := &bytes.Buffer{}
+	if err := nbt.Marshal(buf, entityNBT); err != nil {
+		return nil, fmt.Errorf("failed to marshal %s block entity: %w", entity.Type(), err)
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBlockEntity decodes the block entity of the given type from the NBT persisted by MarshalBlockEntity.
+func UnmarshalBlockEntity(entityType BlockEntityType, pos data.PositionI, entityData []byte) (BlockEntity, error) {
+	switch entityType {
+	case BlockEntityChest:
+		var chest chestNBT
+		if err := nbt.Unmarshal(entityData, &chest); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s block entity: %w", entityType, err)
+		}
+
+		entity := &ChestEntity{Container: items.NewContainer(items.ChestSize), pos: pos}
+		unmarshalItems(entity.Container, chest.Items)
+		return entity, nil
+	case BlockEntityFurnace:
+		var furnace furnaceNBT
+		if err := nbt.Unmarshal(entityData, &furnace); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s block entity: %w", entityType, err)
+		}
+
+		entity := &FurnaceEntity{Furnace: items.NewFurnace(), pos: pos}
+		unmarshalItems(entity.Container, furnace.Items)
+		entity.BurnTime = furnace.BurnTime
+		entity.BurnTotal = furnace.BurnTimeTotal
+		entity.CookTime = furnace.CookTime
+		entity.CookTotal = furnace.CookTimeTotal
+		return entity, nil
+	case BlockEntitySign:
+		var sign signNBT
+		if err := nbt.Unmarshal(entityData, &sign); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s block entity: %w", entityType, err)
+		}
+
+		entity := newSignEntity(pos)
+		entity.isDirty = false
+		if sign.Color != "" {
+			entity.Color = sign.Color
+		}
+		for i, text := range []string{sign.Text1, sign.Text2, sign.Text3, sign.Text4} {
+			if text == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(text), entity.Lines[i]); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal %s block entity line %d: %w", entityType, i, err)
+			}
+		}
+		return entity, nil
+	}
+
+	dataNBT := make(map[string]interface{})
+	if err := nbt.Unmarshal(entityData, &dataNBT); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s block entity: %w", entityType, err)
+	}
+	for _, tag := range []string{tagID, tagX, tagY, tagZ} {
+		delete(dataNBT, tag)
+	}
+	return &DataEntity{entityType: entityType, pos: pos, data: dataNBT}, nil
+}
+
+func marshalItems(container *items.Container) []itemNBT {
+	var itemsNBT []itemNBT
+	for slotID, item := range container.Slots() {
+		if !item.IsPresent {
+			continue
+		}
+		itemsNBT = append(itemsNBT, itemNBT{Slot: int8(slotID), ID: item.ItemID.String(), Count: int8(item.ItemCount)})
+	}
+	return itemsNBT
+}
+
+// unmarshalItems puts the items into the container, leaving the container unchanged since it was just loaded.
+func unmarshalItems(container *items.Container, itemsNBT []itemNBT) {
+	for _, item := range itemsNBT {
+		itemID, ok := objects.ItemByName(item.ID)
+		if !ok {
+			continue // DEBT items unknown to this server version are lost
+		}
+		container.SetSlot(int16(item.Slot), items.Slot{IsPresent: true, ItemID: itemID, ItemCount: int16(item.Count)})
+	}
+	container.MarkSaved()
+}
@@ -1,20 +1,20 @@
 package level
 
 import (
-	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/alexykot/cncraft/pkg/game/data"
-	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
+// SectionsPerChunk - sections in the chunk supported by the server, i.e. chunks are 128 blocks high.
 // DEBT make this configurable for supporting taller worlds
-const sectionsPerChunk = 8
+const SectionsPerChunk = 8
 
-// SectionRepo - persistence-aware interface for loading and saving sections.
+// SectionRepo - persistence-aware interface for loading and saving sections and the block entities in them.
 type SectionRepo interface {
 	// LoadSection - loads section from persistence into memory
 	LoadSection(x, z int64, index uint8) (Section, error)
@@ -22,7 +22,16 @@ type SectionRepo interface {
 	// SaveSection - saves section state
 	// DEBT This does not allow for differential updates, will be ineffective to save whole section every time
 	//  a block in the section is updated. Will need to be optimised for diff updates only, eventually.
-	SaveSection(Section) error
+	SaveSection(x, z int64, section Section) error
+
+	// LoadBlockEntities - loads all block entities of the chunk from persistence into memory
+	LoadBlockEntities(x, z int64) ([]BlockEntity, error)
+
+	// SaveBlockEntity - saves block entity state, expects the entity lock to be held
+	SaveBlockEntity(x, z int64, entity BlockEntity) error
+
+	// DeleteBlockEntity - deletes the saved block entity at the given global block coords
+	DeleteBlockEntity(x, z int64, p data.PositionI) error
 }
 
 type heightMap struct {
@@ -71,11 +80,30 @@ type Chunk interface {
 	Z() int64 // block coordinates of the lowest Z block in the chunk, NOT the chunk coord (divided by 16 rounded down)
 
 	Load(repo SectionRepo) error
-	Unload()
+	// Unload - saves all changed sections and drops sections from memory.
+	Unload(repo SectionRepo) error
+	IsLoaded() bool
 
+	// Save - saves all sections that were changed since the chunk was loaded or last saved.
+	Save(repo SectionRepo) error
+
+	// RLock - holds the chunk sections in place for reading, so that the chunk is neither changed nor unloaded
+	// while it is marshalled. Needed for Sections, HeightMap, SkyLight and BlockLight, the rest locks by itself.
+	RLock()
+	RUnlock()
+
+	// Sections - expects the chunk read lock to be held.
 	Sections() []Section
+	// HeightMap - expects the chunk read lock to be held.
 	HeightMap() heightMap
 
+	// SkyLight - sky light levels of the section blocks packed in nibbles, nil if the section is dark.
+	// Expects the chunk read lock to be held.
+	SkyLight(sectionIndex int) []byte
+	// BlockLight - light levels of the section blocks emitted by light sources packed in nibbles, nil if the
+	// section is dark. Expects the chunk read lock to be held.
+	BlockLight(sectionIndex int) []byte
+
 	// GetBlock - supports values x.[0:15] y.[0:255] z.[0:15]
 	GetBlock(p data.PositionI) (Block, error)
 
@@ -87,6 +115,19 @@ type Chunk interface {
 
 	// SetGlobalBlock - supports any x.y.z values, but validates if the coords belong to this chunk, errors out if not.
 	SetGlobalBlock(p data.PositionI, block Block) error
+
+	// GetBlockEntity - supports any x.y.z values, provides the block entity at the global block coords if there is one.
+	GetBlockEntity(p data.PositionI) (BlockEntity, bool)
+
+	// SetBlockEntity - validates if the entity coords belong to this chunk, errors out if not. Replaces the entity
+	// at the same coords if there is one.
+	SetBlockEntity(entity BlockEntity) error
+
+	// RemoveBlockEntity - supports any x.y.z values, removes the block entity at the global block coords if there is one.
+	RemoveBlockEntity(p data.PositionI)
+
+	// BlockEntities - all block entities of the chunk.
+	BlockEntities() []BlockEntity
 }
 
 // DEBT no performance considerations applied here yet. Likely will have to be redesigned for RAM/CPU efficiency.
@@ -109,27 +150,41 @@ type chunk struct {
 	x int64
 	z int64
 
+	// sections are read by the chunk streamer and other shards while the shard owning the chunk changes or unloads them
+	mu       sync.RWMutex
 	sections []Section
+	light    *chunkLight
+	skyless  bool // chunks of dimensions with no skylight, i.e. the nether and the end, are not lit by the sky
+
+	// block entities are read by the chunk streamer while the shard changes them
+	entitiesMu      sync.RWMutex
+	blockEntities   map[data.PositionI]BlockEntity
+	removedEntities map[data.PositionI]struct{} // removed since the chunk was loaded or last saved
 }
 
 // NewChunk creates new chunk (not loaded yet)
-func NewChunk(x, z int64) Chunk {
-	return &chunk{x: x, z: z}
+func NewChunk(x, z int64, hasSkylight bool) Chunk {
+	return &chunk{x: x, z: z, skyless: !hasSkylight}
 }
 
 func (c *chunk) ID() ChunkID { return MkChunkID(c.x, c.z) }
 func (c *chunk) X() int64    { return c.x }
 func (c *chunk) Z() int64    { return c.z }
 
+func (c *chunk) RLock()   { c.mu.RLock() }
+func (c *chunk) RUnlock() { c.mu.RUnlock() }
+
 func (c *chunk) Sections() []Section {
 	return c.sections
 }
 
 func (c *chunk) Load(repo SectionRepo) error {
-	c.Unload()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	var err error
 
-	c.sections = make([]Section, sectionsPerChunk, sectionsPerChunk)
+	c.sections = make([]Section, SectionsPerChunk, SectionsPerChunk)
 
 	if c.sections[0], err = repo.LoadSection(c.x, c.z, 0); err != nil {
 		return fmt.Errorf("failed to load section %d: %w", 0, err)
@@ -158,11 +213,117 @@ func (c *chunk) Load(repo SectionRepo) error {
 	// 	return fmt.Errorf("failed to load section %d: %w", 7, err)
 	// }
 
+	entities, err := repo.LoadBlockEntities(c.x, c.z)
+	if err != nil {
+		return fmt.Errorf("failed to load block entities: %w", err)
+	}
+	c.entitiesMu.Lock()
+	c.blockEntities = make(map[data.PositionI]BlockEntity, len(entities))
+	c.removedEntities = make(map[data.PositionI]struct{})
+	for _, entity := range entities {
+		c.blockEntities[entity.Position()] = entity
+	}
+	c.entitiesMu.Unlock()
+
+	c.computeLight()
 	return nil
 }
 
-func (c *chunk) Unload() {
+func (c *chunk) Unload(repo SectionRepo) error {
+	if err := c.Save(repo); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
 	c.sections = nil // DEBT is this enough to unload section data from memory 🤔
+	c.light = nil
+	c.mu.Unlock()
+
+	c.entitiesMu.Lock()
+	c.blockEntities = nil
+	c.removedEntities = nil
+	c.entitiesMu.Unlock()
+	return nil
+}
+
+func (c *chunk) IsLoaded() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.sections != nil
+}
+
+func (c *chunk) Save(repo SectionRepo) error {
+	if err := c.saveSections(repo); err != nil {
+		return err
+	}
+
+	c.entitiesMu.Lock()
+	defer c.entitiesMu.Unlock()
+
+	for p := range c.removedEntities {
+		if err := repo.DeleteBlockEntity(c.x, c.z, p); err != nil {
+			return fmt.Errorf("failed to delete block entity at x.%d y.%d z.%d: %w", p.X, p.Y, p.Z, err)
+		}
+		delete(c.removedEntities, p)
+	}
+
+	for _, entity := range c.blockEntities {
+		if err := c.saveBlockEntity(repo, entity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveSections saves the dirty sections under the read lock, so that the blocks are not changed while being saved.
+// The sections are marked saved under the write lock afterwards, changes made in between keep them dirty.
+func (c *chunk) saveSections(repo SectionRepo) error {
+	savedVersions, err := c.saveDirtySections(repo)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for chunkSection, version := range savedVersions {
+		chunkSection.MarkSaved(version)
+	}
+	return err
+}
+
+func (c *chunk) saveDirtySections(repo SectionRepo) (map[Section]uint64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	savedVersions := make(map[Section]uint64)
+	for _, chunkSection := range c.sections {
+		if chunkSection == nil || !chunkSection.IsDirty() {
+			continue
+		}
+
+		version := chunkSection.Version()
+		if err := repo.SaveSection(c.x, c.z, chunkSection); err != nil {
+			return savedVersions, fmt.Errorf("failed to save section %d: %w", chunkSection.Index(), err)
+		}
+		savedVersions[chunkSection] = version
+	}
+	return savedVersions, nil
+}
+
+func (c *chunk) saveBlockEntity(repo SectionRepo, entity BlockEntity) error {
+	entity.Lock()
+	defer entity.Unlock()
+
+	if !entity.IsDirty() {
+		return nil
+	}
+
+	if err := repo.SaveBlockEntity(c.x, c.z, entity); err != nil {
+		p := entity.Position()
+		return fmt.Errorf("failed to save block entity at x.%d y.%d z.%d: %w", p.X, p.Y, p.Z, err)
+	}
+	entity.MarkSaved()
+	return nil
 }
 
 func (c *chunk) HeightMap() heightMap {
@@ -176,22 +337,55 @@ func (c *chunk) HeightMap() heightMap {
 }
 
 func (c *chunk) GetBlock(p data.PositionI) (Block, error) {
-	sectionIndex := int(math.Floor(float64(p.Y / sectionsPerChunk)))
-	if len(c.sections) < sectionIndex {
-		return nil, fmt.Errorf("block coord y.%d out of range", p.Y)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	chunkSection, err := c.findSection(p.Y)
+	if err != nil {
+		return nil, err
 	}
 
-	sectionY := p.Y % sectionsPerChunk
-	sectionBlock := c.sections[sectionIndex].GetBlock(p.X, sectionY, p.Z)
+	sectionY := p.Y % SectionY
+	sectionBlock := chunkSection.GetBlock(p.X, sectionY, p.Z)
 	if sectionBlock == nil {
 		return nil, fmt.Errorf("failed to find block in chunk %s, section %d at coords x.%d y.%d z.%d",
-			string(c.ID()), sectionIndex, p.X, sectionY, p.Z)
+			string(c.ID()), chunkSection.Index(), p.X, sectionY, p.Z)
 	}
 	return sectionBlock, nil
 }
 
 func (c *chunk) SetBlock(p data.PositionI, block Block) error {
-	return errors.New("SetBlock unimplemented")
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	chunkSection, err := c.findSection(p.Y)
+	if err != nil {
+		return err
+	}
+
+	if err := chunkSection.SetBlock(p.X, p.Y%SectionY, p.Z, block); err != nil {
+		return fmt.Errorf("failed to set block in chunk %s, section %d: %w", string(c.ID()), chunkSection.Index(), err)
+	}
+
+	c.updateLight(p)
+	return nil
+}
+
+// findSection finds the loaded section containing the given y block coordinate.
+func (c *chunk) findSection(y int64) (Section, error) {
+	if y < 0 {
+		return nil, fmt.Errorf("block coord y.%d out of range", y)
+	}
+
+	sectionIndex := int(y / SectionY)
+	if len(c.sections) <= sectionIndex {
+		return nil, fmt.Errorf("block coord y.%d out of range", y)
+	}
+
+	if c.sections[sectionIndex] == nil {
+		return nil, fmt.Errorf("section %d of chunk %s is not loaded", sectionIndex, string(c.ID()))
+	}
+	return c.sections[sectionIndex], nil
 }
 
 func (c *chunk) GetGlobalBlock(p data.PositionI) (Block, error) {
@@ -216,46 +410,64 @@ func (c *chunk) SetGlobalBlock(p data.PositionI, block Block) error {
 	return c.SetBlock(getLocalPosition(p), block)
 }
 
-func (c *chunk) findHeights() [ChunkX][ChunkZ]uint8 {
-	var sectionIndex int64
+func (c *chunk) GetBlockEntity(p data.PositionI) (BlockEntity, bool) {
+	c.entitiesMu.RLock()
+	defer c.entitiesMu.RUnlock()
 
-	// find the topmost non-empty section to start from
-	for index, chunkSection := range c.sections {
-		if chunkSection != nil {
-			sectionIndex = int64(index)
-		}
+	entity, ok := c.blockEntities[p]
+	return entity, ok
+}
+
+func (c *chunk) SetBlockEntity(entity BlockEntity) error {
+	p := entity.Position()
+	if c.x != getChunkXZ(p.X) || c.z != getChunkXZ(p.Z) {
+		return fmt.Errorf("coords x.%d z.%d are outside of chunk %s", p.X, p.Z, c.ID())
 	}
 
-	heights := [ChunkX][ChunkZ]uint8{}
-
-	var heightsFound int
-	// walk through sections down
-	for ; sectionIndex >= 0; sectionIndex-- {
-		// walk every column in the section
-		for x := int64(0); x < ChunkX; x++ {
-			for z := int64(0); z < ChunkZ; z++ {
-				if heights[x][z] != 0 { // skip if the given column already has a height
-					continue
-				}
-
-				// scan column top-down and look for non-air blocks
-				for y := int64(SectionY); y > 0; y-- {
-					sectionBlock := c.sections[sectionIndex].GetBlock(x, y-1, z)
-					// DEBT check for solid block rather than non-air, start at https://minecraft.gamepedia.com/Solid_block
-					if sectionBlock.ID() != objects.BlockAir {
-						// DEBT for unknown reason Notchian server supplies height of "2" in the flatworld the
-						//  solid block height is "4". Adjusting until figure out why.
-						heights[x][z] = uint8(y + sectionIndex*SectionY - 2)
-						heightsFound++
-						break
-					}
-				}
-			}
-		}
+	c.entitiesMu.Lock()
+	defer c.entitiesMu.Unlock()
+
+	if c.blockEntities == nil {
+		return fmt.Errorf("chunk %s is not loaded", c.ID())
+	}
+
+	c.blockEntities[p] = entity
+	delete(c.removedEntities, p)
+	return nil
+}
 
-		// if all non-air heights are found - stop scanning
-		if heightsFound == ChunkX*ChunkZ {
-			break
+func (c *chunk) RemoveBlockEntity(p data.PositionI) {
+	c.entitiesMu.Lock()
+	defer c.entitiesMu.Unlock()
+
+	if _, ok := c.blockEntities[p]; !ok {
+		return
+	}
+
+	delete(c.blockEntities, p)
+	c.removedEntities[p] = struct{}{}
+}
+
+func (c *chunk) BlockEntities() []BlockEntity {
+	c.entitiesMu.RLock()
+	defer c.entitiesMu.RUnlock()
+
+	entities := make([]BlockEntity, 0, len(c.blockEntities))
+	for _, entity := range c.blockEntities {
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+func (c *chunk) findHeights() [ChunkX][ChunkZ]uint8 {
+	heights := c.findSurface()
+	for x := range heights {
+		for z := range heights[x] {
+			if heights[x][z] != 0 {
+				// DEBT for unknown reason Notchian server supplies height of "2" in the flatworld the
+				//  solid block height is "4". Adjusting until figure out why.
+				heights[x][z] -= 2
+			}
 		}
 	}
 	return heights
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/nbt"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 )
 
@@ -54,24 +58,24 @@ func TestCompactHeights(t *testing.T) {
 
 func getDefaultChunk() *chunk {
 	s := &section{index: 0, blocks: [16][16][16]Block{}}
-	for x, zBlocks := range s.blocks {
-		for z := range zBlocks {
-			s.blocks[x][z][0] = NewBlock(objects.BlockDirt)
-			s.blocks[x][z][1] = NewBlock(objects.BlockDirt)
-			s.blocks[x][z][2] = NewBlock(objects.BlockDirt)
-			s.blocks[x][z][3] = NewBlock(objects.BlockDirt)
-			s.blocks[x][z][4] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][5] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][6] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][7] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][8] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][9] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][10] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][11] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][12] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][13] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][14] = NewBlock(objects.BlockAir)
-			s.blocks[x][z][15] = NewBlock(objects.BlockAir)
+	for z := 0; z < SectionZ; z++ {
+		for x := 0; x < SectionX; x++ {
+			s.blocks[0][z][x] = NewBlock(objects.BlockDirt)
+			s.blocks[1][z][x] = NewBlock(objects.BlockDirt)
+			s.blocks[2][z][x] = NewBlock(objects.BlockDirt)
+			s.blocks[3][z][x] = NewBlock(objects.BlockDirt)
+			s.blocks[4][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[5][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[6][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[7][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[8][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[9][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[10][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[11][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[12][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[13][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[14][z][x] = NewBlock(objects.BlockAir)
+			s.blocks[15][z][x] = NewBlock(objects.BlockAir)
 		}
 	}
 
@@ -172,3 +176,166 @@ func TestGetChunkXZ(t *testing.T) {
 		assert.True(t, ChunkX == ChunkZ, "only square chunks supported")
 	})
 }
+
+type memRepo struct {
+	saved    map[int]Section
+	entities map[data.PositionI]savedEntity
+}
+
+type savedEntity struct {
+	entityType BlockEntityType
+	data       []byte
+}
+
+func (r *memRepo) LoadSection(x, z int64, index uint8) (Section, error) {
+	return r.saved[int(index)], nil
+}
+
+func (r *memRepo) SaveSection(x, z int64, section Section) error {
+	r.saved[section.Index()] = section
+	return nil
+}
+
+func (r *memRepo) LoadBlockEntities(x, z int64) ([]BlockEntity, error) {
+	var entities []BlockEntity
+	for p, saved := range r.entities {
+		entity, err := UnmarshalBlockEntity(saved.entityType, p, saved.data)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+func (r *memRepo) SaveBlockEntity(x, z int64, entity BlockEntity) error {
+	entityData, err := MarshalBlockEntity(entity)
+	if err != nil {
+		return err
+	}
+	r.entities[entity.Position()] = savedEntity{entityType: entity.Type(), data: entityData}
+	return nil
+}
+
+func (r *memRepo) DeleteBlockEntity(x, z int64, p data.PositionI) error {
+	delete(r.entities, p)
+	return nil
+}
+
+func TestUnload(t *testing.T) {
+	t.Run("saves_dirty_sections", func(t *testing.T) {
+		repo := &memRepo{saved: make(map[int]Section), entities: make(map[data.PositionI]savedEntity)}
+		c := getDefaultChunk()
+		assert.True(t, c.IsLoaded())
+
+		assert.NoError(t, c.SetBlock(data.PositionI{X: 1, Y: 1, Z: 1}, NewBlock(objects.BlockStone)))
+		assert.NoError(t, c.Unload(repo))
+		assert.False(t, c.IsLoaded())
+
+		if assert.Contains(t, repo.saved, 0) {
+			assert.Equal(t, objects.BlockStone, repo.saved[0].GetBlock(1, 1, 1).ID())
+			assert.False(t, repo.saved[0].IsDirty())
+		}
+	})
+
+	t.Run("skips_clean_sections", func(t *testing.T) {
+		repo := &memRepo{saved: make(map[int]Section), entities: make(map[data.PositionI]savedEntity)}
+		c := getDefaultChunk()
+
+		assert.NoError(t, c.Unload(repo))
+		assert.False(t, c.IsLoaded())
+		assert.Empty(t, repo.saved)
+	})
+}
+
+func TestBlockEntities(t *testing.T) {
+	repo := &memRepo{saved: make(map[int]Section), entities: make(map[data.PositionI]savedEntity)}
+	repo.saved[0] = getDefaultChunk().sections[0]
+	c := NewChunk(16, 0, true)
+	require.NoError(t, c.Load(repo))
+
+	chestPos := data.PositionI{X: 17, Y: 4, Z: 1}
+	entity, ok := NewBlockEntity(objects.BlockChest_FacingNorthTypeSingleWaterloggedFalse, chestPos)
+	require.True(t, ok)
+	chest := entity.(*ChestEntity)
+	chest.SetSlot(3, items.Slot{IsPresent: true, ItemID: objects.ItemDiamond, ItemCount: 5})
+	require.NoError(t, c.SetBlockEntity(chest))
+
+	furnacePos := data.PositionI{X: 18, Y: 4, Z: 1}
+	entity, ok = NewBlockEntity(objects.BlockFurnace_FacingNorthLitFalse, furnacePos)
+	require.True(t, ok)
+	furnace := entity.(*FurnaceEntity)
+	furnace.BurnTime = 100
+	furnace.SetSlot(items.FurnaceFuel, items.Slot{IsPresent: true, ItemID: objects.ItemCoal, ItemCount: 1})
+	require.NoError(t, c.SetBlockEntity(furnace))
+
+	bannerPos := data.PositionI{X: 19, Y: 4, Z: 1}
+	entity, ok = NewBlockEntity(objects.BlockWhiteWallBanner_FacingNorth, bannerPos)
+	require.True(t, ok)
+	banner := entity.(*DataEntity)
+	assert.Equal(t, BlockEntityBanner, banner.Type())
+	banner.data["CustomName"] = `{"text":"Banner"}`
+	require.NoError(t, c.SetBlockEntity(banner))
+
+	_, ok = NewBlockEntity(objects.BlockDirt, chestPos)
+	assert.False(t, ok)
+	assert.Error(t, c.SetBlockEntity(&ChestEntity{pos: data.PositionI{X: 1}}), "entity outside of the chunk")
+
+	require.NoError(t, c.Unload(repo))
+	require.Len(t, repo.entities, 3)
+
+	require.NoError(t, c.Load(repo))
+	entity, ok = c.GetBlockEntity(chestPos)
+	require.True(t, ok)
+	loadedChest := entity.(*ChestEntity)
+	assert.Equal(t, items.Slot{IsPresent: true, ItemID: objects.ItemDiamond, ItemCount: 5}, loadedChest.GetSlot(3))
+	assert.False(t, loadedChest.IsDirty())
+
+	clientData, err := MarshalClientBlockEntity(loadedChest)
+	require.NoError(t, err)
+	var clientChest chestNBT
+	require.NoError(t, nbt.Unmarshal(clientData, &clientChest))
+	assert.Equal(t, chestNBT{ID: string(BlockEntityChest), X: 17, Y: 4, Z: 1}, clientChest, "chest contents are not sent")
+
+	entity, ok = c.GetBlockEntity(furnacePos)
+	require.True(t, ok)
+	assert.Equal(t, int16(100), entity.(*FurnaceEntity).BurnTime)
+
+	entity, ok = c.GetBlockEntity(bannerPos)
+	require.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"CustomName": `{"text":"Banner"}`}, entity.(*DataEntity).data)
+
+	c.RemoveBlockEntity(chestPos)
+	_, ok = c.GetBlockEntity(chestPos)
+	assert.False(t, ok)
+	require.NoError(t, c.Save(repo))
+	assert.Len(t, repo.entities, 2)
+}
+
+func TestSignEntity(t *testing.T) {
+	signPos := data.PositionI{X: 1, Y: 4, Z: 1}
+	entity, ok := NewBlockEntity(objects.BlockOakWallSign_FacingNorthWaterloggedFalse, signPos)
+	require.True(t, ok)
+	sign := entity.(*SignEntity)
+
+	writer, stranger := uuid.New(), uuid.New()
+	lines := [SignLineCount]string{"first", "", "third", ""}
+	assert.False(t, sign.Write(writer, lines), "nobody may write before the editor is set")
+
+	sign.SetEditor(writer)
+	assert.False(t, sign.Write(stranger, lines), "only the editor may write")
+	require.True(t, sign.Write(writer, lines))
+	assert.False(t, sign.Write(writer, lines), "the sign may only be written once")
+
+	signNBT, err := MarshalBlockEntity(sign)
+	require.NoError(t, err)
+	loaded, err := UnmarshalBlockEntity(BlockEntitySign, signPos, signNBT)
+	require.NoError(t, err)
+
+	loadedSign := loaded.(*SignEntity)
+	for i, line := range lines {
+		assert.Equal(t, line, loadedSign.Lines[i].AsText())
+	}
+	assert.Equal(t, "black", loadedSign.Color)
+	assert.False(t, loadedSign.IsDirty())
+}
@@ -24,11 +24,22 @@ type dimension struct {
 	boundaries Edges
 }
 
-var defaultDim *dimension
+// NewDimension creates the dimension with all chunks within the given edges (not loaded yet). Edges are the block
+// coordinates of the outermost chunks. Chunks of the dimension are lit by the sky only if it has skylight.
+func NewDimension(name string, edges Edges, hasSkylight bool) Dimension {
+	chunks := map[ChunkID]Chunk{}
+	for x := edges.NegativeX; x <= edges.PositiveX; x = x + SectionX {
+		for z := edges.NegativeZ; z <= edges.PositiveZ; z = z + SectionZ {
+			chunk := NewChunk(x, z, hasSkylight)
+			chunks[chunk.ID()] = chunk
+		}
+	}
 
-func NewDimension(name string) Dimension {
-	// TODO replace with actual dimension loading
-	return getDefaultDimension(name)
+	return &dimension{
+		name:       name,
+		chunks:     chunks,
+		boundaries: edges,
+	}
 }
 
 func (d *dimension) Name() string              { return d.name }
@@ -38,38 +49,3 @@ func (d *dimension) GetChunk(id ChunkID) (Chunk, bool) {
 	return chunk, ok
 }
 func (d *dimension) Edges() Edges { return d.boundaries }
-
-func getDefaultDimension(name string) Dimension {
-	if defaultDim == nil {
-		chunks := map[ChunkID]Chunk{}
-		for x := -48; x <= 48; x = x + 16 {
-			for z := -48; z <= 48; z = z + 16 {
-				chunk := NewChunk(int64(x), int64(z))
-				chunks[chunk.ID()] = chunk
-			}
-		}
-
-		defaultDim = &dimension{
-			name:   name,
-			chunks: chunks,
-		}
-
-		// Assuming the dimension is not going to change dynamically, and is rectangular shape.
-		for _, chunk := range defaultDim.chunks {
-			if defaultDim.boundaries.NegativeX > chunk.X() {
-				defaultDim.boundaries.NegativeX = chunk.X()
-			}
-			if defaultDim.boundaries.NegativeZ > chunk.Z() {
-				defaultDim.boundaries.NegativeZ = chunk.Z()
-			}
-			if defaultDim.boundaries.PositiveX < chunk.X() {
-				defaultDim.boundaries.PositiveX = chunk.X()
-			}
-			if defaultDim.boundaries.PositiveZ < chunk.Z() {
-				defaultDim.boundaries.PositiveZ = chunk.Z()
-			}
-		}
-	}
-
-	return defaultDim
-}
@@ -0,0 +1,343 @@
+package level
+
+import (
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// LightArrSize - size of the light levels of a section packed in nibbles, as sent to the client.
+const LightArrSize = SectionX * SectionY * SectionZ / 2
+
+// fullSkyLight - packed light of a section fully lit by the open sky.
+var fullSkyLight = func() []byte {
+	arr := make([]byte, LightArrSize)
+	for i := range arr {
+		arr[i] = objects.MaxLight<<4 | objects.MaxLight
+	}
+	return arr
+}()
+
+// chunkLight holds sky and block light levels of every block in the chunk, indexed the same as section
+// blocks, i.e. [y][z][x], with y spanning all sections.
+// DEBT light is computed within the chunk only, the light coming in from the neighbouring chunks is not accounted for.
+type chunkLight struct {
+	height int64 // height of the chunk in blocks
+	sky    []uint8
+	block  []uint8
+}
+
+// lightDirections - offsets of the six neighbouring blocks the light spreads to.
+var lightDirections = [6]data.PositionI{
+	{X: 0, Y: -1, Z: 0}, // down must go first, sky light spreads down without dimming
+	{X: 0, Y: 1, Z: 0},
+	{X: -1, Y: 0, Z: 0},
+	{X: 1, Y: 0, Z: 0},
+	{X: 0, Y: 0, Z: -1},
+	{X: 0, Y: 0, Z: 1},
+}
+
+// SkyLight provides sky light of the given section. Sections above the chunk are lit by the open sky, and sections
+// below it are dark. Provides nil if the section is dark, the chunk has no skylight or is not loaded.
+func (c *chunk) SkyLight(sectionIndex int) []byte {
+	if c.light == nil || c.skyless || sectionIndex < 0 {
+		return nil
+	}
+	if sectionIndex >= len(c.sections) {
+		return fullSkyLight
+	}
+	return packLight(c.light.sky, sectionIndex)
+}
+
+// BlockLight provides light of the light emitting blocks in the given section. Provides nil if the section is dark
+// or the chunk is not loaded.
+func (c *chunk) BlockLight(sectionIndex int) []byte {
+	if c.light == nil || sectionIndex < 0 || sectionIndex >= len(c.sections) {
+		return nil
+	}
+	return packLight(c.light.block, sectionIndex)
+}
+
+// computeLight computes light of the whole chunk. Sky light fills the chunk down to the height map and goes on down
+// through transparent blocks, block light spreads out from the light emitting blocks. Chunks with no skylight are
+// left dark by the sky.
+func (c *chunk) computeLight() {
+	height := int64(len(c.sections) * SectionY)
+	c.light = &chunkLight{
+		height: height,
+		sky:    make([]uint8, height*SectionZ*SectionX),
+		block:  make([]uint8, height*SectionZ*SectionX),
+	}
+
+	if !c.skyless {
+		c.computeSkyLight()
+	}
+
+	var blockQueue []int
+	for x := int64(0); x < ChunkX; x++ {
+		for z := int64(0); z < ChunkZ; z++ {
+			for y := int64(0); y < height; y++ {
+				if emission := c.blockIDAt(x, y, z).LightEmission(); emission > 0 {
+					c.light.block[lightIndex(x, y, z)] = emission
+					blockQueue = append(blockQueue, lightIndex(x, y, z))
+				}
+			}
+		}
+	}
+	c.spreadLight(c.light.block, blockQueue, false)
+}
+
+// computeSkyLight fills the chunk with the sky light down to the height map, and spreads it on from there.
+func (c *chunk) computeSkyLight() {
+	height := c.light.height
+	surface := c.findSurface()
+	var topSurface int64
+	for x := range surface {
+		for z := range surface[x] {
+			if int64(surface[x][z]) > topSurface {
+				topSurface = int64(surface[x][z])
+			}
+		}
+	}
+
+	var skyQueue []int
+	for x := int64(0); x < ChunkX; x++ {
+		for z := int64(0); z < ChunkZ; z++ {
+			y := height - 1
+			for ; y >= int64(surface[x][z]); y-- {
+				c.light.sky[lightIndex(x, y, z)] = objects.MaxLight
+			}
+			// the topmost non-air block may still let the sky through
+			level := int(objects.MaxLight)
+			for ; y >= 0; y-- {
+				if level -= int(c.blockIDAt(x, y, z).LightOpacity()); level <= 0 {
+					break
+				}
+				c.light.sky[lightIndex(x, y, z)] = uint8(level)
+			}
+			// spread from below the topmost surface, the sky may light blocks under overhangs of the higher columns
+			for y++; y <= topSurface && y < height; y++ {
+				skyQueue = append(skyQueue, lightIndex(x, y, z))
+			}
+		}
+	}
+	c.spreadLight(c.light.sky, skyQueue, true)
+}
+
+// updateLight updates the chunk light after the block at the given position has changed. Light that was coming
+// through or from the old block is removed, and then the light around it is spread out again.
+func (c *chunk) updateLight(p data.PositionI) {
+	if c.light == nil {
+		return
+	}
+
+	for _, sky := range []bool{true, false} {
+		if sky && c.skyless {
+			continue
+		}
+
+		levels := c.light.block
+		if sky {
+			levels = c.light.sky
+		}
+
+		removed, respread := c.unspreadLight(levels, lightIndex(p.X, p.Y, p.Z), sky)
+		if sky {
+			// blocks at the top of the chunk are lit by the open sky above them
+			for _, i := range removed {
+				if x, y, z := lightPosition(i); y == c.light.height-1 {
+					if level := int(objects.MaxLight) - int(c.blockIDAt(x, y, z).LightOpacity()); level > 0 {
+						levels[i] = uint8(level)
+						respread = append(respread, i)
+					}
+				}
+			}
+		} else if emission := c.blockIDAt(p.X, p.Y, p.Z).LightEmission(); emission > 0 {
+			i := lightIndex(p.X, p.Y, p.Z)
+			if emission > levels[i] {
+				levels[i] = emission
+			}
+			respread = append(respread, i)
+		}
+		c.spreadLight(levels, respread, sky)
+	}
+}
+
+// spreadLight spreads the light out from the queued blocks, dimming it by at least one level per block.
+func (c *chunk) spreadLight(levels []uint8, queue []int, sky bool) {
+	for len(queue) > 0 {
+		i := queue[0]
+		queue = queue[1:]
+
+		level := int(levels[i])
+		if level <= 1 {
+			continue
+		}
+
+		x, y, z := lightPosition(i)
+		for d, dir := range lightDirections {
+			nx, ny, nz := x+dir.X, y+dir.Y, z+dir.Z
+			if !c.inLightBounds(nx, ny, nz) {
+				continue
+			}
+
+			opacity := int(c.blockIDAt(nx, ny, nz).LightOpacity())
+			if opacity >= objects.MaxLight {
+				continue
+			}
+
+			var newLevel int
+			if sky && d == 0 && level == objects.MaxLight && opacity == 0 {
+				newLevel = objects.MaxLight
+			} else if opacity > 1 {
+				newLevel = level - opacity
+			} else {
+				newLevel = level - 1
+			}
+
+			n := lightIndex(nx, ny, nz)
+			if newLevel > int(levels[n]) {
+				levels[n] = uint8(newLevel)
+				queue = append(queue, n)
+			}
+		}
+	}
+}
+
+// unspreadLight removes the light that has spread out from the given block. Provides the blocks the light was
+// removed from, and the lit blocks around them that need to spread their light again.
+func (c *chunk) unspreadLight(levels []uint8, start int, sky bool) (removed, respread []int) {
+	type node struct {
+		i     int
+		level uint8
+	}
+
+	queue := []node{{i: start, level: levels[start]}}
+	levels[start] = 0
+	removed = append(removed, start)
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		x, y, z := lightPosition(current.i)
+		for d, dir := range lightDirections {
+			nx, ny, nz := x+dir.X, y+dir.Y, z+dir.Z
+			if !c.inLightBounds(nx, ny, nz) {
+				continue
+			}
+
+			n := lightIndex(nx, ny, nz)
+			level := levels[n]
+			if level == 0 {
+				continue
+			}
+
+			skyColumn := sky && d == 0 && current.level == objects.MaxLight && level == objects.MaxLight
+			if level < current.level || skyColumn {
+				levels[n] = 0
+				removed = append(removed, n)
+				queue = append(queue, node{i: n, level: level})
+			} else {
+				respread = append(respread, n)
+			}
+		}
+	}
+	return removed, respread
+}
+
+// findSurface finds the height of every column, i.e. the y coord of the block above the topmost non-air block.
+// Columns with no blocks have zero height.
+func (c *chunk) findSurface() [ChunkX][ChunkZ]uint8 {
+	var sectionIndex int64
+
+	// find the topmost non-empty section to start from
+	for index, chunkSection := range c.sections {
+		if chunkSection != nil {
+			sectionIndex = int64(index)
+		}
+	}
+
+	heights := [ChunkX][ChunkZ]uint8{}
+	if len(c.sections) == 0 {
+		return heights
+	}
+
+	var heightsFound int
+	// walk through sections down
+	for ; sectionIndex >= 0; sectionIndex-- {
+		if c.sections[sectionIndex] == nil {
+			continue
+		}
+
+		// walk every column in the section
+		for x := int64(0); x < ChunkX; x++ {
+			for z := int64(0); z < ChunkZ; z++ {
+				if heights[x][z] != 0 { // skip if the given column already has a height
+					continue
+				}
+
+				// scan column top-down and look for non-air blocks
+				for y := int64(SectionY); y > 0; y-- {
+					sectionBlock := c.sections[sectionIndex].GetBlock(x, y-1, z)
+					// DEBT check for solid block rather than non-air, start at https://minecraft.gamepedia.com/Solid_block
+					if sectionBlock.ID() != objects.BlockAir {
+						heights[x][z] = uint8(y + sectionIndex*SectionY)
+						heightsFound++
+						break
+					}
+				}
+			}
+		}
+
+		// if all non-air heights are found - stop scanning
+		if heightsFound == ChunkX*ChunkZ {
+			break
+		}
+	}
+	return heights
+}
+
+// blockIDAt provides ID of the block at the given local coords. Blocks in sections not loaded are air.
+func (c *chunk) blockIDAt(x, y, z int64) objects.BlockID {
+	chunkSection := c.sections[y/SectionY]
+	if chunkSection == nil {
+		return objects.BlockAir
+	}
+	sectionBlock := chunkSection.GetBlock(x, y%SectionY, z)
+	if sectionBlock == nil {
+		return objects.BlockAir
+	}
+	return sectionBlock.ID()
+}
+
+func (c *chunk) inLightBounds(x, y, z int64) bool {
+	return x >= 0 && x < ChunkX && z >= 0 && z < ChunkZ && y >= 0 && y < c.light.height
+}
+
+func lightIndex(x, y, z int64) int {
+	return int(y*SectionZ*SectionX + z*SectionX + x)
+}
+
+func lightPosition(i int) (x, y, z int64) {
+	return int64(i % SectionX), int64(i / (SectionZ * SectionX)), int64(i / SectionX % SectionZ)
+}
+
+// packLight packs light levels of the given section into nibbles, even blocks go into the low nibble of each byte.
+// Provides nil if the whole section is dark.
+func packLight(levels []uint8, sectionIndex int) []byte {
+	sectionLevels := levels[sectionIndex*SectionY*SectionZ*SectionX : (sectionIndex+1)*SectionY*SectionZ*SectionX]
+
+	var lit bool
+	packed := make([]byte, LightArrSize)
+	for i, level := range sectionLevels {
+		if level == 0 {
+			continue
+		}
+		lit = true
+		packed[i/2] |= level << (4 * (i % 2))
+	}
+	if !lit {
+		return nil
+	}
+	return packed
+}
@@ -0,0 +1,101 @@
+package level
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestComputeLight(t *testing.T) {
+	t.Run("flatworld", func(t *testing.T) {
+		c := getDefaultChunk()
+		c.computeLight()
+
+		for y := int64(0); y < SectionY; y++ {
+			expect := uint8(0)
+			if y >= 4 {
+				expect = objects.MaxLight
+			}
+			assert.Equal(t, expect, c.light.sky[lightIndex(5, y, 7)], "sky light at y.%d", y)
+		}
+		assert.Nil(t, c.BlockLight(0))
+		assert.Nil(t, c.SkyLight(-1))
+		assert.Equal(t, fullSkyLight, c.SkyLight(1))
+	})
+
+	t.Run("no_skylight", func(t *testing.T) {
+		c := getDefaultChunk()
+		c.skyless = true
+		c.sections[0].(*section).blocks[4][8][8] = NewBlock(objects.BlockTorch)
+		c.computeLight()
+
+		for y := int64(0); y < SectionY; y++ {
+			assert.Equal(t, uint8(0), c.light.sky[lightIndex(5, y, 7)], "sky light at y.%d", y)
+		}
+		assert.Nil(t, c.SkyLight(0))
+		assert.Nil(t, c.SkyLight(1))
+		assert.Equal(t, uint8(14), c.light.block[lightIndex(8, 4, 8)])
+
+		c.sections[0].(*section).blocks[3][3][3] = NewBlock(objects.BlockAir)
+		c.updateLight(data.PositionI{X: 3, Y: 3, Z: 3})
+		assert.Equal(t, uint8(0), c.light.sky[lightIndex(3, 3, 3)])
+	})
+
+	t.Run("light_source", func(t *testing.T) {
+		c := getDefaultChunk()
+		c.sections[0].(*section).blocks[4][8][8] = NewBlock(objects.BlockTorch)
+		c.computeLight()
+
+		assert.Equal(t, uint8(14), c.light.block[lightIndex(8, 4, 8)])
+		assert.Equal(t, uint8(13), c.light.block[lightIndex(9, 4, 8)])
+		assert.Equal(t, uint8(10), c.light.block[lightIndex(8, 7, 7)])
+		assert.Equal(t, uint8(0), c.light.block[lightIndex(8, 3, 8)], "dirt must not be lit")
+
+		packed := c.BlockLight(0)
+		require.Len(t, packed, LightArrSize)
+		i := lightIndex(8, 4, 8)
+		assert.Equal(t, byte(14), packed[i/2]&0x0F)
+		assert.Equal(t, byte(13), packed[i/2]>>4, "block x.9 goes into the high nibble")
+	})
+}
+
+func TestUpdateLight(t *testing.T) {
+	c := getDefaultChunk()
+	c.computeLight()
+
+	steps := []struct {
+		name  string
+		pos   data.PositionI
+		block objects.BlockID
+	}{
+		{name: "place_torch", pos: data.PositionI{X: 8, Y: 4, Z: 8}, block: objects.BlockTorch},
+		{name: "dig_hole", pos: data.PositionI{X: 3, Y: 3, Z: 3}, block: objects.BlockAir},
+		{name: "dig_hole_deeper", pos: data.PositionI{X: 3, Y: 2, Z: 3}, block: objects.BlockAir},
+		{name: "cover_hole", pos: data.PositionI{X: 3, Y: 6, Z: 3}, block: objects.BlockStone},
+		{name: "glass_over_torch", pos: data.PositionI{X: 8, Y: 5, Z: 8}, block: objects.BlockGlass},
+		{name: "glowstone_at_top", pos: data.PositionI{X: 0, Y: 15, Z: 0}, block: objects.BlockGlowstone},
+		{name: "remove_torch", pos: data.PositionI{X: 8, Y: 4, Z: 8}, block: objects.BlockAir},
+		{name: "uncover_hole", pos: data.PositionI{X: 3, Y: 6, Z: 3}, block: objects.BlockAir},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			require.NoError(t, c.SetBlock(step.pos, NewBlock(step.block)))
+
+			updated := *c.light
+			updated.sky = append([]uint8(nil), c.light.sky...)
+			updated.block = append([]uint8(nil), c.light.block...)
+
+			c.computeLight()
+			assert.Equal(t, c.light.sky, updated.sky, "incrementally updated sky light differs from computed")
+			assert.Equal(t, c.light.block, updated.block, "incrementally updated block light differs from computed")
+		})
+	}
+
+	assert.Equal(t, uint8(objects.MaxLight), c.light.sky[lightIndex(3, 2, 3)], "sky must light the bottom of the hole")
+	assert.Equal(t, uint8(15), c.light.block[lightIndex(0, 15, 0)])
+}
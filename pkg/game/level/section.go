@@ -19,6 +19,19 @@ type Section interface {
 
 	// SetBlock - supports values x:[0:15] y:[0:15] z: [0:15]
 	SetBlock(x, y, z int64, block Block) error
+
+	// IsDirty - true if any blocks in the section have changed since it was loaded or last saved.
+	IsDirty() bool
+
+	// Version - counts the block changes in the section, to be taken along with the section state being persisted.
+	Version() uint64
+
+	// MarkSaved - resets the dirty flag, to be called once the section state of the given version is persisted.
+	// Changes made since that version keep the section dirty.
+	MarkSaved(version uint64)
+
+	// Compact - provides the bits-per-block value, the block palette and the palette-compacted block data.
+	Compact() (bpb uint8, palette []objects.BlockID, data []uint64, err error)
 }
 
 func NewSection(blocks BlockArr, index uint8) Section {
@@ -28,36 +41,99 @@ func NewSection(blocks BlockArr, index uint8) Section {
 	}
 }
 
+// NewCompactSection creates new section from the bits-per-block value, the block palette and the palette-compacted
+// block data, i.e. from the same values as provided by Section.Compact().
+func NewCompactSection(index uint8, bpb uint8, palette []objects.BlockID, data []uint64) (Section, error) {
+	tupleSize, useGlobalPalette, err := blockTupleSize(bpb)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != (SectionY*SectionZ*SectionX+int(tupleSize)-1)/int(tupleSize) {
+		return nil, fmt.Errorf("invalid block data length %d for bpb value %d", len(data), bpb)
+	}
+
+	var blocks BlockArr
+	var i int
+	mask := uint64(1)<<bpb - 1
+	for _, long := range data {
+		for j := uint8(0); j < tupleSize && i < SectionY*SectionZ*SectionX; j++ {
+			paletteIndex := (long >> (uint64(bpb) * uint64(tupleSize-1-j))) & mask
+
+			var blockID objects.BlockID
+			if useGlobalPalette {
+				blockID = objects.BlockID(paletteIndex)
+			} else if paletteIndex < uint64(len(palette)) {
+				blockID = palette[paletteIndex]
+			} else {
+				return nil, fmt.Errorf("palette index %d out of palette range %d", paletteIndex, len(palette))
+			}
+
+			blocks[i/(SectionZ*SectionX)][i/SectionX%SectionZ][i%SectionX] = NewBlock(blockID)
+			i++
+		}
+	}
+
+	return NewSection(blocks, index), nil
+}
+
 type section struct {
 	// DEBT will need to store compacted paletted block map and unpack on request to save RAM
-	blocks BlockArr // x,z,y block coordinates
+	blocks BlockArr // y,z,x block coordinates
 	index  uint8
+
+	version      uint64 // incremented on every block change
+	savedVersion uint64
 }
 
 func (s *section) Index() int { return int(s.index) }
 
 func (s *section) GetBlock(x, y, z int64) Block {
-	if x < 0 || x > SectionX-1 || y < 0 || y > SectionX-1 || z < 0 || z > SectionZ-1 {
+	if x < 0 || x > SectionX-1 || y < 0 || y > SectionY-1 || z < 0 || z > SectionZ-1 {
 		return nil
 	}
 
-	return s.blocks[x][z][y]
+	return s.blocks[y][z][x]
 }
 
 func (s *section) SetBlock(x, y, z int64, b Block) error {
 	if x < 0 || x > SectionX-1 || y < 0 || y > SectionY-1 || z < 0 || z > SectionZ-1 {
 		return fmt.Errorf("block coords x,y,z: %d,%d,%d out of range", x, y, z)
 	}
-	s.blocks[x][z][y] = b
+	s.blocks[y][z][x] = b
+	s.version++
 	return nil
 }
 
+func (s *section) IsDirty() bool   { return s.version != s.savedVersion }
+func (s *section) Version() uint64 { return s.version }
+
+func (s *section) MarkSaved(version uint64) {
+	if version > s.savedVersion {
+		s.savedVersion = version
+	}
+}
+
+func (s *section) Compact() (uint8, []objects.BlockID, []uint64, error) {
+	palette := s.makePalette()
+	bpb := bitsPerBlock(len(palette))
+
+	compactData, err := s.makeBlockData(bpb, palette)
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("failed to compact block data: %w", err)
+	}
+
+	return bpb, palette, compactData, nil
+}
+
 func (s *section) Push(writer *buffer.Buffer) {
 	// push count of non-air blocks
 	writer.PushInt16(SectionY * SectionZ * SectionX) // DEBT this does not consider non-air blocks yet
 
-	palette := s.makePalette()
-	bpb := bitsPerBlock(len(palette))
+	bpb, palette, compactData, err := s.Compact()
+	if err != nil {
+		// DEBT update buffer interface to support errors.
+	}
 
 	// push bits-per-block value
 	writer.PushByte(bpb)
@@ -70,34 +146,29 @@ func (s *section) Push(writer *buffer.Buffer) {
 		}
 	}
 
-	compactData, err := s.makeBlockData(bpb, palette)
-	if err != nil {
-		// DEBT update buffer interface to support errors.
-	}
 	writer.PushVarInt(int32(len(compactData)))
 	for _, long := range compactData {
 		writer.PushUint64(long)
 	}
 }
 
+// makePalette lists all distinct block IDs in the section, in the order of their first appearance.
 func (s *section) makePalette() []objects.BlockID {
 	paletteMap := make(map[objects.BlockID]struct{})
 
+	var palette []objects.BlockID
 	for y := 0; y < SectionY; y++ {
 		for z := 0; z < SectionZ; z++ {
 			for x := 0; x < SectionX; x++ {
-				paletteMap[s.blocks[y][z][x].ID()] = struct{}{}
+				blockID := s.blocks[y][z][x].ID()
+				if _, ok := paletteMap[blockID]; !ok {
+					paletteMap[blockID] = struct{}{}
+					palette = append(palette, blockID)
+				}
 			}
 		}
 	}
 
-	palette := make([]objects.BlockID, len(paletteMap), len(paletteMap))
-	var i int
-	for blockId := range paletteMap {
-		palette[i] = blockId
-		i++
-	}
-
 	return palette
 }
 
@@ -128,25 +199,9 @@ func bitsPerBlock(len int) uint8 {
 // 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000          bpb=8, 8 blocks
 // 00000000 00000000000000 00000000000000 00000000000000 00000000000000             bpb=14, 4 blocks
 func (s *section) makeBlockData(bpb uint8, palette []objects.BlockID) ([]uint64, error) {
-	var useGlobalPalette bool
-
-	var tupleSize uint8
-	switch bpb {
-	case 4:
-		tupleSize = 16
-	case 5:
-		tupleSize = 12
-	case 6:
-		tupleSize = 10
-	case 7:
-		tupleSize = 9
-	case 8:
-		tupleSize = 8
-	case 14:
-		tupleSize = 4
-		useGlobalPalette = true
-	default:
-		return nil, fmt.Errorf("bpb value %d not supported", bpb)
+	tupleSize, useGlobalPalette, err := blockTupleSize(bpb)
+	if err != nil {
+		return nil, err
 	}
 
 	var compactData []uint64
@@ -201,3 +256,24 @@ func (s *section) makeBlockData(bpb uint8, palette []objects.BlockID) ([]uint64,
 
 	return compactData, nil
 }
+
+// blockTupleSize provides the number of blocks that fit into a single long for the given bits-per-block value, and
+// whether the global palette is used for that value.
+func blockTupleSize(bpb uint8) (tupleSize uint8, useGlobalPalette bool, err error) {
+	switch bpb {
+	case 4:
+		return 16, false, nil
+	case 5:
+		return 12, false, nil
+	case 6:
+		return 10, false, nil
+	case 7:
+		return 9, false, nil
+	case 8:
+		return 8, false, nil
+	case 14:
+		return 4, true, nil
+	default:
+		return 0, false, fmt.Errorf("bpb value %d not supported", bpb)
+	}
+}
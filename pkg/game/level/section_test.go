@@ -338,3 +338,78 @@ func TestMakeBlockData14(t *testing.T) {
 		}
 	})
 }
+
+func TestNewCompactSection(t *testing.T) {
+	blockSets := map[string][]objects.BlockID{
+		"2_blocks": {objects.BlockAir, objects.BlockDirt},
+		"17_blocks": {
+			objects.BlockAir, objects.BlockDirt, objects.BlockStone, objects.BlockGrass, objects.BlockGranite,
+			objects.BlockGravel, objects.BlockSand, objects.BlockSandstone, objects.BlockIce, objects.BlockBlackWool,
+			objects.BlockWhiteWool, objects.BlockPinkWool, objects.BlockGrayWool, objects.BlockBlueWool,
+			objects.BlockRedWool, objects.BlockGreenWool, objects.BlockBedrock,
+		},
+	}
+
+	for name, blockIDs := range blockSets {
+		t.Run(name, func(t *testing.T) {
+			var blocks BlockArr
+			var i int
+			for y := 0; y < SectionY; y++ {
+				for z := 0; z < SectionZ; z++ {
+					for x := 0; x < SectionX; x++ {
+						blocks[y][z][x] = NewBlock(blockIDs[i%len(blockIDs)])
+						i++
+					}
+				}
+			}
+
+			bpb, palette, data, err := NewSection(blocks, 3).Compact()
+			require.NoError(t, err)
+
+			unpacked, err := NewCompactSection(3, bpb, palette, data)
+			require.NoError(t, err)
+			assert.Equal(t, 3, unpacked.Index())
+			assert.False(t, unpacked.IsDirty())
+
+			for y := 0; y < SectionY; y++ {
+				for z := 0; z < SectionZ; z++ {
+					for x := 0; x < SectionX; x++ {
+						assert.Equal(t, blocks[y][z][x].ID(), unpacked.GetBlock(int64(x), int64(y), int64(z)).ID(),
+							fmt.Sprintf("block mismatch at x.%d y.%d z.%d", x, y, z))
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("invalid_data_length", func(t *testing.T) {
+		_, err := NewCompactSection(0, 4, []objects.BlockID{objects.BlockAir}, make([]uint64, 10))
+		assert.Error(t, err)
+	})
+}
+
+func TestSectionMarkSaved(t *testing.T) {
+	var blocks BlockArr
+	s := NewSection(blocks, 0)
+	assert.False(t, s.IsDirty())
+
+	require.NoError(t, s.SetBlock(1, 2, 3, NewBlock(objects.BlockDirt)))
+	assert.True(t, s.IsDirty())
+	savedVersion := s.Version()
+
+	t.Run("changed_while_saving", func(t *testing.T) {
+		require.NoError(t, s.SetBlock(1, 2, 3, NewBlock(objects.BlockStone)))
+		s.MarkSaved(savedVersion)
+		assert.True(t, s.IsDirty())
+	})
+
+	t.Run("saved", func(t *testing.T) {
+		s.MarkSaved(s.Version())
+		assert.False(t, s.IsDirty())
+	})
+
+	t.Run("stale_save", func(t *testing.T) {
+		s.MarkSaved(savedVersion)
+		assert.False(t, s.IsDirty())
+	})
+}
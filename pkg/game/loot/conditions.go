@@ -0,0 +1,155 @@
+package loot
+
+import (
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// Condition - predicate deciding if the pool, entry or function applies in the given context.
+type Condition interface {
+	Check(ctx *Context) bool
+}
+
+func checkAll(conditions []Condition, ctx *Context) bool {
+	for _, condition := range conditions {
+		if !condition.Check(ctx) {
+			return false
+		}
+	}
+	return true
+}
+
+// SurvivesExplosion - passes with the chance of 1/radius when the block is destroyed by an explosion.
+type SurvivesExplosion struct{}
+
+func (c SurvivesExplosion) Check(ctx *Context) bool {
+	if ctx.ExplosionRadius <= 0 {
+		return true
+	}
+	return ctx.Rand.Float64() <= 1/ctx.ExplosionRadius
+}
+
+// EnchantmentLevel - enchantment required with the level of at least Min.
+type EnchantmentLevel struct {
+	Enchantment string
+	Min         int
+}
+
+// MatchTool - passes if the tool is one of the Items, if any given, and has all the Enchantments.
+type MatchTool struct {
+	Items        []objects.ItemID
+	Enchantments []EnchantmentLevel
+}
+
+func (c MatchTool) Check(ctx *Context) bool {
+	if len(c.Items) > 0 {
+		var found bool
+		for _, item := range c.Items {
+			if item == ctx.Tool {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, enchantment := range c.Enchantments {
+		if ctx.enchantmentLevel(enchantment.Enchantment) < enchantment.Min {
+			return false
+		}
+	}
+	return true
+}
+
+// BlockStateProperty - passes if the block is in one of the States, i.e. the states having the required properties.
+type BlockStateProperty struct {
+	States []objects.BlockID
+}
+
+func (c BlockStateProperty) Check(ctx *Context) bool {
+	return hasState(c.States, ctx.Block)
+}
+
+// LocationCheck - passes if the block at the given offset from the broken block is in one of the States.
+type LocationCheck struct {
+	OffsetX, OffsetY, OffsetZ int64
+	States                    []objects.BlockID
+}
+
+func (c LocationCheck) Check(ctx *Context) bool {
+	if ctx.BlockAt == nil {
+		return false
+	}
+
+	block, ok := ctx.BlockAt(data.PositionI{
+		X: ctx.Position.X + c.OffsetX,
+		Y: ctx.Position.Y + c.OffsetY,
+		Z: ctx.Position.Z + c.OffsetZ,
+	})
+	return ok && hasState(c.States, block)
+}
+
+// TableBonus - passes with the chance from the Chances depending on the tool enchantment level.
+type TableBonus struct {
+	Enchantment string
+	Chances     []float64
+}
+
+func (c TableBonus) Check(ctx *Context) bool {
+	level := ctx.enchantmentLevel(c.Enchantment)
+	if level >= len(c.Chances) {
+		level = len(c.Chances) - 1
+	}
+	return ctx.Rand.Float64() < c.Chances[level]
+}
+
+// RandomChance - passes with the given chance.
+type RandomChance struct {
+	Chance float64
+}
+
+func (c RandomChance) Check(ctx *Context) bool {
+	return ctx.Rand.Float64() < c.Chance
+}
+
+// EntityProperties - passes if the block is broken by an entity.
+// DEBT the entity predicates are not supported, only the presence of the entity is checked.
+type EntityProperties struct{}
+
+func (c EntityProperties) Check(ctx *Context) bool {
+	return ctx.HasEntity
+}
+
+// Alternative - passes if any of the Terms passes.
+type Alternative struct {
+	Terms []Condition
+}
+
+func (c Alternative) Check(ctx *Context) bool {
+	for _, term := range c.Terms {
+		if term.Check(ctx) {
+			return true
+		}
+	}
+	return false
+}
+
+// Inverted - passes if the Term does not.
+type Inverted struct {
+	Term Condition
+}
+
+func (c Inverted) Check(ctx *Context) bool {
+	return !c.Term.Check(ctx)
+}
+
+func hasState(states []objects.BlockID, block objects.BlockID) bool {
+	for _, state := range states {
+		if state == block {
+			return true
+		}
+	}
+	return false
+}
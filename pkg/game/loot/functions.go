@@ -0,0 +1,107 @@
+package loot
+
+import (
+	"math"
+
+	"github.com/alexykot/cncraft/pkg/game/items"
+)
+
+// Function - modifies the dropped item stack.
+// DEBT functions copying block NBT, names and states into the item are not supported as item NBT is not supported,
+//  so the generated tables skip them.
+type Function interface {
+	Apply(stack items.Slot, ctx *Context) items.Slot
+}
+
+func applyAll(functions []Function, stack items.Slot, ctx *Context) items.Slot {
+	for _, function := range functions {
+		stack = function.Apply(stack, ctx)
+	}
+	return stack
+}
+
+// Conditional - applies the Function only if all the Conditions pass.
+type Conditional struct {
+	Conditions []Condition
+	Function   Function
+}
+
+func (f Conditional) Apply(stack items.Slot, ctx *Context) items.Slot {
+	if !checkAll(f.Conditions, ctx) {
+		return stack
+	}
+	return f.Function.Apply(stack, ctx)
+}
+
+// SetCount - sets the stack count to the rolled number.
+type SetCount struct {
+	Count Number
+}
+
+func (f SetCount) Apply(stack items.Slot, ctx *Context) items.Slot {
+	stack.ItemCount = int16(f.Count.Roll(ctx.Rand))
+	return stack
+}
+
+// LimitCount - clamps the stack count between Min and Max.
+type LimitCount struct {
+	Min, Max int16
+}
+
+func (f LimitCount) Apply(stack items.Slot, _ *Context) items.Slot {
+	if stack.ItemCount < f.Min {
+		stack.ItemCount = f.Min
+	}
+	if stack.ItemCount > f.Max {
+		stack.ItemCount = f.Max
+	}
+	return stack
+}
+
+// ExplosionDecay - each item of the stack survives the explosion with the chance of 1/radius.
+type ExplosionDecay struct{}
+
+func (f ExplosionDecay) Apply(stack items.Slot, ctx *Context) items.Slot {
+	if ctx.ExplosionRadius <= 0 {
+		return stack
+	}
+	stack.ItemCount = int16(binomial(ctx.Rand, int(stack.ItemCount), 1/ctx.ExplosionRadius))
+	return stack
+}
+
+type BonusFormula int
+
+const (
+	OreDrops               BonusFormula = iota // multiplies the count by a random number up to the enchantment level + 1
+	UniformBonusCount                          // adds a random number up to the enchantment level * BonusMultiplier
+	BinomialWithBonusCount                     // adds the number of successes out of enchantment level + Extra attempts
+)
+
+// ApplyBonus - increases the stack count depending on the level of the tool Enchantment.
+type ApplyBonus struct {
+	Enchantment     string
+	Formula         BonusFormula
+	BonusMultiplier float64
+	Extra           int
+	Probability     float64
+}
+
+func (f ApplyBonus) Apply(stack items.Slot, ctx *Context) items.Slot {
+	level := ctx.enchantmentLevel(f.Enchantment)
+
+	switch f.Formula {
+	case OreDrops:
+		if level > 0 {
+			bonus := ctx.Rand.Intn(level+2) - 1
+			if bonus < 0 {
+				bonus = 0
+			}
+			stack.ItemCount *= int16(bonus + 1)
+		}
+	case UniformBonusCount:
+		stack.ItemCount += int16(ctx.Rand.Intn(int(math.Round(float64(level)*f.BonusMultiplier)) + 1))
+	case BinomialWithBonusCount:
+		stack.ItemCount += int16(binomial(ctx.Rand, level+f.Extra, f.Probability))
+	}
+	return stack
+}
@@ -0,0 +1,186 @@
+// Package loot implements Notchian loot tables, deciding what drops from the broken blocks. The tables themselves are
+// generated from the Notchian data export by `tools gen loot-tables`.
+package loot
+
+import (
+	"math/rand"
+
+	"github.com/alexykot/cncraft/pkg/game/data"
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// Context - circumstances the loot is generated in, checked by the conditions and used by the functions.
+type Context struct {
+	Rand *rand.Rand
+
+	Block    objects.BlockID
+	Position data.PositionI
+	// BlockAt provides the block at the given position, if the position is available.
+	BlockAt func(blockPos data.PositionI) (objects.BlockID, bool)
+
+	Tool objects.ItemID
+	// Enchantments - levels of the tool enchantments, by enchantment name.
+	// DEBT enchantments are not implemented yet, so this is always empty in the game.
+	Enchantments map[string]int
+
+	ExplosionRadius float64 // zero unless the block is destroyed by an explosion
+	HasEntity       bool    // the block is broken by an entity, e.g. a player
+}
+
+func (c *Context) enchantmentLevel(enchantment string) int {
+	return c.Enchantments[enchantment]
+}
+
+// Table - loot table, every pool of the table drops its own items.
+type Table struct {
+	Pools     []Pool
+	Functions []Function
+}
+
+// Pool - set of entries to choose the drops from, as many times as there are rolls.
+type Pool struct {
+	Rolls      Number
+	Conditions []Condition
+	Entries    []Entry
+	Functions  []Function
+}
+
+type EntryType int
+
+const (
+	ItemEntry         EntryType = iota // drops the item
+	AlternativesEntry                  // expands into the first child entry that passes its conditions
+)
+
+// Entry - item that may drop from the pool, or a group of alternative entries.
+type Entry struct {
+	Type       EntryType
+	Item       objects.ItemID
+	Weight     int // chance of the entry to be chosen relative to the other entries of the pool, 1 if not set
+	Conditions []Condition
+	Functions  []Function
+	Children   []Entry
+}
+
+// BlockDrops provides the items dropped by the block in the given context, nothing for blocks without a loot table.
+func BlockDrops(ctx *Context) []items.Slot {
+	table, ok := blockTables[ctx.Block.String()]
+	if !ok {
+		return nil
+	}
+	return table.Drops(ctx)
+}
+
+// Drops rolls all pools of the table and provides the resulting item stacks.
+func (t *Table) Drops(ctx *Context) []items.Slot {
+	var drops []items.Slot
+	for _, pool := range t.Pools {
+		if !checkAll(pool.Conditions, ctx) {
+			continue
+		}
+
+		rolls := int(pool.Rolls.Roll(ctx.Rand))
+		for i := 0; i < rolls; i++ {
+			entry, ok := pool.choose(ctx)
+			if !ok {
+				continue
+			}
+
+			stack := items.Slot{IsPresent: true, ItemID: entry.Item, ItemCount: 1}
+			stack = applyAll(entry.Functions, stack, ctx)
+			stack = applyAll(pool.Functions, stack, ctx)
+			stack = applyAll(t.Functions, stack, ctx)
+			if stack.ItemCount > 0 {
+				drops = append(drops, stack)
+			}
+		}
+	}
+	return drops
+}
+
+// choose picks one of the pool item entries passing their conditions, at random according to the entry weights.
+func (p *Pool) choose(ctx *Context) (Entry, bool) {
+	var candidates []Entry
+	var totalWeight int
+	for _, entry := range p.Entries {
+		if expanded, ok := entry.expand(ctx); ok {
+			candidates = append(candidates, expanded)
+			totalWeight += expanded.weight()
+		}
+	}
+
+	if len(candidates) == 0 {
+		return Entry{}, false
+	}
+
+	pick := ctx.Rand.Intn(totalWeight)
+	for _, candidate := range candidates {
+		if pick < candidate.weight() {
+			return candidate, true
+		}
+		pick -= candidate.weight()
+	}
+	return Entry{}, false
+}
+
+// expand resolves the entry into the item entry to drop, if the conditions pass.
+func (e Entry) expand(ctx *Context) (Entry, bool) {
+	if !checkAll(e.Conditions, ctx) {
+		return Entry{}, false
+	}
+
+	switch e.Type {
+	case ItemEntry:
+		return e, true
+	case AlternativesEntry:
+		for _, child := range e.Children {
+			if expanded, ok := child.expand(ctx); ok {
+				return expanded, true
+			}
+		}
+	}
+	return Entry{}, false
+}
+
+func (e Entry) weight() int {
+	if e.Weight <= 0 {
+		return 1
+	}
+	return e.Weight
+}
+
+// Number - number provider, rolled every time the number is needed.
+type Number interface {
+	Roll(r *rand.Rand) float64
+}
+
+// Constant - always the same number.
+type Constant float64
+
+func (c Constant) Roll(_ *rand.Rand) float64 { return float64(c) }
+
+// Uniform - number uniformly distributed between Min and Max.
+type Uniform struct {
+	Min, Max float64
+}
+
+func (u Uniform) Roll(r *rand.Rand) float64 { return u.Min + r.Float64()*(u.Max-u.Min) }
+
+// Binomial - number of successes out of N attempts, each with the probability P.
+type Binomial struct {
+	N int
+	P float64
+}
+
+func (b Binomial) Roll(r *rand.Rand) float64 { return float64(binomial(r, b.N, b.P)) }
+
+func binomial(r *rand.Rand, n int, p float64) int {
+	var successes int
+	for i := 0; i < n; i++ {
+		if r.Float64() < p {
+			successes++
+		}
+	}
+	return successes
+}
@@ -0,0 +1,77 @@
+package loot
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestBlockDrops(t *testing.T) {
+	tests := []struct {
+		name  string
+		block objects.BlockID
+		tool  objects.ItemID
+		drops []items.Slot
+	}{
+		{name: "dirt", block: objects.BlockDirt, tool: objects.ItemAir,
+			drops: []items.Slot{{IsPresent: true, ItemID: objects.ItemDirt, ItemCount: 1}}},
+		{name: "stone", block: objects.BlockStone, tool: objects.ItemWoodenPickaxe,
+			drops: []items.Slot{{IsPresent: true, ItemID: objects.ItemCobblestone, ItemCount: 1}}},
+		{name: "grass_block", block: objects.BlockGrassBlock_SnowyFalse, tool: objects.ItemAir,
+			drops: []items.Slot{{IsPresent: true, ItemID: objects.ItemDirt, ItemCount: 1}}},
+		{name: "grass_with_shears", block: objects.BlockGrass, tool: objects.ItemShears,
+			drops: []items.Slot{{IsPresent: true, ItemID: objects.ItemGrass, ItemCount: 1}}},
+		{name: "young_wheat", block: objects.Wheat_Age0, tool: objects.ItemAir,
+			drops: []items.Slot{{IsPresent: true, ItemID: objects.ItemWheatSeeds, ItemCount: 1}}},
+		{name: "glass", block: objects.BlockGlass, tool: objects.ItemAir},
+		{name: "air", block: objects.BlockAir, tool: objects.ItemAir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drops := BlockDrops(&Context{
+				Rand:      rand.New(rand.NewSource(1)),
+				Block:     tt.block,
+				Tool:      tt.tool,
+				HasEntity: true,
+			})
+			assert.Equal(t, tt.drops, drops)
+		})
+	}
+}
+
+func TestBlockDropsRipeWheat(t *testing.T) {
+	drops := BlockDrops(&Context{Rand: rand.New(rand.NewSource(1)), Block: objects.Wheat_Age7, HasEntity: true})
+	require.Len(t, drops, 2)
+
+	assert.Equal(t, objects.ItemWheat, drops[0].ItemID)
+	assert.Equal(t, int16(1), drops[0].ItemCount)
+
+	assert.Equal(t, objects.ItemWheatSeeds, drops[1].ItemID)
+	assert.True(t, drops[1].ItemCount >= 1 && drops[1].ItemCount <= 4, "seeds count %d", drops[1].ItemCount)
+}
+
+func TestBlockDropsExplosion(t *testing.T) {
+	ctx := &Context{Rand: rand.New(rand.NewSource(1)), Block: objects.BlockDirt, ExplosionRadius: 1e9}
+
+	var dropped int
+	for i := 0; i < 100; i++ {
+		dropped += len(BlockDrops(ctx))
+	}
+	assert.Less(t, dropped, 100) // practically nothing survives an explosion this big
+}
+
+func TestApplyBonusOreDrops(t *testing.T) {
+	ctx := &Context{Rand: rand.New(rand.NewSource(1)), Enchantments: map[string]int{"minecraft:fortune": 3}}
+	bonus := ApplyBonus{Enchantment: "minecraft:fortune", Formula: OreDrops}
+
+	for i := 0; i < 100; i++ {
+		stack := bonus.Apply(items.Slot{IsPresent: true, ItemID: objects.ItemDiamond, ItemCount: 1}, ctx)
+		assert.True(t, stack.ItemCount >= 1 && stack.ItemCount <= 4, "diamond count %d", stack.ItemCount)
+	}
+}
@@ -2,6 +2,7 @@ package player
 
 import (
 	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/game"
 	"github.com/alexykot/cncraft/pkg/mask"
 )
 
@@ -17,6 +18,18 @@ type Abilities struct {
 	InstantBuild bool
 }
 
+// NewAbilities provides the abilities the gamemode gives.
+func NewAbilities(mode game.Gamemode) *Abilities {
+	switch mode {
+	case game.Creative:
+		return &Abilities{Invulnerable: true, AllowFlight: true, InstantBuild: true}
+	case game.Spectator:
+		return &Abilities{Invulnerable: true, Flying: true, AllowFlight: true}
+	default:
+		return &Abilities{}
+	}
+}
+
 func (p *Abilities) Push(writer *buffer.Buffer) {
 	flags := byte(0)
 
@@ -0,0 +1,63 @@
+package player
+
+import "strings"
+
+// OpLevel - operator level of the player, as per https://minecraft.fandom.com/wiki/Permission_level
+type OpLevel int8
+
+const (
+	OpLevelNone       OpLevel = iota // regular player
+	OpLevelModerator                 // may bypass the spawn protection
+	OpLevelGamemaster                // may use the cheat commands, e.g. /gamemode and /give
+	OpLevelAdmin                     // may use the multiplayer management commands, e.g. /kick and /op
+	OpLevelOwner                     // may use all the commands
+)
+
+// opLevelStatusBase - entity status telling the client the player has op level 0, statuses of the higher levels follow.
+const opLevelStatusBase = 24
+
+// EntityStatus provides the entity status telling the client the op level of the player. The client permits
+// the debug shortcuts, e.g. F3+F4 gamemode switcher, for the op levels high enough.
+func (l OpLevel) EntityStatus() int8 { return opLevelStatusBase + int8(l) }
+
+func (l OpLevel) IsValid() bool { return l >= OpLevelNone && l <= OpLevelOwner }
+
+// permissionWildcard grants all the permission nodes starting the same as the wildcard node does,
+// e.g. "cncraft.command.*" grants "cncraft.command.teleport", "*" alone grants everything.
+const permissionWildcard = "*"
+
+// Permissions - op level of the player, the roles the player holds and the permission nodes the roles grant,
+// e.g. "cncraft.command.teleport".
+type Permissions struct {
+	OpLevel OpLevel
+	Roles   []string
+	nodes   map[string]struct{}
+}
+
+func NewPermissions(opLevel OpLevel, roles []string, nodes ...string) *Permissions {
+	p := &Permissions{OpLevel: opLevel, Roles: roles, nodes: make(map[string]struct{}, len(nodes))}
+	for _, node := range nodes {
+		p.nodes[node] = struct{}{}
+	}
+	return p
+}
+
+// Has tells if the permission node is granted, either as is or by a wildcard node.
+func (p *Permissions) Has(node string) bool {
+	if p == nil || node == "" {
+		return false
+	}
+	if _, ok := p.nodes[node]; ok {
+		return true
+	}
+	if _, ok := p.nodes[permissionWildcard]; ok {
+		return true
+	}
+
+	for i := strings.LastIndexByte(node, '.'); i > 0; i = strings.LastIndexByte(node[:i], '.') {
+		if _, ok := p.nodes[node[:i+1]+permissionWildcard]; ok {
+			return true
+		}
+	}
+	return false
+}
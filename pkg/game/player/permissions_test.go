@@ -0,0 +1,31 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissions_Has(t *testing.T) {
+	perms := NewPermissions(OpLevelGamemaster, []string{"gamemaster"},
+		"cncraft.command.teleport", "cncraft.world.*")
+
+	assert.True(t, perms.Has("cncraft.command.teleport"))
+	assert.False(t, perms.Has("cncraft.command.teleport.others"), "nodes are not granted by their parents")
+	assert.False(t, perms.Has("cncraft.command.kick"))
+	assert.True(t, perms.Has("cncraft.world.build"))
+	assert.True(t, perms.Has("cncraft.world.build.spawn"))
+	assert.False(t, perms.Has("cncraft.worldedit"))
+	assert.False(t, perms.Has(""))
+
+	assert.True(t, NewPermissions(OpLevelOwner, nil, "*").Has("cncraft.command.kick"))
+
+	var none *Permissions
+	assert.False(t, none.Has("cncraft.command.teleport"))
+}
+
+func TestOpLevel_EntityStatus(t *testing.T) {
+	assert.Equal(t, int8(24), OpLevelNone.EntityStatus())
+	assert.Equal(t, int8(28), OpLevelOwner.EntityStatus())
+	assert.False(t, OpLevel(5).IsValid())
+}
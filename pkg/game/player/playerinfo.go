@@ -2,7 +2,7 @@ package player
 
 import (
 	"github.com/alexykot/cncraft/pkg/buffer"
-	"github.com/alexykot/cncraft/pkg/game/entities"
+	"github.com/alexykot/cncraft/pkg/game"
 )
 
 type PlayerInfoAction int32
@@ -20,33 +20,32 @@ type PlayerInfo interface {
 }
 
 type PlayerInfoAddPlayer struct {
-	Player entities.PlayerCharacter
+	Profile  Profile
+	GameMode game.Gamemode
+	Latency  int32 // ping of the player in milliseconds
 }
 
 func (p *PlayerInfoAddPlayer) Push(writer *buffer.Buffer) {
-	//profile := p.Player.GetProfile()
-	//writer.PushUUID(profile.UUID)
-	//writer.PushString(profile.Name)
-	//
-	//writer.PushVarInt(int32(len(profile.Properties)))
-	//
-	//for _, prop := range profile.Properties {
-	//	writer.PushString(prop.Name)
-	//	writer.PushString(prop.Value)
-	//
-	//	if prop.Signature == nil {
-	//		writer.PushBool(false)
-	//	} else {
-	//		writer.PushBool(true)
-	//		writer.PushString(*prop.Signature)
-	//	}
-	//}
-	//
-	//writer.PushVarInt(int32(p.Player.GetGameMode()))
-	//
-	//writer.PushVarInt(0) // update this to the player's actual ping
-	//
-	//writer.PushBool(false) // update this to be whether the player has a custom display name or not, write that name as json if they do
+	writer.PushUUID(p.Profile.ID)
+	writer.PushString(p.Profile.Name)
+
+	writer.PushVarInt(int32(len(p.Profile.Properties)))
+	for _, prop := range p.Profile.Properties {
+		writer.PushString(prop.Name)
+		writer.PushString(prop.Value)
+
+		if prop.Signature == "" {
+			writer.PushBool(false)
+		} else {
+			writer.PushBool(true)
+			writer.PushString(prop.Signature)
+		}
+	}
+
+	writer.PushVarInt(int32(p.GameMode))
+	writer.PushVarInt(p.Latency)
+
+	writer.PushBool(false) // DEBT custom display names are not supported
 }
 
 type PlayerInfoUpdateLatency struct{}
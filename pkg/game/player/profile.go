@@ -0,0 +1,18 @@
+package player
+
+import "github.com/google/uuid"
+
+// Profile - the account profile of the player, as authenticated by the session server.
+type Profile struct {
+	ID         uuid.UUID
+	Name       string
+	Properties []ProfileProperty
+}
+
+// ProfileProperty - property of the profile, e.g. the skin. Value and signature are base64 encoded as provided
+// by the session server, and passed to the clients as is.
+type ProfileProperty struct {
+	Name      string
+	Value     string
+	Signature string // empty if the property is not signed
+}
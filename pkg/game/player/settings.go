@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/chat"
 	"github.com/alexykot/cncraft/pkg/mask"
 )
 
@@ -33,6 +34,17 @@ const (
 	Hidden
 )
 
+// Shows tells if the messages in the given position are shown in this chat mode, as per https://wiki.vg/Chat#Client_chat_mode
+func (m ChatMode) Shows(position chat.MessagePosition) bool {
+	switch m {
+	case CommandsOnly:
+		return position != chat.NormalChat
+	case Hidden:
+		return position == chat.HotBarText
+	}
+	return true
+}
+
 type SkinParts struct {
 	mask.Masking
 
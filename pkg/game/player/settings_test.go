@@ -0,0 +1,31 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexykot/cncraft/pkg/chat"
+)
+
+func TestChatMode_Shows(t *testing.T) {
+	tests := []struct {
+		mode     ChatMode
+		position chat.MessagePosition
+		expected bool
+	}{
+		{mode: Full, position: chat.NormalChat, expected: true},
+		{mode: Full, position: chat.SystemChat, expected: true},
+		{mode: Full, position: chat.HotBarText, expected: true},
+		{mode: CommandsOnly, position: chat.NormalChat, expected: false},
+		{mode: CommandsOnly, position: chat.SystemChat, expected: true},
+		{mode: CommandsOnly, position: chat.HotBarText, expected: true},
+		{mode: Hidden, position: chat.NormalChat, expected: false},
+		{mode: Hidden, position: chat.SystemChat, expected: false},
+		{mode: Hidden, position: chat.HotBarText, expected: true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, test.mode.Shows(test.position), "mode %d, position %d", test.mode, test.position)
+	}
+}
@@ -0,0 +1,203 @@
+// Package recipes implements Notchian recipes and matching the crafting grid against them. The recipes themselves are
+// generated from the Notchian data export by `tools gen recipes`.
+package recipes
+
+import (
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+// Type - recipe type, named by the protocol identifier of the type.
+// DEBT stonecutting, smithing and the special crafting recipes (e.g. armor dyeing, map cloning) are not supported.
+type Type string
+
+const (
+	CraftingShaped    Type = "minecraft:crafting_shaped"
+	CraftingShapeless Type = "minecraft:crafting_shapeless"
+	Smelting          Type = "minecraft:smelting"
+	Blasting          Type = "minecraft:blasting"
+	Smoking           Type = "minecraft:smoking"
+	CampfireCooking   Type = "minecraft:campfire_cooking"
+)
+
+// IsCrafting tells if the recipe is crafted in a crafting grid.
+func (t Type) IsCrafting() bool { return t == CraftingShaped || t == CraftingShapeless }
+
+// IsCooking tells if the recipe is cooked in a furnace, blast furnace, smoker or on a campfire.
+func (t Type) IsCooking() bool {
+	return t == Smelting || t == Blasting || t == Smoking || t == CampfireCooking
+}
+
+// Ingredient - items any of which satisfies the ingredient. Empty ingredient stands for an empty slot of a shaped
+// recipe.
+type Ingredient []objects.ItemID
+
+// Matches tells if the item satisfies the ingredient.
+func (i Ingredient) Matches(item objects.ItemID) bool {
+	if len(i) == 0 {
+		return item == objects.ItemAir
+	}
+
+	for _, ingredientItem := range i {
+		if ingredientItem == item {
+			return true
+		}
+	}
+	return false
+}
+
+type Recipe struct {
+	ID    string
+	Type  Type
+	Group string // recipes of the same group are shown together in the recipe book
+
+	Width, Height int          // shaped recipes only
+	Ingredients   []Ingredient // shaped recipe ingredients go row by row; cooking recipes have a single ingredient
+
+	Result      objects.ItemID
+	ResultCount int16
+
+	Experience  float32 // cooking recipes only
+	CookingTime int32   // cooking recipes only, in ticks
+}
+
+var recipesByID map[string]*Recipe
+
+func init() {
+	recipesByID = make(map[string]*Recipe, len(recipeList))
+	for _, recipe := range recipeList {
+		recipesByID[recipe.ID] = recipe
+	}
+}
+
+// All provides all known recipes.
+func All() []*Recipe { return recipeList }
+
+// Get provides the recipe by its ID.
+func Get(recipeID string) (*Recipe, bool) {
+	recipe, ok := recipesByID[recipeID]
+	return recipe, ok
+}
+
+// MatchCrafting finds the crafting recipe for the grid of items of the given width, the grid goes row by row.
+// Empty grid slots are expected to hold air.
+func MatchCrafting(grid []objects.ItemID, width int) (*Recipe, bool) {
+	for _, recipe := range recipeList {
+		switch recipe.Type {
+		case CraftingShaped:
+			if recipe.matchesShaped(grid, width) {
+				return recipe, true
+			}
+		case CraftingShapeless:
+			if recipe.matchesShapeless(grid) {
+				return recipe, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// MatchCooking finds the cooking recipe of the given type for the item.
+func MatchCooking(recipeType Type, item objects.ItemID) (*Recipe, bool) {
+	for _, recipe := range recipeList {
+		if recipe.Type == recipeType && recipe.Ingredients[0].Matches(item) {
+			return recipe, true
+		}
+	}
+	return nil, false
+}
+
+// FitsGrid tells if the crafting recipe can be crafted in a grid of the given size.
+func (r *Recipe) FitsGrid(width, height int) bool {
+	switch r.Type {
+	case CraftingShaped:
+		return r.Width <= width && r.Height <= height
+	case CraftingShapeless:
+		return len(r.Ingredients) <= width*height
+	}
+	return false
+}
+
+// matchesShaped checks the pattern against the grid area actually holding items, as is and mirrored horizontally.
+func (r *Recipe) matchesShaped(grid []objects.ItemID, width int) bool {
+	minX, minY, maxX, maxY := width, len(grid), -1, -1
+	for slot, item := range grid {
+		if item == objects.ItemAir {
+			continue
+		}
+
+		x, y := slot%width, slot/width
+		if x < minX {
+			minX = x
+		}
+		if x > maxX {
+			maxX = x
+		}
+		if y < minY {
+			minY = y
+		}
+		if y > maxY {
+			maxY = y
+		}
+	}
+
+	if maxX < 0 || maxX-minX+1 != r.Width || maxY-minY+1 != r.Height {
+		return false
+	}
+
+	for _, isMirrored := range []bool{false, true} {
+		matches := true
+		for y := 0; y < r.Height && matches; y++ {
+			for x := 0; x < r.Width; x++ {
+				patternX := x
+				if isMirrored {
+					patternX = r.Width - 1 - x
+				}
+
+				if !r.Ingredients[y*r.Width+patternX].Matches(grid[(minY+y)*width+minX+x]) {
+					matches = false
+					break
+				}
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesShapeless checks that every ingredient is satisfied by a distinct item of the grid, with nothing left over.
+func (r *Recipe) matchesShapeless(grid []objects.ItemID) bool {
+	var gridItems []objects.ItemID
+	for _, item := range grid {
+		if item != objects.ItemAir {
+			gridItems = append(gridItems, item)
+		}
+	}
+
+	if len(gridItems) != len(r.Ingredients) {
+		return false
+	}
+	return matchIngredients(r.Ingredients, gridItems, make([]bool, len(gridItems)))
+}
+
+// matchIngredients assigns the grid items to the ingredients, backtracking when an ingredient is left unsatisfied.
+func matchIngredients(ingredients []Ingredient, gridItems []objects.ItemID, isUsed []bool) bool {
+	if len(ingredients) == 0 {
+		return true
+	}
+
+	for i, item := range gridItems {
+		if isUsed[i] || !ingredients[0].Matches(item) {
+			continue
+		}
+
+		isUsed[i] = true
+		if matchIngredients(ingredients[1:], gridItems, isUsed) {
+			return true
+		}
+		isUsed[i] = false
+	}
+	return false
+}
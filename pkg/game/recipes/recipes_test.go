@@ -0,0 +1,78 @@
+package recipes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alexykot/cncraft/pkg/protocol/objects"
+)
+
+func TestMatchCrafting(t *testing.T) {
+	const (
+		air    = objects.ItemAir
+		planks = objects.ItemBirchPlanks
+		stick  = objects.ItemStick
+		coal   = objects.ItemCoal
+	)
+
+	tests := []struct {
+		name     string
+		grid     []objects.ItemID
+		width    int
+		recipeID string
+	}{
+		{name: "shapeless", grid: []objects.ItemID{air, air, air, objects.ItemBirchLog}, width: 2, recipeID: "minecraft:birch_planks"},
+		{name: "shaped_2x2", grid: []objects.ItemID{planks, planks, planks, planks}, width: 2, recipeID: "minecraft:crafting_table"},
+		{name: "shaped_offset", grid: []objects.ItemID{air, planks, air, planks}, width: 2, recipeID: "minecraft:stick"},
+		{name: "shaped_3x3", grid: []objects.ItemID{
+			air, coal, air,
+			air, stick, air,
+			air, air, air,
+		}, width: 3, recipeID: "minecraft:torch"},
+		{name: "shaped_mirrored", grid: []objects.ItemID{
+			planks, planks, air,
+			stick, planks, air,
+			stick, air, air,
+		}, width: 3, recipeID: "minecraft:wooden_axe"},
+		{name: "shaped_wrong_layout", grid: []objects.ItemID{planks, air, air, planks}, width: 2},
+		{name: "empty", grid: []objects.ItemID{air, air, air, air}, width: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe, ok := MatchCrafting(tt.grid, tt.width)
+			if tt.recipeID == "" {
+				assert.False(t, ok)
+				return
+			}
+
+			if assert.True(t, ok) {
+				assert.Equal(t, tt.recipeID, recipe.ID)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	recipe, ok := Get("minecraft:iron_ingot_from_blasting")
+	if assert.True(t, ok) {
+		assert.Equal(t, Blasting, recipe.Type)
+		assert.Equal(t, objects.ItemIronIngot, recipe.Result)
+		assert.Equal(t, int32(100), recipe.CookingTime)
+	}
+
+	_, ok = Get("minecraft:armor_dye")
+	assert.False(t, ok)
+}
+
+func TestMatchCooking(t *testing.T) {
+	recipe, ok := MatchCooking(Smelting, objects.ItemIronOre)
+	if assert.True(t, ok) {
+		assert.Equal(t, objects.ItemIronIngot, recipe.Result)
+		assert.Equal(t, int32(200), recipe.CookingTime)
+	}
+
+	_, ok = MatchCooking(Smelting, objects.ItemDiamond)
+	assert.False(t, ok)
+}
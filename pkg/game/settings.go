@@ -16,6 +16,8 @@ type Tick int64
 
 const TickSpeed = time.Millisecond * 50
 
+const TicksPerSecond = 20
+
 func (d Tick) AsTime() time.Time {
 	return time.Unix(0, int64(d))
 }
@@ -56,6 +58,14 @@ const (
 	Spectator
 )
 
+type Weather uint8
+
+const (
+	WeatherClear Weather = iota
+	WeatherRain
+	WeatherThunder
+)
+
 type Coreness bool
 
 const (
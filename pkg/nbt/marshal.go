@@ -5,6 +5,7 @@ import (
 	"io"
 	"math"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -48,6 +49,12 @@ func (e *Encoder) marshal(val reflect.Value, tagType byte, tagName string) error
 func (e *Encoder) writeHeader(val reflect.Value, tagType byte, tagName string) (err error) {
 	if tagType == TagList {
 		eleType := getTagType(val.Type().Elem())
+		if val.Type().Elem().Kind() == reflect.Interface { // e.g. lists decoded without knowing their types
+			eleType = TagEnd
+			if val.Len() > 0 {
+				eleType = getTagType(val.Index(0).Elem().Type())
+			}
+		}
 		err = e.writeListHeader(eleType, tagName, val.Len())
 	} else {
 		err = e.writeTag(tagType, tagName)
@@ -60,7 +67,12 @@ func (e *Encoder) writeValue(val reflect.Value, tagType byte) error {
 	default:
 		return errors.New("unsupported type " + val.Type().Kind().String())
 	case TagByte:
-		_, err := e.w.Write([]byte{byte(val.Uint())})
+		var err error
+		if val.Kind() == reflect.Int8 {
+			_, err = e.w.Write([]byte{byte(val.Int())})
+		} else {
+			_, err = e.w.Write([]byte{byte(val.Uint())})
+		}
 		return err
 	case TagShort:
 		return e.writeInt16(int16(val.Int()))
@@ -100,6 +112,9 @@ func (e *Encoder) writeValue(val reflect.Value, tagType byte) error {
 	case TagList:
 		for i := 0; i < val.Len(); i++ {
 			arrVal := val.Index(i)
+			if arrVal.Kind() == reflect.Interface {
+				arrVal = arrVal.Elem()
+			}
 			err := e.writeValue(arrVal, getTagType(arrVal.Type()))
 			if err != nil {
 				return err
@@ -118,6 +133,10 @@ func (e *Encoder) writeValue(val reflect.Value, tagType byte) error {
 			val = reflect.ValueOf(val.Interface())
 		}
 
+		if val.Kind() == reflect.Map {
+			return e.writeMap(val)
+		}
+
 		n := val.NumField()
 		for i := 0; i < n; i++ {
 			f := val.Type().Field(i)
@@ -138,9 +157,30 @@ func (e *Encoder) writeValue(val reflect.Value, tagType byte) error {
 	return nil
 }
 
+// writeMap writes map with string keys as compound, with tags sorted by name to keep the output stable.
+func (e *Encoder) writeMap(val reflect.Value) error {
+	if val.Type().Key().Kind() != reflect.String {
+		return errors.New("cannot marshal map with key type " + val.Type().Key().String())
+	}
+
+	keys := val.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+	for _, key := range keys {
+		elem := val.MapIndex(key)
+		if elem.Kind() == reflect.Interface {
+			elem = elem.Elem()
+		}
+		if err := e.marshal(elem, getTagType(elem.Type()), key.String()); err != nil {
+			return err
+		}
+	}
+	_, err := e.w.Write([]byte{TagEnd})
+	return err
+}
+
 func getTagType(vk reflect.Type) byte {
 	switch vk.Kind() {
-	case reflect.Uint8:
+	case reflect.Uint8, reflect.Int8:
 		return TagByte
 	case reflect.Int16, reflect.Uint16:
 		return TagShort
@@ -154,7 +194,7 @@ func getTagType(vk reflect.Type) byte {
 		return TagDouble
 	case reflect.String:
 		return TagString
-	case reflect.Struct, reflect.Interface:
+	case reflect.Struct, reflect.Interface, reflect.Map:
 		return TagCompound
 	case reflect.Array, reflect.Slice:
 		switch vk.Elem().Kind() {
@@ -96,6 +96,20 @@ func TestMarshal_InterfaceArray(t *testing.T) {
 				TagEnd,
 			},
 		},
+		{
+			name: "String interface array",
+			args: []interface{}{"a", "b"},
+			want: []byte{
+				TagList, 0x00, 0x00 /*no name*/, TagString, 0, 0, 0, 2,
+				0x00, 0x01, 'a',
+				0x00, 0x01, 'b',
+			},
+		},
+		{
+			name: "Empty interface array",
+			args: []interface{}{},
+			want: []byte{TagList, 0x00, 0x00 /*no name*/, TagEnd, 0, 0, 0, 0},
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,3 +180,25 @@ func TestMarshal_StructArray(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshal_Map(t *testing.T) {
+	v := struct {
+		Y     int8              `nbt:"Y"`
+		Props map[string]string `nbt:"Props"`
+	}{-1, map[string]string{"b": "2", "a": "1"}}
+	out := []byte{TagCompound, 0x00, 0x00,
+		TagByte, 0x00, 0x01, 'Y', 0xff,
+		TagCompound, 0x00, 0x05, 'P', 'r', 'o', 'p', 's',
+		TagString, 0x00, 0x01, 'a', 0x00, 0x01, '1',
+		TagString, 0x00, 0x01, 'b', 0x00, 0x01, '2',
+		TagEnd,
+		TagEnd,
+	}
+
+	var buf bytes.Buffer
+	if err := Marshal(&buf, v); err != nil {
+		t.Error(err)
+	} else if !bytes.Equal(buf.Bytes(), out) {
+		t.Errorf("output binary not right: got % 02x, want % 02x ", buf.Bytes(), out)
+	}
+}
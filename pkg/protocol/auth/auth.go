@@ -18,13 +18,11 @@ type A interface {
 	GetUserVerifyToken(userID uuid.UUID) []byte
 	DecryptUserVerifyToken(userID uuid.UUID, encVerifyToken []byte) (plainTextToken []byte, err error)
 	DecryptUserSharedSecret(userID uuid.UUID, encSharedSecret []byte) (plainTextSecret []byte, err error)
-	RunMojangSessionAuth(userID uuid.UUID, sharedSecret []byte) (*mojang.AuthResponse, error)
+	RunSessionAuth(userID uuid.UUID, sharedSecret []byte) (*mojang.AuthResponse, error)
 	LoginSuccess(userID uuid.UUID)
 	LoginFailure(userID uuid.UUID)
 }
 
-var authRunner auther // the singleton
-
 const verifyTokenLength = 16
 
 // allowed length of the shared secret corresponding to three types of AES encryption
@@ -33,7 +31,9 @@ const secretAES192 = 24
 const secretAES256 = 32
 
 type auther struct {
-	// DEBT With auther being a singleton this is global state. Hmm.
+	serverID string // server ID sent to the clients in CEncryptionRequest, it is a part of the session auth hash
+	sessions *mojang.SessionServer
+
 	stagingUsers map[uuid.UUID]stagingUser
 	mu           sync.Mutex
 }
@@ -44,16 +44,15 @@ type stagingUser struct {
 	verifyToken   []byte
 }
 
-func init() {
-	authRunner = auther{
+// NewAuther creates the auther authenticating the users against the given session server, the Mojang one if empty.
+func NewAuther(serverID, sessionServerURL string) A {
+	return &auther{
+		serverID:     serverID,
+		sessions:     mojang.NewSessionServer(sessionServerURL),
 		stagingUsers: make(map[uuid.UUID]stagingUser),
 	}
 }
 
-func GetAuther() A {
-	return &authRunner
-}
-
 func (a *auther) BootstrapUser(userID uuid.UUID, username string) error {
 	crypter, err := mojang.NewRSACrypter()
 	if err != nil {
@@ -141,15 +140,18 @@ func (a *auther) DecryptUserSharedSecret(userID uuid.UUID, encSharedSecret []byt
 	return sharedSecret, nil
 }
 
-func (a *auther) RunMojangSessionAuth(userID uuid.UUID, sharedSecret []byte) (*mojang.AuthResponse, error) {
+// RunSessionAuth checks with the session server that the user has joined this server, and provides the user profile.
+func (a *auther) RunSessionAuth(userID uuid.UUID, sharedSecret []byte) (*mojang.AuthResponse, error) {
+	a.mu.Lock()
 	user, ok := a.stagingUsers[userID]
+	a.mu.Unlock()
 	if !ok {
 		return nil, fmt.Errorf("stagingUser %s not found", userID.String())
 	}
 
-	auth, err := mojang.RunMojangSessionAuth(user.username, user.secretCrypter.GetPubKey(), sharedSecret)
+	auth, err := a.sessions.HasJoined(user.username, a.serverID, user.secretCrypter.GetPubKey(), sharedSecret)
 	if err != nil {
-		return nil, fmt.Errorf("failed to run Mojang authentication: %w", err)
+		return nil, fmt.Errorf("failed to run session authentication: %w", err)
 	}
 
 	return auth, nil
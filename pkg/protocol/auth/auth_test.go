@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/protocol/auth/mojang"
+	"github.com/alexykot/cncraft/pkg/protocol/auth/mojang/sessiontest"
+)
+
+const testServerID = "CNCRAFTTESTSERVER"
+
+// login does what the client does on login: encrypts the shared secret and the verify token with the public key
+// of the server, and reports joining the server to the session server.
+func login(t *testing.T, sessionURL, accessToken string, profileID uuid.UUID, pubKeyDER, verifyToken []byte) (
+	sharedSecret, encSecret, encToken []byte) {
+	pubKey, err := x509.ParsePKIXPublicKey(pubKeyDER)
+	require.NoError(t, err)
+
+	sharedSecret = make([]byte, secretAES128)
+	_, err = rand.Read(sharedSecret)
+	require.NoError(t, err)
+
+	encSecret, err = rsa.EncryptPKCS1v15(rand.Reader, pubKey.(*rsa.PublicKey), sharedSecret)
+	require.NoError(t, err)
+	encToken, err = rsa.EncryptPKCS1v15(rand.Reader, pubKey.(*rsa.PublicKey), verifyToken)
+	require.NoError(t, err)
+
+	join, err := json.Marshal(map[string]string{
+		"accessToken":     accessToken,
+		"selectedProfile": profileID.String(),
+		"serverId":        mojang.ServerHash(testServerID, sharedSecret, pubKeyDER),
+	})
+	require.NoError(t, err)
+	res, err := http.Post(sessionURL+sessiontest.JoinPath, "application/json", bytes.NewReader(join))
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusNoContent, res.StatusCode)
+
+	return sharedSecret, encSecret, encToken
+}
+
+func TestSessionAuth(t *testing.T) {
+	sessions := sessiontest.NewServer()
+	defer sessions.Close()
+
+	profile := sessiontest.Profile{
+		ID:         uuid.New(),
+		Name:       "Notch",
+		Properties: []mojang.Property{{Name: "textures", Value: "dGV4dHVyZXM=", Signature: "c2lnbmF0dXJl"}},
+	}
+	sessions.AddProfile("token", profile)
+
+	auther := NewAuther(testServerID, sessions.URL)
+	connID := uuid.New()
+	require.NoError(t, auther.BootstrapUser(connID, profile.Name))
+
+	sharedSecret, encSecret, encToken := login(t, sessions.URL, "token", profile.ID,
+		auther.GetUserPubkey(connID), auther.GetUserVerifyToken(connID))
+
+	verifyToken, err := auther.DecryptUserVerifyToken(connID, encToken)
+	require.NoError(t, err)
+	assert.Equal(t, auther.GetUserVerifyToken(connID), verifyToken)
+
+	decrypted, err := auther.DecryptUserSharedSecret(connID, encSecret)
+	require.NoError(t, err)
+	assert.Equal(t, sharedSecret, decrypted)
+
+	res, err := auther.RunSessionAuth(connID, decrypted)
+	require.NoError(t, err)
+	assert.Equal(t, profile.ID, res.ProfileID)
+	assert.Equal(t, profile.Name, res.Username)
+	assert.Equal(t, profile.Properties, res.Properties)
+}
+
+func TestSessionAuthNotJoined(t *testing.T) {
+	sessions := sessiontest.NewServer()
+	defer sessions.Close()
+	profile := sessiontest.Profile{ID: uuid.New(), Name: "Notch"}
+	sessions.AddProfile("token", profile)
+
+	auther := NewAuther(testServerID, sessions.URL)
+	connID := uuid.New()
+	require.NoError(t, auther.BootstrapUser(connID, "Notch"))
+
+	_, err := auther.RunSessionAuth(connID, make([]byte, secretAES128))
+	assert.Error(t, err, "user that has not joined expected to fail the auth")
+
+	sharedSecret, _, _ := login(t, sessions.URL, "token", profile.ID,
+		auther.GetUserPubkey(connID), auther.GetUserVerifyToken(connID))
+	sharedSecret[0] ^= 0xFF
+	_, err = auther.RunSessionAuth(connID, sharedSecret)
+	assert.Error(t, err, "user that has joined with a different shared secret expected to fail the auth")
+}
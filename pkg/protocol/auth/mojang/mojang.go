@@ -2,28 +2,38 @@ package mojang
 
 import (
 	"crypto/sha1"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-const mojangSessionServerURL = "https://sessionserver.mojang.com/session/minecraft/hasJoined"
+// DefaultSessionServerURL - the Mojang session server, used unless some other compatible one is configured.
+const DefaultSessionServerURL = "https://sessionserver.mojang.com"
+
+// HasJoinedPath - path of the endpoint telling if the user has joined the server, relative to the session server URL.
+const HasJoinedPath = "/session/minecraft/hasJoined"
+
+// sessionTimeout - the client gives up on the login after 30 seconds, no point waiting on the session server longer.
+const sessionTimeout = 10 * time.Second
 
 type AuthResponse struct {
-	ProfileID  uuid.UUID // id of the Mojang account profile
-	Username   string    // username as recorded by Mojang
-	Properties []prop    // user properties, currently only skin picture payload
+	ProfileID  uuid.UUID  // id of the Mojang account profile
+	Username   string     // username as recorded by Mojang
+	Properties []Property // user properties, currently only skin picture payload
 }
 
-type prop struct {
-	Name string
-	Data []byte
-	Sign []byte
+// Property - profile property, as provided by the session server. Value and signature are base64 encoded,
+// and passed to the clients as is.
+type Property struct {
+	Name      string
+	Value     string
+	Signature string // empty if the property is not signed
 }
 
 type authJson struct {
@@ -35,53 +45,71 @@ type authJson struct {
 type propJson struct {
 	Name string `json:"name"`
 	Data string `json:"value"`
-	Sign string `json:"signature"`
+	Sign string `json:"signature,omitempty"`
+}
+
+// SessionServer authenticates the users against the Mojang session server, or any other server implementing
+// the same API. See https://wiki.vg/Protocol_Encryption#Server for details.
+type SessionServer struct {
+	url    string
+	client *http.Client
 }
 
-func RunMojangSessionAuth(username string, publicKeyDER []byte, sharedSecret []byte) (*AuthResponse, error) {
-	jsonRes, err := getMojangSessionAuth(username, generateAuthSHAHex(sharedSecret, publicKeyDER))
+func NewSessionServer(sessionServerURL string) *SessionServer {
+	if sessionServerURL == "" {
+		sessionServerURL = DefaultSessionServerURL
+	}
+	return &SessionServer{
+		url:    strings.TrimSuffix(sessionServerURL, "/"),
+		client: &http.Client{Timeout: sessionTimeout},
+	}
+}
+
+// HasJoined checks with the session server that the user has joined this server, and provides the user profile.
+func (s *SessionServer) HasJoined(username, serverID string, publicKeyDER []byte, sharedSecret []byte) (*AuthResponse, error) {
+	jsonRes, err := s.getHasJoined(username, ServerHash(serverID, sharedSecret, publicKeyDER))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get Mojang session auth: %w", err)
+		return nil, fmt.Errorf("failed to get session auth: %w", err)
 	}
 
 	id, err := uuid.Parse(jsonRes.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse Mojang profile ID: %w", err)
+		return nil, fmt.Errorf("failed to parse profile ID: %w", err)
 	}
 
 	auth := AuthResponse{
 		ProfileID:  id,
 		Username:   jsonRes.Name,
-		Properties: make([]prop, 0, len(jsonRes.Prop)),
+		Properties: make([]Property, 0, len(jsonRes.Prop)),
 	}
 
-	for i, jsonProp := range jsonRes.Prop {
-		propData, err := base64.StdEncoding.DecodeString(jsonProp.Data)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse Mojang profile property data b64: %w", err)
-		}
-		propSign, err := base64.StdEncoding.DecodeString(jsonProp.Sign)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse Mojang profile property signature b64: %w", err)
-		}
-
-		auth.Properties[i] = prop{
-			Name: jsonProp.Name,
-			Data: propData,
-			Sign: propSign,
-		}
+	for _, jsonProp := range jsonRes.Prop {
+		auth.Properties = append(auth.Properties, Property{
+			Name:      jsonProp.Name,
+			Value:     jsonProp.Data,
+			Signature: jsonProp.Sign,
+		})
 	}
 
 	return &auth, nil
 }
 
-func getMojangSessionAuth(username, hash string) (*authJson, error) {
-	mojangURL := fmt.Sprintf("%s?username=%s&serverId=%s", mojangSessionServerURL, username, hash)
+func (s *SessionServer) getHasJoined(username, hash string) (*authJson, error) {
+	query := url.Values{"username": {username}, "serverId": {hash}}
 
-	out, err := http.Get(mojangURL)
+	out, err := s.client.Get(s.url + HasJoinedPath + "?" + query.Encode())
 	if err != nil {
 		return nil, fmt.Errorf("failed to call session server: %w", err)
 	}
+	defer out.Body.Close()
+
+	switch out.StatusCode {
+	case http.StatusOK:
+	case http.StatusNoContent: // the user has not joined, either not authenticated with Mojang or the hash is wrong
+		return nil, fmt.Errorf("user %s has not joined this server", username)
+	default:
+		return nil, fmt.Errorf("session server responded with unexpected status %s", out.Status)
+	}
 
 	response, err := ioutil.ReadAll(out.Body)
 	if err != nil {
@@ -96,9 +124,12 @@ func getMojangSessionAuth(username, hash string) (*authJson, error) {
 	return &auth, nil
 }
 
-// generateAuthSHAHex implementes Mojang's custom SHA1 hex encoding. See https://wiki.vg/Protocol_Encryption#Authentication for details.
-func generateAuthSHAHex(sharedSecret, publicKey []byte) string {
+// ServerHash implements Mojang's custom SHA1 hex encoding of the server ID, shared secret and the public key,
+// the client and the server both send it to the session server. See https://wiki.vg/Protocol_Encryption#Authentication
+// for details.
+func ServerHash(serverID string, sharedSecret, publicKey []byte) string {
 	sha := sha1.New()
+	sha.Write([]byte(serverID))
 	sha.Write(sharedSecret)
 	sha.Write(publicKey)
 	hash := sha.Sum(nil)
@@ -0,0 +1,17 @@
+package mojang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerHash(t *testing.T) {
+	// reference hashes from https://wiki.vg/Protocol_Encryption#Sample_Code
+	assert.Equal(t, "4ed1f46bbe04bc756bcb17c0c7ce3e4632f06a48", ServerHash("Notch", nil, nil))
+	assert.Equal(t, "-7c9d5b0044c130109a5d7b5fb5c317c02b4e28c1", ServerHash("jeb_", nil, nil))
+	assert.Equal(t, "88e16a1019277b15d58faf0541e11910eb756f6", ServerHash("simon", nil, nil))
+
+	assert.Equal(t, ServerHash("Notch", nil, nil), ServerHash("", []byte("No"), []byte("tch")),
+		"server ID, shared secret and public key expected to be hashed in sequence")
+}
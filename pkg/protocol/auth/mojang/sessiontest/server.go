@@ -0,0 +1,147 @@
+// Package sessiontest provides a fake session server, implementing the part of the Mojang session server API
+// used on login, both by the clients and by the servers. Intended for the integration tests, see
+// https://wiki.vg/Protocol_Encryption#Authentication for the API details.
+package sessiontest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/protocol/auth/mojang"
+)
+
+// JoinPath - path of the endpoint the clients report joining the server to, relative to the session server URL.
+const JoinPath = "/session/minecraft/join"
+
+// Profile - account profile the fake session server knows about.
+type Profile struct {
+	ID         uuid.UUID
+	Name       string
+	Properties []mojang.Property
+}
+
+// Server - the fake session server. Configure the server under test with the URL of it.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	profiles map[string]Profile // profiles by the access tokens of the accounts
+	joined   map[string]string  // server hashes by the names of the profiles joined
+}
+
+type joinJson struct {
+	AccessToken     string `json:"accessToken"`
+	SelectedProfile string `json:"selectedProfile"`
+	ServerID        string `json:"serverId"`
+}
+
+type profileJson struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Properties []propertyJson `json:"properties"`
+}
+
+type propertyJson struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// NewServer starts the fake session server. The server needs to be closed once no longer needed.
+func NewServer() *Server {
+	s := &Server{
+		profiles: make(map[string]Profile),
+		joined:   make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(JoinPath, s.handleJoin)
+	mux.HandleFunc(mojang.HasJoinedPath, s.handleHasJoined)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// AddProfile registers the account profile, the client holding the access token may join the servers as that profile.
+func (s *Server) AddProfile(accessToken string, profile Profile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.profiles[accessToken] = profile
+}
+
+// Join reports the client with the access token joining the server, same as the client does on login.
+func (s *Server) Join(accessToken, serverHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	profile, ok := s.profiles[accessToken]
+	if !ok {
+		return fmt.Errorf("invalid access token")
+	}
+	s.joined[profile.Name] = serverHash
+	return nil
+}
+
+func (s *Server) handleJoin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var join joinJson
+	if err := json.NewDecoder(r.Body).Decode(&join); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	profile, ok := s.profiles[join.AccessToken]
+	s.mu.Unlock()
+	if !ok || strings.ReplaceAll(profile.ID.String(), "-", "") != strings.ReplaceAll(join.SelectedProfile, "-", "") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	if err := s.Join(join.AccessToken, join.ServerID); err != nil {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleHasJoined(w http.ResponseWriter, r *http.Request) {
+	username, serverHash := r.URL.Query().Get("username"), r.URL.Query().Get("serverId")
+
+	s.mu.Lock()
+	joinedHash, isJoined := s.joined[username]
+	var profile Profile
+	for _, known := range s.profiles {
+		if known.Name == username {
+			profile = known
+		}
+	}
+	s.mu.Unlock()
+
+	if !isJoined || joinedHash != serverHash { // same as Mojang, no content means the user has not joined
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	response := profileJson{
+		ID:         strings.ReplaceAll(profile.ID.String(), "-", ""),
+		Name:       profile.Name,
+		Properties: make([]propertyJson, len(profile.Properties)),
+	}
+	for i, property := range profile.Properties {
+		response.Properties[i] = propertyJson{Name: property.Name, Value: property.Value, Signature: property.Signature}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -8,12 +8,14 @@ import (
 
 	"github.com/alexykot/cncraft/pkg/buffer"
 	"github.com/alexykot/cncraft/pkg/chat"
+	"github.com/alexykot/cncraft/pkg/commands"
 	"github.com/alexykot/cncraft/pkg/game"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/entities"
 	"github.com/alexykot/cncraft/pkg/game/items"
 	"github.com/alexykot/cncraft/pkg/game/level"
 	"github.com/alexykot/cncraft/pkg/game/player"
+	"github.com/alexykot/cncraft/pkg/game/recipes"
 	"github.com/alexykot/cncraft/pkg/nbt"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 	"github.com/alexykot/cncraft/pkg/protocol/plugin"
@@ -116,11 +118,36 @@ func (p *CPacketLoginPluginRequest) Push(writer *buffer.Buffer) {
 }
 
 // PLAY STATE PACKETS
-type CPacketSpawnEntity struct{}
+type CPacketSpawnEntity struct {
+	EntityID   int32
+	ObjectUUID uuid.UUID
+	EntityType int32
+	Position   data.PositionF
+	Rotation   data.RotationF
+	Data       int32
+	Velocity   data.VelocityF
+}
 
 func (p *CPacketSpawnEntity) ProtocolID() ProtocolPacketID { return protocolCSpawnEntity }
-func (p *CPacketSpawnEntity) Type() PacketType             { return CDisconnectPlay }
-func (p *CPacketSpawnEntity) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketSpawnEntity) Type() PacketType             { return CSpawnEntity }
+func (p *CPacketSpawnEntity) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.EntityID)
+	writer.PushUUID(p.ObjectUUID)
+	writer.PushVarInt(p.EntityType)
+
+	writer.PushFloat64(p.Position.X)
+	writer.PushFloat64(p.Position.Y)
+	writer.PushFloat64(p.Position.Z)
+
+	writer.PushByte(angle(p.Rotation.Pitch))
+	writer.PushByte(angle(p.Rotation.Yaw))
+
+	writer.PushInt32(p.Data)
+
+	writer.PushInt16(velocity(p.Velocity.X))
+	writer.PushInt16(velocity(p.Velocity.Y))
+	writer.PushInt16(velocity(p.Velocity.Z))
+}
 
 type CPacketSpawnExperienceOrb struct{}
 
@@ -176,19 +203,35 @@ func (p *CPacketAcknowledgePlayerDigging) Push(writer *buffer.Buffer) {
 	writer.PushBool(p.Successful)
 }
 
-type CPacketBlockBreakAnimation struct{}
+type CPacketBlockBreakAnimation struct {
+	EntityID     int32 // entity ID of the player digging the block
+	Location     data.PositionI
+	DestroyStage int8 // 0 to 9, any other value removes the animation
+}
 
 func (p *CPacketBlockBreakAnimation) ProtocolID() ProtocolPacketID {
 	return protocolCBlockBreakAnimation
 }
-func (p *CPacketBlockBreakAnimation) Type() PacketType           { return CBlockBreakAnimation }
-func (p *CPacketBlockBreakAnimation) Push(writer *buffer.Buffer) { panic("packet not implemented") }
+func (p *CPacketBlockBreakAnimation) Type() PacketType { return CBlockBreakAnimation }
+func (p *CPacketBlockBreakAnimation) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.EntityID)
+	p.Location.Push(writer)
+	writer.PushByte(byte(p.DestroyStage))
+}
 
-type CPacketBlockEntityData struct{}
+type CPacketBlockEntityData struct {
+	Location data.PositionI
+	Action   uint8  // block entity type being updated, as per https://wiki.vg/Protocol#Block_Entity_Data
+	NBT      []byte // block entity NBT compound, as marshalled by level.MarshalClientBlockEntity
+}
 
 func (p *CPacketBlockEntityData) ProtocolID() ProtocolPacketID { return protocolCBlockEntityData }
 func (p *CPacketBlockEntityData) Type() PacketType             { return CBlockEntityData }
-func (p *CPacketBlockEntityData) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketBlockEntityData) Push(writer *buffer.Buffer) {
+	p.Location.Push(writer)
+	writer.PushByte(p.Action)
+	writer.PushBytes(p.NBT, false)
+}
 
 type CPacketBlockAction struct{}
 
@@ -246,17 +289,82 @@ func (p *CPacketChatMessage) Push(writer *buffer.Buffer) {
 	writer.PushUUID(p.Sender)
 }
 
-type CPacketTabComplete struct{}
+type CPacketTabComplete struct {
+	TransactionID int32
+	Start         int32 // position in the text the matches replace from
+	Length        int32
+	Matches       []string
+}
 
 func (p *CPacketTabComplete) ProtocolID() ProtocolPacketID { return protocolCTabComplete }
 func (p *CPacketTabComplete) Type() PacketType             { return CTabComplete }
-func (p *CPacketTabComplete) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketTabComplete) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.TransactionID)
+	writer.PushVarInt(p.Start)
+	writer.PushVarInt(p.Length)
+	writer.PushVarInt(int32(len(p.Matches)))
+	for _, match := range p.Matches {
+		writer.PushString(match)
+		writer.PushBool(false) // no tooltip
+	}
+}
+
+// Command node flags, as per https://wiki.vg/Command_Data
+const (
+	commandNodeExecutable     = 0x04
+	commandNodeHasSuggestions = 0x10
+)
+
+// askServerSuggestions - suggestions type making the client request the suggestions with STabComplete.
+const askServerSuggestions = "minecraft:ask_server"
 
-type CPacketDeclareCommands struct{}
+type CPacketDeclareCommands struct {
+	Root *commands.Node
+}
 
 func (p *CPacketDeclareCommands) ProtocolID() ProtocolPacketID { return protocolCDeclareCommands }
 func (p *CPacketDeclareCommands) Type() PacketType             { return CDeclareCommands }
-func (p *CPacketDeclareCommands) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketDeclareCommands) Push(writer *buffer.Buffer) {
+	// the tree is flattened into the array of nodes, children referred to by their index in the array
+	nodes := []*commands.Node{p.Root}
+	indices := map[*commands.Node]int32{p.Root: 0}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].Children {
+			indices[child] = int32(len(nodes))
+			nodes = append(nodes, child)
+		}
+	}
+
+	writer.PushVarInt(int32(len(nodes)))
+	for _, node := range nodes {
+		flags := byte(node.Type)
+		if node.IsExecutable() {
+			flags |= commandNodeExecutable
+		}
+		if node.Suggester != nil {
+			flags |= commandNodeHasSuggestions
+		}
+		writer.PushByte(flags)
+
+		writer.PushVarInt(int32(len(node.Children)))
+		for _, child := range node.Children {
+			writer.PushVarInt(indices[child])
+		}
+
+		if node.Type == commands.RootNode {
+			continue
+		}
+		writer.PushString(node.Name)
+		if node.Type == commands.ArgumentNode {
+			writer.PushString(node.Parser.ID())
+			node.Parser.PushProperties(writer)
+		}
+		if node.Suggester != nil {
+			writer.PushString(askServerSuggestions)
+		}
+	}
+	writer.PushVarInt(indices[p.Root])
+}
 
 type CPacketWindowConfirmation struct {
 	WindowID items.WindowID
@@ -278,11 +386,15 @@ func (p *CPacketWindowConfirmation) Pull(reader *buffer.Buffer) {
 	p.Accepted = reader.PullBool()
 }
 
-type CPacketCloseWindow struct{}
+type CPacketCloseWindow struct {
+	WindowID items.WindowID
+}
 
 func (p *CPacketCloseWindow) ProtocolID() ProtocolPacketID { return protocolCCloseWindow }
 func (p *CPacketCloseWindow) Type() PacketType             { return CCloseWindow }
-func (p *CPacketCloseWindow) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketCloseWindow) Push(writer *buffer.Buffer) {
+	writer.PushByte(byte(p.WindowID))
+}
 
 type CPacketWindowItems struct {
 	WindowID  items.WindowID
@@ -307,11 +419,19 @@ func (p *CPacketWindowItems) Push(writer *buffer.Buffer) {
 	}
 }
 
-type CPacketWindowProperty struct{}
+type CPacketWindowProperty struct {
+	WindowID items.WindowID
+	Property int16 // meaning depends on the window type, as per https://wiki.vg/Protocol#Window_Property
+	Value    int16
+}
 
 func (p *CPacketWindowProperty) ProtocolID() ProtocolPacketID { return protocolCWindowProperty }
 func (p *CPacketWindowProperty) Type() PacketType             { return CWindowProperty }
-func (p *CPacketWindowProperty) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketWindowProperty) Push(writer *buffer.Buffer) {
+	writer.PushByte(byte(p.WindowID))
+	writer.PushInt16(p.Property)
+	writer.PushInt16(p.Value)
+}
 
 type CPacketSetSlot struct {
 	WindowID items.WindowID
@@ -373,11 +493,18 @@ func (p *CPacketDisconnectPlay) Pull(reader *buffer.Buffer) {
 	p.Reason = chat.New(reader.PullString())
 }
 
-type CPacketEntityStatus struct{}
+// CPacketEntityStatus - entity status, the statuses are entity specific, as per https://wiki.vg/Entity_statuses
+type CPacketEntityStatus struct {
+	EntityID int32
+	Status   int8
+}
 
 func (p *CPacketEntityStatus) ProtocolID() ProtocolPacketID { return protocolCEntityStatus }
 func (p *CPacketEntityStatus) Type() PacketType             { return CEntityStatus }
-func (p *CPacketEntityStatus) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketEntityStatus) Push(writer *buffer.Buffer) {
+	writer.PushInt32(p.EntityID)
+	writer.PushByte(byte(p.Status))
+}
 
 type CPacketExplosion struct{}
 
@@ -385,17 +512,47 @@ func (p *CPacketExplosion) ProtocolID() ProtocolPacketID { return protocolCExplo
 func (p *CPacketExplosion) Type() PacketType             { return CExplosion }
 func (p *CPacketExplosion) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
-type CPacketUnloadChunk struct{}
+type CPacketUnloadChunk struct {
+	ChunkX int32 // chunk coordinates, i.e. block coordinates divided by 16
+	ChunkZ int32
+}
 
 func (p *CPacketUnloadChunk) ProtocolID() ProtocolPacketID { return protocolCUnloadChunk }
 func (p *CPacketUnloadChunk) Type() PacketType             { return CUnloadChunk }
-func (p *CPacketUnloadChunk) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketUnloadChunk) Push(writer *buffer.Buffer) {
+	writer.PushInt32(p.ChunkX)
+	writer.PushInt32(p.ChunkZ)
+}
 
-type CPacketChangeGameState struct{}
+// GameStateReason - game state changed, as per https://wiki.vg/index.php?title=Protocol&oldid=16317#Change_Game_State
+type GameStateReason uint8
+
+const (
+	GameStateNoRespawnBlock GameStateReason = iota
+	GameStateEndRaining
+	GameStateBeginRaining
+	GameStateChangeGamemode // value is the new gamemode
+	GameStateWinGame
+	GameStateDemoEvent
+	GameStateArrowHitPlayer
+	GameStateRainLevel    // value is from 0 to 1
+	GameStateThunderLevel // value is from 0 to 1
+	GameStatePufferfishSting
+	GameStateElderGuardianAppearance
+	GameStateEnableRespawnScreen
+)
+
+type CPacketChangeGameState struct {
+	Reason GameStateReason
+	Value  float32
+}
 
 func (p *CPacketChangeGameState) ProtocolID() ProtocolPacketID { return protocolCChangeGameState }
 func (p *CPacketChangeGameState) Type() PacketType             { return CChangeGameState }
-func (p *CPacketChangeGameState) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketChangeGameState) Push(writer *buffer.Buffer) {
+	writer.PushByte(byte(p.Reason))
+	writer.PushFloat32(p.Value)
+}
 
 type CPacketOpenHorseWindow struct{}
 
@@ -420,6 +577,9 @@ type CPacketChunkData struct {
 func (p *CPacketChunkData) ProtocolID() ProtocolPacketID { return protocolCChunkData }
 func (p *CPacketChunkData) Type() PacketType             { return CChunkData }
 func (p *CPacketChunkData) Push(writer *buffer.Buffer) {
+	p.Chunk.RLock()
+	defer p.Chunk.RUnlock()
+
 	writer.PushInt32(int32(p.Chunk.X() / 16)) // convert block coord into chunk coord
 	writer.PushInt32(int32(p.Chunk.Z() / 16)) // convert block coord into chunk coord
 
@@ -459,12 +619,19 @@ func (p *CPacketChunkData) Push(writer *buffer.Buffer) {
 	}
 	writer.PushBytes(sectionsBuff.Bytes(), true)
 
+	blockEntities := p.Chunk.BlockEntities()
 	blockEntitiesBuff := buffer.New()
-	blockEntitiesBuff.PushVarInt(0) // TODO block entities not implemented yet
-	// if err := nbt.Marshal(blockEntitiesBuff, struct{}{}); err != nil {
-	// 	panic(fmt.Errorf("failed to marshal NBT: %w", err))
-	// }
-	// println(fmt.Sprintf("blockEntities bytes %X", blockEntitiesBuff.Bytes()))
+	blockEntitiesBuff.PushVarInt(int32(len(blockEntities)))
+	for _, entity := range blockEntities {
+		entity.Lock()
+		entityNBT, err := level.MarshalClientBlockEntity(entity)
+		entity.Unlock()
+		// DEBT push packet interface should handle and return marshalling errors
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal NBT: %w", err))
+		}
+		blockEntitiesBuff.PushBytes(entityNBT, false)
+	}
 	writer.PushBytes(blockEntitiesBuff.Bytes(), false)
 }
 
@@ -480,11 +647,55 @@ func (p *CPacketParticle) ProtocolID() ProtocolPacketID { return protocolCPartic
 func (p *CPacketParticle) Type() PacketType             { return CParticle }
 func (p *CPacketParticle) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
-type CPacketUpdateLight struct{}
+// lightSections - number of sections the light is sent for, i.e. all 16 sections of the chunk and one section
+// below and above it each.
+const lightSections = 18
+
+type CPacketUpdateLight struct {
+	Chunk level.Chunk
+}
 
 func (p *CPacketUpdateLight) ProtocolID() ProtocolPacketID { return protocolCUpdateLight }
 func (p *CPacketUpdateLight) Type() PacketType             { return CUpdateLight }
-func (p *CPacketUpdateLight) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketUpdateLight) Push(writer *buffer.Buffer) {
+	p.Chunk.RLock()
+	defer p.Chunk.RUnlock()
+
+	writer.PushVarInt(int32(p.Chunk.X() / 16)) // convert block coord into chunk coord
+	writer.PushVarInt(int32(p.Chunk.Z() / 16)) // convert block coord into chunk coord
+	writer.PushBool(true)                      // trust edges
+
+	var skyMask, blockMask, emptySkyMask, emptyBlockMask int32
+	var skyLight, blockLight [][]byte
+	for i := 0; i < lightSections; i++ {
+		sectionIndex := i - 1 // the first bit is the section below the chunk
+
+		if light := p.Chunk.SkyLight(sectionIndex); light != nil {
+			skyMask |= 1 << i
+			skyLight = append(skyLight, light)
+		} else {
+			emptySkyMask |= 1 << i
+		}
+
+		if light := p.Chunk.BlockLight(sectionIndex); light != nil {
+			blockMask |= 1 << i
+			blockLight = append(blockLight, light)
+		} else {
+			emptyBlockMask |= 1 << i
+		}
+	}
+
+	writer.PushVarInt(skyMask)
+	writer.PushVarInt(blockMask)
+	writer.PushVarInt(emptySkyMask)
+	writer.PushVarInt(emptyBlockMask)
+	for _, light := range skyLight {
+		writer.PushBytes(light, true)
+	}
+	for _, light := range blockLight {
+		writer.PushBytes(light, true)
+	}
+}
 
 type CPacketJoinGame struct {
 	EntityID int32
@@ -591,25 +802,43 @@ func (p *CPacketOpenBook) ProtocolID() ProtocolPacketID { return protocolCOpenBo
 func (p *CPacketOpenBook) Type() PacketType             { return COpenBook }
 func (p *CPacketOpenBook) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
-type CPacketOpenWindow struct{}
+type CPacketOpenWindow struct {
+	WindowID   items.WindowID
+	WindowType items.WindowType
+	Title      *chat.Message
+}
 
 func (p *CPacketOpenWindow) ProtocolID() ProtocolPacketID { return protocolCOpenWindow }
 func (p *CPacketOpenWindow) Type() PacketType             { return COpenWindow }
-func (p *CPacketOpenWindow) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketOpenWindow) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(int32(p.WindowID))
+	writer.PushVarInt(int32(p.WindowType))
+	writer.PushString(p.Title.AsJson())
+}
 
-type CPacketOpenSignEditor struct{}
+type CPacketOpenSignEditor struct {
+	Location data.PositionI
+}
 
 func (p *CPacketOpenSignEditor) ProtocolID() ProtocolPacketID { return protocolCOpenSignEditor }
 func (p *CPacketOpenSignEditor) Type() PacketType             { return COpenSignEditor }
-func (p *CPacketOpenSignEditor) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketOpenSignEditor) Push(writer *buffer.Buffer) {
+	p.Location.Push(writer)
+}
 
-type CPacketCraftRecipeResponse struct{}
+type CPacketCraftRecipeResponse struct {
+	WindowID items.WindowID
+	RecipeID string
+}
 
 func (p *CPacketCraftRecipeResponse) ProtocolID() ProtocolPacketID {
 	return protocolCCraftRecipeResponse
 }
-func (p *CPacketCraftRecipeResponse) Type() PacketType           { return CCraftRecipeResponse }
-func (p *CPacketCraftRecipeResponse) Push(writer *buffer.Buffer) { panic("packet not implemented") }
+func (p *CPacketCraftRecipeResponse) Type() PacketType { return CCraftRecipeResponse }
+func (p *CPacketCraftRecipeResponse) Push(writer *buffer.Buffer) {
+	writer.PushByte(byte(p.WindowID))
+	writer.PushString(p.RecipeID)
+}
 
 type CPacketPlayerAbilities struct {
 	Abilities   player.Abilities
@@ -640,8 +869,6 @@ type CPacketPlayerInfo struct {
 func (p *CPacketPlayerInfo) ProtocolID() ProtocolPacketID { return protocolCPlayerInfo }
 func (p *CPacketPlayerInfo) Type() PacketType             { return CPlayerInfo }
 func (p *CPacketPlayerInfo) Push(writer *buffer.Buffer) {
-	panic("player.PlayerInfo structure may have changed in 1.16.4, need to recheck")
-
 	writer.PushVarInt(int32(p.Action))
 	writer.PushVarInt(int32(len(p.Values)))
 
@@ -660,7 +887,7 @@ type CPacketPlayerPositionAndLook struct {
 	Location data.Location
 	Relative data.Relativity
 
-	TeleportID int32 // no idea what ID is this, the packet type 3/0x36 in the protocol 754 does not have this field
+	TeleportID int32 // the client confirms the teleport with STeleportConfirm carrying the same ID
 }
 
 func (p *CPacketPlayerPositionAndLook) ProtocolID() ProtocolPacketID {
@@ -680,17 +907,66 @@ func (p *CPacketPlayerPositionAndLook) Push(writer *buffer.Buffer) {
 	writer.PushVarInt(p.TeleportID)
 }
 
-type CPacketUnlockRecipes struct{}
+type UnlockRecipesAction int32
+
+const (
+	UnlockRecipesInit   UnlockRecipesAction = 0
+	UnlockRecipesAdd    UnlockRecipesAction = 1
+	UnlockRecipesRemove UnlockRecipesAction = 2
+)
+
+// RecipeBookState - whether the recipe book of the given kind is open, and whether it shows the craftable recipes only.
+type RecipeBookState struct {
+	IsOpen     bool
+	IsFiltered bool
+}
+
+type CPacketUnlockRecipes struct {
+	Action UnlockRecipesAction
+
+	CraftingBook     RecipeBookState
+	SmeltingBook     RecipeBookState
+	BlastFurnaceBook RecipeBookState
+	SmokerBook       RecipeBookState
+
+	RecipeIDs            []string // recipes to unlock or remove, all unlocked recipes on init
+	HighlightedRecipeIDs []string // recipes to highlight as new, on init only
+}
 
 func (p *CPacketUnlockRecipes) ProtocolID() ProtocolPacketID { return protocolCUnlockRecipes }
 func (p *CPacketUnlockRecipes) Type() PacketType             { return CUnlockRecipes }
-func (p *CPacketUnlockRecipes) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketUnlockRecipes) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(int32(p.Action))
+	for _, book := range []RecipeBookState{p.CraftingBook, p.SmeltingBook, p.BlastFurnaceBook, p.SmokerBook} {
+		writer.PushBool(book.IsOpen)
+		writer.PushBool(book.IsFiltered)
+	}
 
-type CPacketDestroyEntities struct{}
+	writer.PushVarInt(int32(len(p.RecipeIDs)))
+	for _, recipeID := range p.RecipeIDs {
+		writer.PushString(recipeID)
+	}
+
+	if p.Action == UnlockRecipesInit {
+		writer.PushVarInt(int32(len(p.HighlightedRecipeIDs)))
+		for _, recipeID := range p.HighlightedRecipeIDs {
+			writer.PushString(recipeID)
+		}
+	}
+}
+
+type CPacketDestroyEntities struct {
+	EntityIDs []int32
+}
 
 func (p *CPacketDestroyEntities) ProtocolID() ProtocolPacketID { return protocolCDestroyEntities }
 func (p *CPacketDestroyEntities) Type() PacketType             { return CDestroyEntities }
-func (p *CPacketDestroyEntities) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketDestroyEntities) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(int32(len(p.EntityIDs)))
+	for _, entityID := range p.EntityIDs {
+		writer.PushVarInt(entityID)
+	}
+}
 
 type CPacketRemoveEntityEffect struct{}
 
@@ -704,11 +980,34 @@ func (p *CPacketResourcePackSend) ProtocolID() ProtocolPacketID { return protoco
 func (p *CPacketResourcePackSend) Type() PacketType             { return CResourcePackSend }
 func (p *CPacketResourcePackSend) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
-type CPacketRespawn struct{}
+type CPacketRespawn struct {
+	Dimension tags.Dimension
+	WorldName string
+
+	HashedSeed int64
+	GameMode   game.Gamemode
+
+	IsDebug      bool
+	IsFlat       bool
+	CopyMetadata bool
+}
 
 func (p *CPacketRespawn) ProtocolID() ProtocolPacketID { return protocolCRespawn }
 func (p *CPacketRespawn) Type() PacketType             { return CRespawn }
-func (p *CPacketRespawn) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketRespawn) Push(writer *buffer.Buffer) {
+	// DEBT push packet interface should handle and return marshalling errors
+	if err := nbt.Marshal(writer, p.Dimension); err != nil {
+		panic(fmt.Errorf("failed to marshal NBT: %w", err))
+	}
+
+	writer.PushString(p.WorldName)
+	writer.PushInt64(p.HashedSeed)
+	writer.PushByte(byte(p.GameMode))
+	writer.PushByte(0xFF) // "Previous Gamemode" field, hardcoded to "-1" which means "none" and ignored.
+	writer.PushBool(p.IsDebug)
+	writer.PushBool(p.IsFlat)
+	writer.PushBool(p.CopyMetadata)
+}
 
 type CPacketEntityHeadLook struct{}
 
@@ -752,11 +1051,17 @@ func (p *CPacketHeldItemChange) Push(writer *buffer.Buffer) {
 	writer.PushByte(p.Slot)
 }
 
-type CPacketUpdateViewPosition struct{}
+type CPacketUpdateViewPosition struct {
+	ChunkX int32 // chunk coordinates, i.e. block coordinates divided by 16
+	ChunkZ int32
+}
 
 func (p *CPacketUpdateViewPosition) ProtocolID() ProtocolPacketID { return protocolCUpdateViewPosition }
 func (p *CPacketUpdateViewPosition) Type() PacketType             { return CUpdateViewPosition }
-func (p *CPacketUpdateViewPosition) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketUpdateViewPosition) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.ChunkX)
+	writer.PushVarInt(p.ChunkZ)
+}
 
 type CPacketUpdateViewDistance struct{}
 
@@ -785,7 +1090,21 @@ func (p *CPacketEntityMetadata) Type() PacketType             { return CEntityMe
 func (p *CPacketEntityMetadata) Push(writer *buffer.Buffer) {
 	writer.PushVarInt(p.Entity.ID())
 
-	// only supporting player metadata for now
+	// only supporting player and item metadata for now
+	if item, ok := p.Entity.(entities.Item); ok {
+		writer.PushByte(7)   // index | item
+		writer.PushVarInt(6) // type | slot
+
+		slot := item.GetSlot()
+		writer.PushBool(slot.IsPresent)
+		if slot.IsPresent {
+			writer.PushVarInt(int32(slot.ItemID))
+			writer.PushByte(byte(slot.ItemCount))
+
+			writer.PushByte(0x00) // TODO item NBT data not implemented
+		}
+	}
+
 	_, ok := p.Entity.(entities.PlayerCharacter)
 	if ok {
 
@@ -864,11 +1183,17 @@ func (p *CPacketUpdateScore) ProtocolID() ProtocolPacketID { return protocolCUpd
 func (p *CPacketUpdateScore) Type() PacketType             { return CUpdateScore }
 func (p *CPacketUpdateScore) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
-type CPacketTimeUpdate struct{}
+type CPacketTimeUpdate struct {
+	WorldAge  int64 // ticks the world has been running for
+	TimeOfDay int64 // ticks since the dawn of the first day, negative stops the daylight cycle on the client
+}
 
 func (p *CPacketTimeUpdate) ProtocolID() ProtocolPacketID { return protocolCTimeUpdate }
 func (p *CPacketTimeUpdate) Type() PacketType             { return CTimeUpdate }
-func (p *CPacketTimeUpdate) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketTimeUpdate) Push(writer *buffer.Buffer) {
+	writer.PushInt64(p.WorldAge)
+	writer.PushInt64(p.TimeOfDay)
+}
 
 type CPacketTitle struct{}
 
@@ -904,23 +1229,57 @@ func (p *CPacketPlayerListHeaderAndFooter) Push(writer *buffer.Buffer) {
 	panic("packet not implemented")
 }
 
-type CPacketNBTQueryResponse struct{}
+type CPacketNBTQueryResponse struct {
+	TransactionID int32
+	NBT           []byte // NBT compound queried, nil if there is none
+}
 
 func (p *CPacketNBTQueryResponse) ProtocolID() ProtocolPacketID { return protocolCNBTQueryResponse }
 func (p *CPacketNBTQueryResponse) Type() PacketType             { return CNBTQueryResponse }
-func (p *CPacketNBTQueryResponse) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketNBTQueryResponse) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.TransactionID)
+	if p.NBT == nil {
+		writer.PushByte(nbt.TagEnd) // the client takes TAG_End for no NBT
+		return
+	}
+	writer.PushBytes(p.NBT, false)
+}
 
-type CPacketCollectItem struct{}
+type CPacketCollectItem struct {
+	CollectedEntityID int32
+	CollectorEntityID int32
+	PickupItemCount   int32
+}
 
 func (p *CPacketCollectItem) ProtocolID() ProtocolPacketID { return protocolCCollectItem }
 func (p *CPacketCollectItem) Type() PacketType             { return CCollectItem }
-func (p *CPacketCollectItem) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketCollectItem) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.CollectedEntityID)
+	writer.PushVarInt(p.CollectorEntityID)
+	writer.PushVarInt(p.PickupItemCount)
+}
 
-type CPacketEntityTeleport struct{}
+type CPacketEntityTeleport struct {
+	EntityID int32
+	Position data.PositionF
+	Rotation data.RotationF
+	OnGround bool
+}
 
 func (p *CPacketEntityTeleport) ProtocolID() ProtocolPacketID { return protocolCEntityTeleport }
 func (p *CPacketEntityTeleport) Type() PacketType             { return CEntityTeleport }
-func (p *CPacketEntityTeleport) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+func (p *CPacketEntityTeleport) Push(writer *buffer.Buffer) {
+	writer.PushVarInt(p.EntityID)
+
+	writer.PushFloat64(p.Position.X)
+	writer.PushFloat64(p.Position.Y)
+	writer.PushFloat64(p.Position.Z)
+
+	writer.PushByte(angle(p.Rotation.Yaw))
+	writer.PushByte(angle(p.Rotation.Pitch))
+
+	writer.PushBool(p.OnGround)
+}
 
 type CPacketAdvancements struct{}
 
@@ -941,15 +1300,57 @@ func (p *CPacketEntityEffect) Type() PacketType             { return CEntityEffe
 func (p *CPacketEntityEffect) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
 
 type CPacketDeclareRecipes struct {
-	// Recipes []*Recipe // this doesn't exist yet ;(
-	RecipeCount int32
+	Recipes []*recipes.Recipe
 }
 
 func (p *CPacketDeclareRecipes) ProtocolID() ProtocolPacketID { return protocolCDeclareRecipes }
 func (p *CPacketDeclareRecipes) Type() PacketType             { return CDeclareRecipes }
 func (p *CPacketDeclareRecipes) Push(writer *buffer.Buffer) {
-	writer.PushVarInt(p.RecipeCount)
-	// when recipes are implemented, instead of holding a recipe count, simply write the size of the slice, Recipe will implement BPush
+	writer.PushVarInt(int32(len(p.Recipes)))
+	for _, recipe := range p.Recipes {
+		writer.PushString(string(recipe.Type))
+		writer.PushString(recipe.ID)
+
+		switch {
+		case recipe.Type == recipes.CraftingShapeless:
+			writer.PushString(recipe.Group)
+			writer.PushVarInt(int32(len(recipe.Ingredients)))
+			for _, ingredient := range recipe.Ingredients {
+				pushIngredient(writer, ingredient)
+			}
+		case recipe.Type == recipes.CraftingShaped:
+			writer.PushVarInt(int32(recipe.Width))
+			writer.PushVarInt(int32(recipe.Height))
+			writer.PushString(recipe.Group)
+			for _, ingredient := range recipe.Ingredients {
+				pushIngredient(writer, ingredient)
+			}
+		case recipe.Type.IsCooking():
+			writer.PushString(recipe.Group)
+			pushIngredient(writer, recipe.Ingredients[0])
+		}
+
+		writer.PushBool(true)
+		writer.PushVarInt(int32(recipe.Result))
+		writer.PushByte(byte(recipe.ResultCount))
+		writer.PushByte(0x00) // TODO item NBT data not implemented
+
+		if recipe.Type.IsCooking() {
+			writer.PushFloat32(recipe.Experience)
+			writer.PushVarInt(recipe.CookingTime)
+		}
+	}
+}
+
+// pushIngredient writes the ingredient as the array of the slots any of which satisfies the ingredient.
+func pushIngredient(writer *buffer.Buffer, ingredient recipes.Ingredient) {
+	writer.PushVarInt(int32(len(ingredient)))
+	for _, item := range ingredient {
+		writer.PushBool(true)
+		writer.PushVarInt(int32(item))
+		writer.PushByte(1)
+		writer.PushByte(0x00) // TODO item NBT data not implemented
+	}
 }
 
 type CPacketTags struct{}
@@ -957,3 +1358,20 @@ type CPacketTags struct{}
 func (p *CPacketTags) ProtocolID() ProtocolPacketID { return protocolCTags }
 func (p *CPacketTags) Type() PacketType             { return CTags }
 func (p *CPacketTags) Push(writer *buffer.Buffer)   { panic("packet not implemented") }
+
+// angle converts rotation degrees into the protocol angle, in steps of 1/256 of a full turn.
+func angle(degrees float32) byte {
+	return byte(int32(degrees*256/360) & 0xFF)
+}
+
+// velocity converts velocity in blocks per tick into the protocol units of 1/8000 of a block per tick,
+// clamped to the protocol limit of 3.9 blocks per tick.
+func velocity(blocksPerTick float64) int16 {
+	const limit = 3.9
+	if blocksPerTick > limit {
+		blocksPerTick = limit
+	} else if blocksPerTick < -limit {
+		blocksPerTick = -limit
+	}
+	return int16(blocksPerTick * 8000)
+}
@@ -0,0 +1,115 @@
+// Package forwarding parses the client details forwarded by the proxies the server runs behind. Two ways of
+// forwarding are supported: the legacy BungeeCord one, where the details are appended to the handshake host, and
+// the modern Velocity one, where the details are sent in the login plugin response, signed with the shared secret.
+// See https://velocitypowered.com/wiki/users/forwarding/ for details.
+package forwarding
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/game/player"
+)
+
+// VelocityChannel - login plugin channel Velocity forwards the client details over.
+const VelocityChannel = "velocity:player_info"
+
+// VelocityVersion - version of the Velocity forwarding supported, the version without the chat signing keys.
+const VelocityVersion = 1
+
+// legacySeparator - separates the host and the forwarded details in the BungeeCord handshake host.
+const legacySeparator = "\x00"
+
+// Info - client details forwarded by the proxy.
+type Info struct {
+	Address net.IP         // address of the client connected to the proxy
+	Profile player.Profile // profile of the client, as authenticated by the proxy
+}
+
+type legacyPropertyJson struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Signature string `json:"signature"`
+}
+
+// ParseLegacy parses the BungeeCord handshake host, i.e. `host\0client IP\0client UUID[\0profile properties JSON]`.
+// The name of the client is not forwarded, it is the name the client logs in with.
+func ParseLegacy(host string) (*Info, error) {
+	parts := strings.Split(host, legacySeparator)
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, fmt.Errorf("handshake host holds no forwarded details")
+	}
+
+	info := &Info{Address: net.ParseIP(parts[1])}
+	if info.Address == nil {
+		return nil, fmt.Errorf("failed to parse forwarded address %s", parts[1])
+	}
+
+	var err error
+	if info.Profile.ID, err = uuid.Parse(parts[2]); err != nil {
+		return nil, fmt.Errorf("failed to parse forwarded UUID: %w", err)
+	}
+
+	if len(parts) == 4 {
+		var properties []legacyPropertyJson
+		if err := json.Unmarshal([]byte(parts[3]), &properties); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal forwarded properties: %w", err)
+		}
+		for _, property := range properties {
+			info.Profile.Properties = append(info.Profile.Properties, player.ProfileProperty{
+				Name:      property.Name,
+				Value:     property.Value,
+				Signature: property.Signature,
+			})
+		}
+	}
+
+	return info, nil
+}
+
+// ParseVelocity verifies the signature of the Velocity login plugin response data with the secret shared with
+// the proxy, and parses the forwarded details out of it.
+func ParseVelocity(secret, data []byte) (*Info, error) {
+	if len(data) <= sha256.Size {
+		return nil, fmt.Errorf("forwarded data is too short")
+	}
+	signature, payload := data[:sha256.Size], data[sha256.Size:]
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return nil, fmt.Errorf("forwarded data signature is invalid")
+	}
+
+	reader := buffer.NewFrom(payload)
+	if version := reader.PullVarInt(); version != VelocityVersion {
+		return nil, fmt.Errorf("unsupported forwarding version %d", version)
+	}
+
+	info := &Info{}
+	address := reader.PullString()
+	if info.Address = net.ParseIP(address); info.Address == nil {
+		return nil, fmt.Errorf("failed to parse forwarded address %s", address)
+	}
+
+	info.Profile.ID = reader.PullUUID()
+	info.Profile.Name = reader.PullString()
+
+	count := reader.PullVarInt()
+	for i := int32(0); i < count; i++ {
+		property := player.ProfileProperty{Name: reader.PullString(), Value: reader.PullString()}
+		if reader.PullBool() {
+			property.Signature = reader.PullString()
+		}
+		info.Profile.Properties = append(info.Profile.Properties, property)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,96 @@
+package forwarding
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alexykot/cncraft/pkg/buffer"
+	"github.com/alexykot/cncraft/pkg/game/player"
+)
+
+// mkVelocity makes the signed login plugin response data, the way Velocity does.
+func mkVelocity(secret []byte, version int32, info *Info) []byte {
+	payload := buffer.New()
+	payload.PushVarInt(version)
+	payload.PushString(info.Address.String())
+	payload.PushUUID(info.Profile.ID)
+	payload.PushString(info.Profile.Name)
+
+	payload.PushVarInt(int32(len(info.Profile.Properties)))
+	for _, property := range info.Profile.Properties {
+		payload.PushString(property.Name)
+		payload.PushString(property.Value)
+		payload.PushBool(property.Signature != "")
+		if property.Signature != "" {
+			payload.PushString(property.Signature)
+		}
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload.Bytes())
+	return append(mac.Sum(nil), payload.Bytes()...)
+}
+
+func TestParseVelocity(t *testing.T) {
+	secret := []byte("velocity secret")
+	info := &Info{
+		Address: net.ParseIP("203.0.113.7").To4(),
+		Profile: player.Profile{
+			ID:   uuid.New(),
+			Name: "Notch",
+			Properties: []player.ProfileProperty{
+				{Name: "textures", Value: "dGV4dHVyZXM=", Signature: "c2lnbmF0dXJl"},
+				{Name: "unsigned", Value: "dmFsdWU="},
+			},
+		},
+	}
+
+	parsed, err := ParseVelocity(secret, mkVelocity(secret, VelocityVersion, info))
+	require.NoError(t, err)
+	assert.True(t, info.Address.Equal(parsed.Address))
+	assert.Equal(t, info.Profile, parsed.Profile)
+
+	_, err = ParseVelocity([]byte("other secret"), mkVelocity(secret, VelocityVersion, info))
+	assert.Error(t, err, "data signed with another secret expected to be rejected")
+
+	tampered := mkVelocity(secret, VelocityVersion, info)
+	tampered[len(tampered)-1] ^= 0xFF
+	_, err = ParseVelocity(secret, tampered)
+	assert.Error(t, err, "tampered data expected to be rejected")
+
+	_, err = ParseVelocity(secret, mkVelocity(secret, 2, info))
+	assert.Error(t, err, "unsupported version expected to be rejected")
+
+	_, err = ParseVelocity(secret, nil)
+	assert.Error(t, err)
+}
+
+func TestParseLegacy(t *testing.T) {
+	id := uuid.New()
+	undashed := id.String()[:8] + id.String()[9:13] + id.String()[14:18] + id.String()[19:23] + id.String()[24:]
+
+	info, err := ParseLegacy("mc.example.com\x00203.0.113.7\x00" + undashed)
+	require.NoError(t, err)
+	assert.Equal(t, "203.0.113.7", info.Address.String())
+	assert.Equal(t, id, info.Profile.ID)
+	assert.Empty(t, info.Profile.Properties)
+
+	info, err = ParseLegacy("mc.example.com\x00203.0.113.7\x00" + undashed +
+		"\x00" + `[{"name":"textures","value":"dGV4dHVyZXM=","signature":"c2lnbmF0dXJl"}]`)
+	require.NoError(t, err)
+	assert.Equal(t, []player.ProfileProperty{{Name: "textures", Value: "dGV4dHVyZXM=", Signature: "c2lnbmF0dXJl"}},
+		info.Profile.Properties)
+
+	_, err = ParseLegacy("mc.example.com")
+	assert.Error(t, err, "host without forwarded details expected to be rejected")
+	_, err = ParseLegacy("mc.example.com\x00not an IP\x00" + undashed)
+	assert.Error(t, err)
+	_, err = ParseLegacy("mc.example.com\x00203.0.113.7\x00not a UUID")
+	assert.Error(t, err)
+}
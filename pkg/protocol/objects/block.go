@@ -1,6 +1,11 @@
 package objects
 
-import "time"
+import (
+	"math"
+	"time"
+
+	"github.com/alexykot/cncraft/pkg/game"
+)
 
 type BlockID uint32
 
@@ -8,34 +13,72 @@ func (b BlockID) String() string { return blockNamesMap[b] }
 func (b BlockID) ID() uint32     { return uint32(b) }
 
 // TODO Need to setup automated code generation from Notchian data export and provide here detailed data about every block.
-//  This will need to consider the tool used for digging.
+//  Until then the dig data is maintained by hand in block_dig.go.
 func (b BlockID) IsDiggable(tool ItemID) bool {
-	switch tool {
-	case ItemWoodenPickaxe, ItemStonePickaxe, ItemIronPickaxe, ItemDiamondPickaxe, ItemGoldenPickaxe:
-		fallthrough
-	case ItemWoodenShovel, ItemStoneShovel, ItemIronShovel, ItemDiamondShovel, ItemGoldenShovel:
-		fallthrough
-	case ItemWoodenAxe, ItemStoneAxe, ItemIronAxe, ItemDiamondAxe, ItemGoldenAxe:
-		fallthrough
-	case ItemWoodenHoe, ItemStoneHoe, ItemIronHoe, ItemDiamondHoe, ItemGoldenHoe:
+	return b.digData().hardness >= 0
+}
+
+// CanHarvest tells if the block drops anything when dug with the given tool.
+func (b BlockID) CanHarvest(tool ItemID) bool {
+	dig := b.digData()
+	if dig.tier == NoTier {
 		return true
 	}
+	return tool.ToolKind() == dig.tool && tool.ToolTier() >= dig.tier
+}
+
+// DigTicks provides the number of ticks it takes to dig the block with the given tool, zero for instantly dug blocks.
+// DEBT this does not account for enchantments, potion effects, and the player being in water or in the air.
+func (b BlockID) DigTicks(tool ItemID) int64 {
+	dig := b.digData()
+	if dig.hardness <= 0 {
+		return 0
+	}
+
+	speed := 1.0
+	if dig.tool != NotATool && tool.ToolKind() == dig.tool {
+		speed = tool.ToolSpeed()
+	}
 
-	return false
+	// damage dealt to the block every tick, the block breaks once the damage adds up to 1
+	damage := speed / dig.hardness
+	if b.CanHarvest(tool) {
+		damage /= 30
+	} else {
+		damage /= 100
+	}
+	if damage >= 1 {
+		return 0
+	}
+	return int64(math.Ceil(1 / damage))
 }
 
-// TODO This will need to be exported as well, and will need to account for the actual tool used.
 func (b BlockID) DigTime(tool ItemID) time.Duration {
-	switch tool {
-	case ItemWoodenPickaxe, ItemStonePickaxe, ItemIronPickaxe, ItemDiamondPickaxe, ItemGoldenPickaxe:
-		fallthrough
-	case ItemWoodenShovel, ItemStoneShovel, ItemIronShovel, ItemDiamondShovel, ItemGoldenShovel:
-		fallthrough
-	case ItemWoodenAxe, ItemStoneAxe, ItemIronAxe, ItemDiamondAxe, ItemGoldenAxe:
-		fallthrough
-	case ItemWoodenHoe, ItemStoneHoe, ItemIronHoe, ItemDiamondHoe, ItemGoldenHoe:
-		return time.Second * 1
-	}
+	return time.Duration(b.DigTicks(tool)) * game.TickSpeed
+}
+
+// replaceableBlocks - blocks that get replaced when a block is placed into them.
+var replaceableBlocks = map[string]struct{}{
+	"minecraft:air":            {},
+	"minecraft:cave_air":       {},
+	"minecraft:void_air":       {},
+	"minecraft:water":          {},
+	"minecraft:lava":           {},
+	"minecraft:grass":          {},
+	"minecraft:fern":           {},
+	"minecraft:dead_bush":      {},
+	"minecraft:tall_grass":     {},
+	"minecraft:large_fern":     {},
+	"minecraft:seagrass":       {},
+	"minecraft:snow":           {}, // DEBT only single layer snow is replaceable
+	"minecraft:vine":           {},
+	"minecraft:fire":           {},
+	"minecraft:soul_fire":      {},
+	"minecraft:structure_void": {},
+}
 
-	return time.Second * 86400
+// IsReplaceable tells if placing a block into the position of this block replaces it.
+func (b BlockID) IsReplaceable() bool {
+	_, ok := replaceableBlocks[b.String()]
+	return ok
 }
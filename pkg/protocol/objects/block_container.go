@@ -0,0 +1,103 @@
+package objects
+
+// Facing - horizontal direction the front of the block faces, in the order the block states go by.
+type Facing uint8
+
+const (
+	FacingNorth Facing = 0 // -Z
+	FacingSouth Facing = 1 // +Z
+	FacingWest  Facing = 2 // -X
+	FacingEast  Facing = 3 // +X
+)
+
+// Clockwise provides the direction turned clockwise, as seen from above.
+func (f Facing) Clockwise() Facing {
+	switch f {
+	case FacingNorth:
+		return FacingEast
+	case FacingEast:
+		return FacingSouth
+	case FacingSouth:
+		return FacingWest
+	default:
+		return FacingNorth
+	}
+}
+
+// CounterClockwise provides the direction turned counter-clockwise, as seen from above.
+func (f Facing) CounterClockwise() Facing {
+	return f.Clockwise().Clockwise().Clockwise()
+}
+
+// Offset provides the X and Z block coordinate offsets of the neighbour block in this direction.
+func (f Facing) Offset() (x, z int64) {
+	switch f {
+	case FacingNorth:
+		return 0, -1
+	case FacingSouth:
+		return 0, 1
+	case FacingWest:
+		return -1, 0
+	default:
+		return 1, 0
+	}
+}
+
+// ChestType - chest half, double chests are made of the left and the right halves as seen facing the chest front.
+type ChestType uint8
+
+const (
+	ChestSingle ChestType = 0
+	ChestLeft   ChestType = 1
+	ChestRight  ChestType = 2
+)
+
+// Chest states go by facing, then by chest type, then by waterlogged true and false.
+const chestFirstState = BlockChest_FacingNorthTypeSingleWaterloggedTrue
+
+// Furnace states go by facing, then by lit true and false.
+const furnaceFirstState = BlockFurnace_FacingNorthLitTrue
+
+func (b BlockID) IsChest() bool         { return b.String() == "minecraft:chest" }
+func (b BlockID) IsFurnace() bool       { return b.String() == "minecraft:furnace" }
+func (b BlockID) IsCraftingTable() bool { return b == BlockCraftingTable }
+
+// ChestState provides the facing and the type of the chest block.
+func (b BlockID) ChestState() (Facing, ChestType) {
+	offset := b - chestFirstState
+	return Facing(offset / 6), ChestType(offset % 6 / 2)
+}
+
+// WithChestType provides the same chest block state with the chest type changed.
+func (b BlockID) WithChestType(chestType ChestType) BlockID {
+	offset := b - chestFirstState
+	return chestFirstState + offset/6*6 + BlockID(chestType)*2 + offset%2
+}
+
+// ChestPartner provides the direction of the other half of the double chest. Single chests have no partner.
+func (b BlockID) ChestPartner() (Facing, bool) {
+	facing, chestType := b.ChestState()
+	switch chestType {
+	case ChestLeft:
+		return facing.Clockwise(), true
+	case ChestRight:
+		return facing.CounterClockwise(), true
+	default:
+		return facing, false
+	}
+}
+
+// FurnaceState provides the facing of the furnace block and if it is lit.
+func (b BlockID) FurnaceState() (Facing, bool) {
+	offset := b - furnaceFirstState
+	return Facing(offset / 2), offset%2 == 0
+}
+
+// WithFurnaceLit provides the same furnace block state lit or unlit.
+func (b BlockID) WithFurnaceLit(isLit bool) BlockID {
+	facing, _ := b.FurnaceState()
+	if isLit {
+		return furnaceFirstState + BlockID(facing)*2
+	}
+	return furnaceFirstState + BlockID(facing)*2 + 1
+}
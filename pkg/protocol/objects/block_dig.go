@@ -0,0 +1,284 @@
+package objects
+
+import "strings"
+
+// blockDig - details of digging the block.
+type blockDig struct {
+	hardness float64  // time it takes to dig the block, negative for blocks that cannot be dug at all
+	tool     ToolKind // kind of the tool that digs the block faster
+	tier     ToolTier // tier of the tool needed for the block to drop anything, NoTier if anything can harvest it
+}
+
+var (
+	unbreakable = blockDig{hardness: -1}
+	instant     = blockDig{hardness: 0}
+	defaultDig  = blockDig{hardness: 1}
+)
+
+// TODO Dig data below is maintained by hand for the most common blocks, blocks not listed here take default time
+//  to dig. It should be generated from the Notchian data export, see the TODO on IsDiggable.
+
+func (b BlockID) digData() blockDig {
+	name := strings.TrimPrefix(b.String(), "minecraft:")
+	if name == "" {
+		return unbreakable
+	}
+	if dig, ok := findDig(name); ok {
+		return dig
+	}
+	if strings.HasPrefix(name, "potted_") {
+		return instant
+	}
+
+	// slabs, stairs and walls are dug the same as the full blocks they are made of
+	for _, suffix := range []string{"_slab", "_stairs", "_wall"} {
+		base := strings.TrimSuffix(name, suffix)
+		if base == name {
+			continue
+		}
+		for _, fullName := range []string{base, base + "s", base + "_block", base + "_planks"} {
+			if dig, ok := findDig(fullName); ok {
+				return dig
+			}
+		}
+	}
+
+	return defaultDig
+}
+
+func findDig(name string) (blockDig, bool) {
+	if dig, ok := blockDigs[name]; ok {
+		return dig, true
+	}
+	for suffix, dig := range blockFamilyDigs {
+		if strings.HasSuffix(name, suffix) {
+			return dig, true
+		}
+	}
+	return blockDig{}, false
+}
+
+// blockDigs - dig details of individual blocks, by block name.
+var blockDigs = map[string]blockDig{
+	"air":                     unbreakable,
+	"cave_air":                unbreakable,
+	"void_air":                unbreakable,
+	"water":                   unbreakable,
+	"lava":                    unbreakable,
+	"bubble_column":           unbreakable,
+	"bedrock":                 unbreakable,
+	"barrier":                 unbreakable,
+	"command_block":           unbreakable,
+	"chain_command_block":     unbreakable,
+	"repeating_command_block": unbreakable,
+	"structure_block":         unbreakable,
+	"structure_void":          unbreakable,
+	"jigsaw":                  unbreakable,
+	"moving_piston":           unbreakable,
+	"nether_portal":           unbreakable,
+	"end_portal":              unbreakable,
+	"end_portal_frame":        unbreakable,
+	"end_gateway":             unbreakable,
+
+	"grass":                 instant,
+	"tall_grass":            instant,
+	"fern":                  instant,
+	"large_fern":            instant,
+	"dead_bush":             instant,
+	"seagrass":              instant,
+	"tall_seagrass":         instant,
+	"kelp":                  instant,
+	"kelp_plant":            instant,
+	"sea_pickle":            instant,
+	"lily_pad":              instant,
+	"sugar_cane":            instant,
+	"nether_sprouts":        instant,
+	"nether_wart":           instant,
+	"wheat":                 instant,
+	"carrots":               instant,
+	"potatoes":              instant,
+	"beetroots":             instant,
+	"melon_stem":            instant,
+	"pumpkin_stem":          instant,
+	"attached_melon_stem":   instant,
+	"attached_pumpkin_stem": instant,
+	"sweet_berry_bush":      instant,
+	"dandelion":             instant,
+	"poppy":                 instant,
+	"blue_orchid":           instant,
+	"allium":                instant,
+	"azure_bluet":           instant,
+	"oxeye_daisy":           instant,
+	"cornflower":            instant,
+	"lily_of_the_valley":    instant,
+	"wither_rose":           instant,
+	"sunflower":             instant,
+	"lilac":                 instant,
+	"rose_bush":             instant,
+	"peony":                 instant,
+	"torch":                 instant,
+	"fire":                  instant,
+	"soul_fire":             instant,
+	"redstone_wire":         instant,
+	"repeater":              instant,
+	"comparator":            instant,
+	"tripwire":              instant,
+	"tripwire_hook":         instant,
+	"flower_pot":            instant,
+	"scaffolding":           instant,
+	"slime_block":           instant,
+	"honey_block":           instant,
+	"tnt":                   instant,
+
+	"dirt":                 {hardness: 0.5, tool: Shovel},
+	"coarse_dirt":          {hardness: 0.5, tool: Shovel},
+	"podzol":               {hardness: 0.5, tool: Shovel},
+	"grass_block":          {hardness: 0.6, tool: Shovel},
+	"mycelium":             {hardness: 0.6, tool: Shovel},
+	"farmland":             {hardness: 0.6, tool: Shovel},
+	"grass_path":           {hardness: 0.65, tool: Shovel},
+	"sand":                 {hardness: 0.5, tool: Shovel},
+	"red_sand":             {hardness: 0.5, tool: Shovel},
+	"gravel":               {hardness: 0.6, tool: Shovel},
+	"clay":                 {hardness: 0.6, tool: Shovel},
+	"soul_sand":            {hardness: 0.5, tool: Shovel},
+	"soul_soil":            {hardness: 0.5, tool: Shovel},
+	"snow":                 {hardness: 0.1, tool: Shovel, tier: WoodTier},
+	"snow_block":           {hardness: 0.2, tool: Shovel, tier: WoodTier},
+	"crimson_nylium":       {hardness: 0.4, tool: Pickaxe, tier: WoodTier},
+	"warped_nylium":        {hardness: 0.4, tool: Pickaxe, tier: WoodTier},
+	"netherrack":           {hardness: 0.4, tool: Pickaxe, tier: WoodTier},
+	"stone":                {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"smooth_stone":         {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"cobblestone":          {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"mossy_cobblestone":    {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"granite":              {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"polished_granite":     {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"diorite":              {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"polished_diorite":     {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"andesite":             {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"polished_andesite":    {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"blackstone":           {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"basalt":               {hardness: 1.25, tool: Pickaxe, tier: WoodTier},
+	"polished_basalt":      {hardness: 1.25, tool: Pickaxe, tier: WoodTier},
+	"sandstone":            {hardness: 0.8, tool: Pickaxe, tier: WoodTier},
+	"red_sandstone":        {hardness: 0.8, tool: Pickaxe, tier: WoodTier},
+	"bricks":               {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"nether_bricks":        {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"nether_brick_fence":   {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"end_stone":            {hardness: 3, tool: Pickaxe, tier: WoodTier},
+	"end_stone_bricks":     {hardness: 3, tool: Pickaxe, tier: WoodTier},
+	"prismarine":           {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"purpur_block":         {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"quartz_block":         {hardness: 0.8, tool: Pickaxe, tier: WoodTier},
+	"bone_block":           {hardness: 2, tool: Pickaxe, tier: WoodTier},
+	"magma_block":          {hardness: 0.5, tool: Pickaxe, tier: WoodTier},
+	"terracotta":           {hardness: 1.25, tool: Pickaxe, tier: WoodTier},
+	"ice":                  {hardness: 0.5, tool: Pickaxe},
+	"packed_ice":           {hardness: 0.5, tool: Pickaxe},
+	"blue_ice":             {hardness: 2.8, tool: Pickaxe},
+	"coal_ore":             {hardness: 3, tool: Pickaxe, tier: WoodTier},
+	"nether_quartz_ore":    {hardness: 3, tool: Pickaxe, tier: WoodTier},
+	"nether_gold_ore":      {hardness: 3, tool: Pickaxe, tier: WoodTier},
+	"iron_ore":             {hardness: 3, tool: Pickaxe, tier: StoneTier},
+	"lapis_ore":            {hardness: 3, tool: Pickaxe, tier: StoneTier},
+	"gold_ore":             {hardness: 3, tool: Pickaxe, tier: IronTier},
+	"redstone_ore":         {hardness: 3, tool: Pickaxe, tier: IronTier},
+	"diamond_ore":          {hardness: 3, tool: Pickaxe, tier: IronTier},
+	"emerald_ore":          {hardness: 3, tool: Pickaxe, tier: IronTier},
+	"ancient_debris":       {hardness: 30, tool: Pickaxe, tier: DiamondTier},
+	"obsidian":             {hardness: 50, tool: Pickaxe, tier: DiamondTier},
+	"crying_obsidian":      {hardness: 50, tool: Pickaxe, tier: DiamondTier},
+	"netherite_block":      {hardness: 50, tool: Pickaxe, tier: DiamondTier},
+	"coal_block":           {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"redstone_block":       {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"iron_block":           {hardness: 5, tool: Pickaxe, tier: StoneTier},
+	"lapis_block":          {hardness: 3, tool: Pickaxe, tier: StoneTier},
+	"gold_block":           {hardness: 3, tool: Pickaxe, tier: IronTier},
+	"diamond_block":        {hardness: 5, tool: Pickaxe, tier: IronTier},
+	"emerald_block":        {hardness: 5, tool: Pickaxe, tier: IronTier},
+	"furnace":              {hardness: 3.5, tool: Pickaxe, tier: WoodTier},
+	"blast_furnace":        {hardness: 3.5, tool: Pickaxe, tier: WoodTier},
+	"smoker":               {hardness: 3.5, tool: Pickaxe, tier: WoodTier},
+	"dispenser":            {hardness: 3.5, tool: Pickaxe, tier: WoodTier},
+	"dropper":              {hardness: 3.5, tool: Pickaxe, tier: WoodTier},
+	"anvil":                {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"chipped_anvil":        {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"damaged_anvil":        {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"iron_door":            {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"iron_trapdoor":        {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"iron_bars":            {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"spawner":              {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"stone_pressure_plate": {hardness: 0.5, tool: Pickaxe, tier: WoodTier},
+	"enchanting_table":     {hardness: 5, tool: Pickaxe, tier: WoodTier},
+	"ender_chest":          {hardness: 22.5, tool: Pickaxe, tier: WoodTier},
+	"glowstone":            {hardness: 0.3},
+	"sea_lantern":          {hardness: 0.3},
+	"glass":                {hardness: 0.3},
+	"glass_pane":           {hardness: 0.3},
+	"cactus":               {hardness: 0.4},
+	"cake":                 {hardness: 0.5},
+	"cobweb":               {hardness: 4, tool: Sword, tier: WoodTier},
+	"vine":                 {hardness: 0.2, tool: Axe},
+	"ladder":               {hardness: 0.4, tool: Axe},
+	"lever":                {hardness: 0.5},
+	"chest":                {hardness: 2.5, tool: Axe},
+	"trapped_chest":        {hardness: 2.5, tool: Axe},
+	"barrel":               {hardness: 2.5, tool: Axe},
+	"crafting_table":       {hardness: 2.5, tool: Axe},
+	"bookshelf":            {hardness: 1.5, tool: Axe},
+	"pumpkin":              {hardness: 1, tool: Axe},
+	"carved_pumpkin":       {hardness: 1, tool: Axe},
+	"jack_o_lantern":       {hardness: 1, tool: Axe},
+	"melon":                {hardness: 1, tool: Axe},
+	"hay_block":            {hardness: 0.5, tool: Hoe},
+	"sponge":               {hardness: 0.6, tool: Hoe},
+	"wet_sponge":           {hardness: 0.6, tool: Hoe},
+	"shroomlight":          {hardness: 1, tool: Hoe},
+	"nether_wart_block":    {hardness: 1, tool: Hoe},
+	"warped_wart_block":    {hardness: 1, tool: Hoe},
+}
+
+// blockFamilyDigs - dig details of the families of blocks, by block name suffix.
+var blockFamilyDigs = map[string]blockDig{
+	"_torch":           instant,
+	"_sapling":         instant,
+	"_tulip":           instant,
+	"_mushroom":        instant,
+	"_fungus":          instant,
+	"_roots":           instant,
+	"_coral":           instant,
+	"_coral_fan":       instant,
+	"_wall_fan":        instant,
+	"_vines":           instant,
+	"_vines_plant":     instant,
+	"_carpet":          {hardness: 0.1},
+	"_bed":             {hardness: 0.2},
+	"_wool":            {hardness: 0.8, tool: Shears},
+	"_banner":          {hardness: 1},
+	"_head":            {hardness: 1},
+	"_skull":           {hardness: 1},
+	"_glass":           {hardness: 0.3},
+	"_glass_pane":      {hardness: 0.3},
+	"_leaves":          {hardness: 0.2, tool: Hoe},
+	"_planks":          {hardness: 2, tool: Axe},
+	"_log":             {hardness: 2, tool: Axe},
+	"_wood":            {hardness: 2, tool: Axe},
+	"_stem":            {hardness: 2, tool: Axe},
+	"_hyphae":          {hardness: 2, tool: Axe},
+	"_fence":           {hardness: 2, tool: Axe},
+	"_fence_gate":      {hardness: 2, tool: Axe},
+	"_door":            {hardness: 3, tool: Axe},
+	"_trapdoor":        {hardness: 3, tool: Axe},
+	"_sign":            {hardness: 1, tool: Axe},
+	"_button":          {hardness: 0.5},
+	"_pressure_plate":  {hardness: 0.5, tool: Axe},
+	"_rail":            {hardness: 0.7, tool: Pickaxe},
+	"_concrete_powder": {hardness: 0.5, tool: Shovel},
+	"_concrete":        {hardness: 1.8, tool: Pickaxe, tier: WoodTier},
+	"_terracotta":      {hardness: 1.25, tool: Pickaxe, tier: WoodTier},
+	"_coral_block":     {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"_shulker_box":     {hardness: 2, tool: Pickaxe},
+	"_bricks":          {hardness: 1.5, tool: Pickaxe, tier: WoodTier},
+	"_ore":             {hardness: 3, tool: Pickaxe, tier: WoodTier},
+}
@@ -0,0 +1,217 @@
+package objects
+
+import "strings"
+
+// MaxLight - the highest light level, i.e. the light of the open sky and of the brightest light sources.
+const MaxLight = 15
+
+// TODO Light data below is maintained by hand for the most common blocks. It should be generated from
+//  the Notchian data export together with the rest of the block data, see the TODO on IsDiggable.
+
+// LightEmission provides the light level emitted by the block.
+func (b BlockID) LightEmission() uint8 {
+	switch b {
+	case BlockRedstoneOre_LitTrue:
+		return 9
+	case BlockRedstoneLamp_LitTrue:
+		return 15
+	case BlockRedstoneTorch_LitTrue,
+		BlockRedstoneWallTorch_FacingNorthLitTrue, BlockRedstoneWallTorch_LitTrueFacingSouth,
+		BlockRedstoneWallTorch_FacingWestLitTrue, BlockRedstoneWallTorch_LitTrueFacingEast:
+		return 7
+	case BlockFurnace_FacingNorthLitTrue, BlockFurnace_FacingSouthLitTrue,
+		BlockFurnace_LitTrueFacingWest, BlockFurnace_FacingEastLitTrue,
+		BlockBlastFurnace_FacingNorthLitTrue, BlockBlastFurnace_FacingSouthLitTrue,
+		BlockBlastFurnace_FacingWestLitTrue, BlockBlastFurnace_FacingEastLitTrue,
+		BlockSmoker_FacingNorthLitTrue, BlockSmoker_FacingSouthLitTrue,
+		BlockSmoker_LitTrueFacingWest, BlockSmoker_FacingEastLitTrue:
+		return 13
+	}
+
+	return lightEmissions[b.String()]
+}
+
+// LightOpacity provides the number of light levels the block takes from the light passing through it.
+// Opaque blocks take all of it and stop the light.
+func (b BlockID) LightOpacity() uint8 {
+	name := b.String()
+	if name == "" || transparentBlocks[name] {
+		return 0
+	}
+	if diffusingBlocks[name] || strings.HasSuffix(name, "_leaves") {
+		return 1
+	}
+	if strings.HasPrefix(name, "minecraft:potted_") {
+		return 0
+	}
+	for _, suffix := range transparentSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return 0
+		}
+	}
+	return MaxLight
+}
+
+// lightEmissions - light emitted by blocks that emit light in all of their states. Campfires are assumed lit.
+var lightEmissions = map[string]uint8{
+	"minecraft:beacon":           15,
+	"minecraft:campfire":         15,
+	"minecraft:conduit":          15,
+	"minecraft:end_gateway":      15,
+	"minecraft:end_portal":       15,
+	"minecraft:fire":             15,
+	"minecraft:glowstone":        15,
+	"minecraft:jack_o_lantern":   15,
+	"minecraft:lantern":          15,
+	"minecraft:lava":             15,
+	"minecraft:sea_lantern":      15,
+	"minecraft:shroomlight":      15,
+	"minecraft:end_rod":          14,
+	"minecraft:torch":            14,
+	"minecraft:wall_torch":       14,
+	"minecraft:nether_portal":    11,
+	"minecraft:crying_obsidian":  10,
+	"minecraft:soul_campfire":    10,
+	"minecraft:soul_fire":        10,
+	"minecraft:soul_lantern":     10,
+	"minecraft:soul_torch":       10,
+	"minecraft:soul_wall_torch":  10,
+	"minecraft:enchanting_table": 7,
+	"minecraft:ender_chest":      7,
+	"minecraft:magma_block":      3,
+	"minecraft:brewing_stand":    1,
+	"minecraft:brown_mushroom":   1,
+	"minecraft:dragon_egg":       1,
+	"minecraft:end_portal_frame": 1,
+}
+
+// diffusingBlocks - blocks that let the light through, but dim it by one level.
+var diffusingBlocks = map[string]bool{
+	"minecraft:bubble_column": true,
+	"minecraft:cobweb":        true,
+	"minecraft:frosted_ice":   true,
+	"minecraft:honey_block":   true,
+	"minecraft:ice":           true,
+	"minecraft:kelp":          true,
+	"minecraft:kelp_plant":    true,
+	"minecraft:seagrass":      true,
+	"minecraft:slime_block":   true,
+	"minecraft:tall_seagrass": true,
+	"minecraft:water":         true,
+}
+
+// transparentBlocks - blocks that let all of the light through.
+var transparentBlocks = map[string]bool{
+	"minecraft:air":                   true,
+	"minecraft:attached_melon_stem":   true,
+	"minecraft:attached_pumpkin_stem": true,
+	"minecraft:bamboo":                true,
+	"minecraft:barrier":               true,
+	"minecraft:beacon":                true,
+	"minecraft:beetroots":             true,
+	"minecraft:bell":                  true,
+	"minecraft:brewing_stand":         true,
+	"minecraft:cactus":                true,
+	"minecraft:cake":                  true,
+	"minecraft:campfire":              true,
+	"minecraft:carrots":               true,
+	"minecraft:cave_air":              true,
+	"minecraft:chain":                 true,
+	"minecraft:chest":                 true,
+	"minecraft:chorus_flower":         true,
+	"minecraft:chorus_plant":          true,
+	"minecraft:cocoa":                 true,
+	"minecraft:comparator":            true,
+	"minecraft:conduit":               true,
+	"minecraft:dead_bush":             true,
+	"minecraft:end_gateway":           true,
+	"minecraft:end_portal":            true,
+	"minecraft:end_rod":               true,
+	"minecraft:ender_chest":           true,
+	"minecraft:fern":                  true,
+	"minecraft:fire":                  true,
+	"minecraft:flower_pot":            true,
+	"minecraft:glass":                 true,
+	"minecraft:glass_pane":            true,
+	"minecraft:grass":                 true,
+	"minecraft:hopper":                true,
+	"minecraft:iron_bars":             true,
+	"minecraft:ladder":                true,
+	"minecraft:lantern":               true,
+	"minecraft:large_fern":            true,
+	"minecraft:lever":                 true,
+	"minecraft:lily_pad":              true,
+	"minecraft:melon_stem":            true,
+	"minecraft:nether_portal":         true,
+	"minecraft:nether_sprouts":        true,
+	"minecraft:nether_wart":           true,
+	"minecraft:potatoes":              true,
+	"minecraft:pumpkin_stem":          true,
+	"minecraft:rail":                  true,
+	"minecraft:redstone_wire":         true,
+	"minecraft:repeater":              true,
+	"minecraft:scaffolding":           true,
+	"minecraft:sea_pickle":            true,
+	"minecraft:snow":                  true,
+	"minecraft:soul_campfire":         true,
+	"minecraft:soul_fire":             true,
+	"minecraft:soul_lantern":          true,
+	"minecraft:structure_void":        true,
+	"minecraft:sugar_cane":            true,
+	"minecraft:sweet_berry_bush":      true,
+	"minecraft:tall_grass":            true,
+	"minecraft:torch":                 true,
+	"minecraft:trapped_chest":         true,
+	"minecraft:tripwire":              true,
+	"minecraft:tripwire_hook":         true,
+	"minecraft:turtle_egg":            true,
+	"minecraft:vine":                  true,
+	"minecraft:void_air":              true,
+	"minecraft:wheat":                 true,
+
+	// flowers
+	"minecraft:allium":             true,
+	"minecraft:azure_bluet":        true,
+	"minecraft:blue_orchid":        true,
+	"minecraft:cornflower":         true,
+	"minecraft:dandelion":          true,
+	"minecraft:lilac":              true,
+	"minecraft:lily_of_the_valley": true,
+	"minecraft:oxeye_daisy":        true,
+	"minecraft:peony":              true,
+	"minecraft:poppy":              true,
+	"minecraft:rose_bush":          true,
+	"minecraft:sunflower":          true,
+	"minecraft:wither_rose":        true,
+}
+
+// transparentSuffixes - name suffixes of the families of non-full blocks that let all of the light through.
+var transparentSuffixes = []string{
+	"_banner",
+	"_bed",
+	"_button",
+	"_carpet",
+	"_coral",
+	"_coral_fan",
+	"_door",
+	"_fence",
+	"_fence_gate",
+	"_fungus",
+	"_glass",
+	"_glass_pane",
+	"_head",
+	"_mushroom",
+	"_pressure_plate",
+	"_rail",
+	"_roots",
+	"_sapling",
+	"_sign",
+	"_skull",
+	"_torch",
+	"_trapdoor",
+	"_tulip",
+	"_vines",
+	"_vines_plant",
+	"_wall",
+	"_wall_fan",
+}
@@ -0,0 +1,93 @@
+package objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigTicks(t *testing.T) {
+	tests := []struct {
+		name  string
+		block BlockID
+		tool  ItemID
+		ticks int64
+	}{
+		{name: "dirt_by_hand", block: BlockDirt, tool: ItemAir, ticks: 15},
+		{name: "dirt_with_shovel", block: BlockDirt, tool: ItemWoodenShovel, ticks: 8},
+		{name: "stone_by_hand", block: BlockStone, tool: ItemAir, ticks: 150},
+		{name: "stone_with_pickaxe", block: BlockStone, tool: ItemWoodenPickaxe, ticks: 23},
+		{name: "stone_with_axe", block: BlockStone, tool: ItemDiamondAxe, ticks: 150},
+		{name: "obsidian_with_diamond_pickaxe", block: BlockObsidian, tool: ItemDiamondPickaxe, ticks: 188},
+		{name: "torch", block: BlockTorch, tool: ItemAir, ticks: 0},
+		{name: "stone_slab_as_stone", block: BlockStoneSlab_TypeBottomWaterloggedFalse, tool: ItemWoodenPickaxe, ticks: 23},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.ticks, tt.block.DigTicks(tt.tool))
+		})
+	}
+}
+
+func TestCanHarvest(t *testing.T) {
+	assert.True(t, BlockDirt.CanHarvest(ItemAir))
+	assert.False(t, BlockStone.CanHarvest(ItemAir))
+	assert.True(t, BlockStone.CanHarvest(ItemWoodenPickaxe))
+	assert.False(t, BlockDiamondOre.CanHarvest(ItemStonePickaxe))
+	assert.True(t, BlockDiamondOre.CanHarvest(ItemIronPickaxe))
+	assert.False(t, BlockBedrock.IsDiggable(ItemNetheritePickaxe))
+	assert.False(t, BlockAir.IsDiggable(ItemAir))
+}
+
+func TestItemBlockState(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     ItemID
+		block    BlockID
+		isPlaced bool
+	}{
+		{name: "dirt", item: ItemDirt, block: BlockDirt, isPlaced: true},
+		{name: "bedrock", item: ItemBedrock, block: BlockBedrock, isPlaced: true},
+		{name: "stone_slab", item: ItemStoneSlab, block: BlockStoneSlab_TypeBottomWaterloggedFalse, isPlaced: true},
+		{name: "seeds", item: ItemWheatSeeds, block: Wheat_Age0, isPlaced: true},
+		{name: "air", item: ItemAir, block: BlockAir, isPlaced: false},
+		{name: "pickaxe", item: ItemDiamondPickaxe, block: BlockAir, isPlaced: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, ok := tt.item.BlockState()
+			assert.Equal(t, tt.isPlaced, ok)
+			if ok {
+				assert.Equal(t, tt.block, block)
+			}
+		})
+	}
+}
+
+func TestChestState(t *testing.T) {
+	facing, chestType := BlockChest_FacingWestTypeLeftWaterloggedFalse.ChestState()
+	assert.Equal(t, FacingWest, facing)
+	assert.Equal(t, ChestLeft, chestType)
+
+	assert.Equal(t, BlockChest_FacingWestTypeRightWaterloggedFalse,
+		BlockChest_FacingWestTypeLeftWaterloggedFalse.WithChestType(ChestRight))
+	assert.Equal(t, BlockChest_FacingEastTypeSingleWaterloggedTrue,
+		BlockChest_FacingEastTypeRightWaterloggedTrue.WithChestType(ChestSingle))
+
+	partner, ok := BlockChest_FacingNorthTypeLeftWaterloggedFalse.ChestPartner()
+	assert.True(t, ok)
+	assert.Equal(t, FacingEast, partner)
+	_, ok = BlockChest_FacingNorthTypeSingleWaterloggedFalse.ChestPartner()
+	assert.False(t, ok)
+}
+
+func TestFurnaceState(t *testing.T) {
+	facing, isLit := BlockFurnace_FacingEastLitFalse.FurnaceState()
+	assert.Equal(t, FacingEast, facing)
+	assert.False(t, isLit)
+
+	assert.Equal(t, BlockFurnace_LitTrueFacingWest, BlockFurnace_LitFalseFacingWest.WithFurnaceLit(true))
+	assert.Equal(t, BlockFurnace_FacingSouthLitFalse, BlockFurnace_FacingSouthLitTrue.WithFurnaceLit(false))
+}
@@ -0,0 +1,32 @@
+package objects
+
+// itemBlocks - blocks placed by the items not named after the block they place.
+var itemBlocks = map[ItemID]string{
+	ItemString:        "minecraft:tripwire",
+	ItemRedstone:      "minecraft:redstone_wire",
+	ItemWheatSeeds:    "minecraft:wheat",
+	ItemPumpkinSeeds:  "minecraft:pumpkin_stem",
+	ItemMelonSeeds:    "minecraft:melon_stem",
+	ItemBeetrootSeeds: "minecraft:beetroots",
+	ItemCarrot:        "minecraft:carrots",
+	ItemPotato:        "minecraft:potatoes",
+	ItemCocoaBeans:    "minecraft:cocoa",
+	ItemSweetBerries:  "minecraft:sweet_berry_bush",
+}
+
+// BlockState provides the block state placed by the item, if the item can be placed as a block.
+// DEBT only the default block state is provided, not accounting for the face clicked, player facing, waterlogging etc.
+//  E.g. torches placed on a wall should become wall torches.
+func (i ItemID) BlockState() (BlockID, bool) {
+	if i == ItemAir {
+		return BlockAir, false
+	}
+
+	name, ok := itemBlocks[i]
+	if !ok {
+		name = i.String()
+	}
+
+	block, ok := blockDefaultStates[name]
+	return block, ok
+}
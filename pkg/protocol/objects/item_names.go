@@ -0,0 +1,20 @@
+package objects
+
+import "sync"
+
+var itemsByName map[string]ItemID
+var itemsByNameOnce sync.Once
+
+// ItemByName provides the item by its Notchian name, e.g. "minecraft:dirt".
+func ItemByName(name string) (ItemID, bool) {
+	// built lazily, item names are only known once the init of the generated items file has run
+	itemsByNameOnce.Do(func() {
+		itemsByName = make(map[string]ItemID, len(itemNamesMap))
+		for itemID, itemName := range itemNamesMap {
+			itemsByName[itemName] = itemID
+		}
+	})
+
+	itemID, ok := itemsByName[name]
+	return itemID, ok
+}
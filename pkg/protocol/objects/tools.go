@@ -0,0 +1,76 @@
+package objects
+
+// ToolKind - kind of the tool, blocks are dug faster with the tool of their preferred kind.
+type ToolKind uint8
+
+const (
+	NotATool ToolKind = iota
+	Sword
+	Shovel
+	Pickaxe
+	Axe
+	Hoe
+	Shears
+)
+
+// ToolTier - material tier of the tool, some blocks only drop anything when dug with the tool of high enough tier.
+type ToolTier uint8
+
+const (
+	NoTier ToolTier = iota
+	WoodTier
+	StoneTier
+	IronTier
+	DiamondTier
+	NetheriteTier
+)
+
+// Tool items go in the groups of five per material, i.e. sword, shovel, pickaxe, axe and hoe,
+// from ItemWoodenSword to ItemNetheriteHoe.
+const toolsPerMaterial = 5
+
+// toolMaterials - tiers and dig speeds of the tool materials, in the order of their item IDs.
+var toolMaterials = []struct {
+	tier  ToolTier
+	speed float64
+}{
+	{tier: WoodTier, speed: 2},      // wooden
+	{tier: StoneTier, speed: 4},     // stone
+	{tier: WoodTier, speed: 12},     // golden
+	{tier: IronTier, speed: 6},      // iron
+	{tier: DiamondTier, speed: 8},   // diamond
+	{tier: NetheriteTier, speed: 9}, // netherite
+}
+
+// ToolKind provides the kind of the tool, or NotATool for items that are not tools.
+func (i ItemID) ToolKind() ToolKind {
+	if i == ItemShears {
+		return Shears
+	}
+	if i < ItemWoodenSword || i > ItemNetheriteHoe {
+		return NotATool
+	}
+	return Sword + ToolKind((i-ItemWoodenSword)%toolsPerMaterial)
+}
+
+// ToolTier provides the material tier of the tool, or NoTier for items that are not tools or have no material.
+func (i ItemID) ToolTier() ToolTier {
+	if i < ItemWoodenSword || i > ItemNetheriteHoe {
+		return NoTier
+	}
+	return toolMaterials[(i-ItemWoodenSword)/toolsPerMaterial].tier
+}
+
+// ToolSpeed provides the dig speed multiplier of the tool, applied when digging the blocks the tool is preferred for.
+func (i ItemID) ToolSpeed() float64 {
+	if i == ItemShears {
+		return 2 // DEBT shears are much faster on leaves, wool and cobwebs
+	}
+	if i < ItemWoodenSword || i > ItemNetheriteHoe {
+		return 1
+	}
+	if i.ToolKind() == Sword {
+		return 1.5
+	}
+	return toolMaterials[(i-ItemWoodenSword)/toolsPerMaterial].speed
+}
@@ -71,6 +71,7 @@ func createSPacketsMap() map[PacketType]func() SPacket {
 		STeleportConfirm: func() SPacket { return &SPacketTeleportConfirm{} },
 		SQueryBlockNBT:   func() SPacket { return &SPacketQueryBlockNBT{} },
 		SChatMessage:     func() SPacket { return &SPacketChatMessage{} },
+		STabComplete:     func() SPacket { return &SPacketTabComplete{} },
 
 		SHeldItemChange:     func() SPacket { return &SPacketHeldItemChange{} },
 		SEntityAction:       func() SPacket { return &SPacketEntityAction{} },
@@ -84,6 +85,11 @@ func createSPacketsMap() map[PacketType]func() SPacket {
 		SPlayerPosition:       func() SPacket { return &SPacketPlayerPosition{} },
 		SPlayerPosAndRotation: func() SPacket { return &SPacketPlayerPosAndRotation{} },
 		SPlayerRotation:       func() SPacket { return &SPacketPlayerRotation{} },
+		SPlayerBlockPlacement: func() SPacket { return &SPacketPlayerBlockPlacement{} },
+		SUseItem:              func() SPacket { return &SPacketUseItem{} },
+		SUpdateSign:           func() SPacket { return &SPacketUpdateSign{} },
+
+		SCraftRecipeRequest: func() SPacket { return &SPacketCraftRecipeRequest{} },
 	}
 }
 
@@ -103,6 +109,8 @@ func createCPacketsMap() map[PacketType]func() CPacket {
 		// Play state packets
 		CDisconnectPlay:        func() CPacket { return &CPacketDisconnectPlay{} },
 		CChatMessage:           func() CPacket { return &CPacketChatMessage{} },
+		CTabComplete:           func() CPacket { return &CPacketTabComplete{} },
+		CDeclareCommands:       func() CPacket { return &CPacketDeclareCommands{} },
 		CJoinGame:              func() CPacket { return &CPacketJoinGame{} },
 		CPluginMessage:         func() CPacket { return &CPacketPluginMessage{} },
 		CPlayerPositionAndLook: func() CPacket { return &CPacketPlayerPositionAndLook{} },
@@ -111,14 +119,35 @@ func createCPacketsMap() map[PacketType]func() CPacket {
 		CPlayerAbilities:       func() CPacket { return &CPacketPlayerAbilities{} },
 		CHeldItemChange:        func() CPacket { return &CPacketHeldItemChange{} },
 		CDeclareRecipes:        func() CPacket { return &CPacketDeclareRecipes{} },
+		CUnlockRecipes:         func() CPacket { return &CPacketUnlockRecipes{} },
+		CCraftRecipeResponse:   func() CPacket { return &CPacketCraftRecipeResponse{} },
 		CChunkData:             func() CPacket { return &CPacketChunkData{} },
+		CUpdateLight:           func() CPacket { return &CPacketUpdateLight{} },
+		CUnloadChunk:           func() CPacket { return &CPacketUnloadChunk{} },
+		CUpdateViewPosition:    func() CPacket { return &CPacketUpdateViewPosition{} },
 		CPlayerInfo:            func() CPacket { return &CPacketPlayerInfo{} },
 		CEntityMetadata:        func() CPacket { return &CPacketEntityMetadata{} },
+		CRespawn:               func() CPacket { return &CPacketRespawn{} },
+		CEntityStatus:          func() CPacket { return &CPacketEntityStatus{} },
+		CChangeGameState:       func() CPacket { return &CPacketChangeGameState{} },
+		CTimeUpdate:            func() CPacket { return &CPacketTimeUpdate{} },
+
+		CSpawnEntity:     func() CPacket { return &CPacketSpawnEntity{} },
+		CEntityTeleport:  func() CPacket { return &CPacketEntityTeleport{} },
+		CCollectItem:     func() CPacket { return &CPacketCollectItem{} },
+		CDestroyEntities: func() CPacket { return &CPacketDestroyEntities{} },
 
 		CWindowItems:              func() CPacket { return &CPacketWindowItems{} },
 		CSetSlot:                  func() CPacket { return &CPacketSetSlot{} },
 		CWindowConfirmation:       func() CPacket { return &CPacketWindowConfirmation{} },
+		COpenWindow:               func() CPacket { return &CPacketOpenWindow{} },
+		COpenSignEditor:           func() CPacket { return &CPacketOpenSignEditor{} },
+		CCloseWindow:              func() CPacket { return &CPacketCloseWindow{} },
+		CWindowProperty:           func() CPacket { return &CPacketWindowProperty{} },
 		CAcknowledgePlayerDigging: func() CPacket { return &CPacketAcknowledgePlayerDigging{} },
+		CBlockBreakAnimation:      func() CPacket { return &CPacketBlockBreakAnimation{} },
 		CBlockChange:              func() CPacket { return &CPacketBlockChange{} },
+		CBlockEntityData:          func() CPacket { return &CPacketBlockEntityData{} },
+		CNBTQueryResponse:         func() CPacket { return &CPacketNBTQueryResponse{} },
 	}
 }
@@ -2,11 +2,13 @@ package protocol
 
 import (
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/alexykot/cncraft/pkg/buffer"
 	"github.com/alexykot/cncraft/pkg/game"
 	"github.com/alexykot/cncraft/pkg/game/data"
 	"github.com/alexykot/cncraft/pkg/game/items"
+	"github.com/alexykot/cncraft/pkg/game/level"
 	"github.com/alexykot/cncraft/pkg/game/player"
 	"github.com/alexykot/cncraft/pkg/protocol/objects"
 	"github.com/alexykot/cncraft/pkg/protocol/plugin"
@@ -210,11 +212,18 @@ func (p *SPacketClientSettings) Pull(reader *buffer.Buffer) error {
 	return nil // DEBT actually check for errors
 }
 
-type SPacketTabComplete struct{}
+type SPacketTabComplete struct {
+	TransactionID int32
+	Text          string // all text typed in the chat box, including the leading slash
+}
 
 func (p *SPacketTabComplete) ProtocolID() ProtocolPacketID { return protocolSTabComplete }
 func (p *SPacketTabComplete) Type() PacketType             { return STabComplete }
-func (p *SPacketTabComplete) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
+func (p *SPacketTabComplete) Pull(reader *buffer.Buffer) error {
+	p.TransactionID = reader.PullVarInt()
+	p.Text = reader.PullString()
+	return nil
+}
 
 type SPacketWindowConfirmation struct {
 	WindowID items.WindowID
@@ -418,11 +427,20 @@ func (p *SPacketPickItem) ProtocolID() ProtocolPacketID { return protocolSPickIt
 func (p *SPacketPickItem) Type() PacketType             { return SPickItem }
 func (p *SPacketPickItem) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
 
-type SPacketCraftRecipeRequest struct{}
+type SPacketCraftRecipeRequest struct {
+	WindowID items.WindowID
+	RecipeID string
+	MakeAll  bool
+}
 
 func (p *SPacketCraftRecipeRequest) ProtocolID() ProtocolPacketID { return protocolSCraftRecipeRequest }
 func (p *SPacketCraftRecipeRequest) Type() PacketType             { return SCraftRecipeRequest }
-func (p *SPacketCraftRecipeRequest) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
+func (p *SPacketCraftRecipeRequest) Pull(reader *buffer.Buffer) error {
+	p.WindowID = items.WindowID(reader.PullByte())
+	p.RecipeID = reader.PullString()
+	p.MakeAll = reader.PullBool()
+	return nil
+}
 
 type SPacketPlayerAbilities struct {
 	Abilities   player.Abilities
@@ -572,11 +590,26 @@ func (p *SPacketUpdateStructureBlock) ProtocolID() ProtocolPacketID {
 func (p *SPacketUpdateStructureBlock) Type() PacketType           { return SUpdateStructureBlock }
 func (p *SPacketUpdateStructureBlock) Pull(reader *buffer.Buffer) { panic("packet not implemented") }
 
-type SPacketUpdateSign struct{}
+// signLineMaxLength - longest sign line the Notchian server accepts, in characters.
+const signLineMaxLength = 384
+
+type SPacketUpdateSign struct {
+	Location data.PositionI
+	Lines    [level.SignLineCount]string
+}
 
 func (p *SPacketUpdateSign) ProtocolID() ProtocolPacketID { return protocolSUpdateSign }
 func (p *SPacketUpdateSign) Type() PacketType             { return SUpdateSign }
-func (p *SPacketUpdateSign) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
+func (p *SPacketUpdateSign) Pull(reader *buffer.Buffer) error {
+	p.Location.Pull(reader)
+	for i := range p.Lines {
+		p.Lines[i] = reader.PullString()
+		if length := utf8.RuneCountInString(p.Lines[i]); length > signLineMaxLength {
+			return fmt.Errorf("sign line %d is too long: %d characters", i, length)
+		}
+	}
+	return nil
+}
 
 type SPacketAnimation struct {
 	Hand uint8
@@ -595,16 +628,38 @@ func (p *SPacketSpectate) ProtocolID() ProtocolPacketID { return protocolSSpecta
 func (p *SPacketSpectate) Type() PacketType             { return SSpectate }
 func (p *SPacketSpectate) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
 
-type SPacketPlayerBlockPlacement struct{}
+type SPacketPlayerBlockPlacement struct {
+	Hand        uint8
+	Location    data.PositionI // location of the block clicked, not of the block being placed
+	Face        byte
+	CursorX     float32
+	CursorY     float32
+	CursorZ     float32
+	InsideBlock bool
+}
 
 func (p *SPacketPlayerBlockPlacement) ProtocolID() ProtocolPacketID {
 	return protocolSPlayerBlockPlacement
 }
-func (p *SPacketPlayerBlockPlacement) Type() PacketType           { return SPlayerBlockPlacement }
-func (p *SPacketPlayerBlockPlacement) Pull(reader *buffer.Buffer) { panic("packet not implemented") }
+func (p *SPacketPlayerBlockPlacement) Type() PacketType { return SPlayerBlockPlacement }
+func (p *SPacketPlayerBlockPlacement) Pull(reader *buffer.Buffer) error {
+	p.Hand = uint8(reader.PullVarInt())
+	p.Location.Pull(reader)
+	p.Face = byte(reader.PullVarInt())
+	p.CursorX = reader.PullFloat32()
+	p.CursorY = reader.PullFloat32()
+	p.CursorZ = reader.PullFloat32()
+	p.InsideBlock = reader.PullBool()
+	return nil // DEBT actually check for errors
+}
 
-type SPacketUseItem struct{}
+type SPacketUseItem struct {
+	Hand uint8
+}
 
 func (p *SPacketUseItem) ProtocolID() ProtocolPacketID { return protocolSUseItem }
 func (p *SPacketUseItem) Type() PacketType             { return SUseItem }
-func (p *SPacketUseItem) Pull(reader *buffer.Buffer)   { panic("packet not implemented") }
+func (p *SPacketUseItem) Pull(reader *buffer.Buffer) error {
+	p.Hand = uint8(reader.PullVarInt())
+	return nil
+}